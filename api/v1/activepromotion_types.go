@@ -36,6 +36,7 @@ const (
 	ActivePromotionCollectingPreActiveResult ActivePromotionState = "CollectingPreActiveResult"
 	ActivePromotionDemoting                  ActivePromotionState = "DemotingActiveEnvironment"
 	ActivePromotionActiveEnvironment         ActivePromotionState = "PromotingActiveEnvironment"
+	ActivePromotionShiftingTraffic           ActivePromotionState = "ShiftingTrafficToActiveEnvironment"
 	ActivePromotionDestroyingPreviousActive  ActivePromotionState = "DestroyingPreviousActiveEnvironment"
 	ActivePromotionDestroyingPreActive       ActivePromotionState = "DestroyingPreActiveEnvironment"
 	ActivePromotionFinished                  ActivePromotionState = "Finished"
@@ -96,9 +97,16 @@ const (
 	// ActivePromotionCondActiveDemotionFinished means a previous active environment has been demoted
 	ActivePromotionCondActiveDemoted ActivePromotionConditionType = "ActiveDemoted"
 
+	// ActivePromotionCondTrafficShifted means traffic has been shifted to the pre-active namespace
+	// In case of progressive traffic shifting
+	ActivePromotionCondTrafficShifted ActivePromotionConditionType = "TrafficShifted"
+
 	// ActivePromotionCondActivePromoted means the pre-active namespace has been promoted to be a new active
 	// In case of successful promoting
 	ActivePromotionCondActivePromoted ActivePromotionConditionType = "ActivePromoted"
+	// ActivePromotionCondActiveHealthy means the new active namespace has been healthy during the health watch
+	// In case of successful promoting
+	ActivePromotionCondActiveHealthy ActivePromotionConditionType = "ActiveHealthy"
 	// ActivePromotionCondPreviousActiveDestroyed means previous active namespace has been destroyed
 	// In case of successful promoting
 	ActivePromotionCondPreviousActiveDestroyed ActivePromotionConditionType = "PreviousActiveDestroyed"
@@ -111,6 +119,10 @@ const (
 
 	// ActivePromotionCondRollbackStarted means the rollback process has been started
 	ActivePromotionCondRollbackStarted ActivePromotionConditionType = "Rollback"
+
+	// ActivePromotionCondPreviousActiveRestored means the previous active namespace has been restored
+	// In case of rolling back after successful promoting
+	ActivePromotionCondPreviousActiveRestored ActivePromotionConditionType = "PreviousActiveRestored"
 )
 
 // ActivePromotionSpec defines the desired state of ActivePromotion
@@ -134,6 +146,20 @@ type ActivePromotionSpec struct {
 	// NoDowntimeGuarantee represents a flag for switching to the new namespace before demoting the active namespace and guarantees the process will not have a downtime
 	// +optional
 	NoDowntimeGuarantee *bool `json:"noDowntimeGuarantee,omitempty"`
+
+	// RollbackRequest represents a request for rolling back to the previous active namespace
+	// which has not been destroyed yet
+	// +optional
+	RollbackRequest *ActivePromotionRollbackRequest `json:"rollbackRequest,omitempty"`
+}
+
+// ActivePromotionRollbackRequest defines a request for rolling back to the previous active namespace
+type ActivePromotionRollbackRequest struct {
+	// RequestedBy represents a person who requested the rollback
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+	// RequestedAt represents time at which the rollback was requested
+	RequestedAt metav1.Time `json:"requestedAt"`
 }
 
 func (s *ActivePromotionSpec) SetTearDownDuration(d metav1.Duration) {
@@ -190,6 +216,18 @@ type ActivePromotionStatus struct {
 	// PreActiveQueue represents a pre-active queue status
 	// +optional
 	PreActiveQueue QueueStatus `json:"preActiveQueue,omitempty"`
+	// TrafficWeight represents percentage of traffic shifted to the pre-active namespace
+	// +optional
+	TrafficWeight int `json:"trafficWeight,omitempty"`
+	// TrafficShiftedAt represents time when traffic was shifted to the current weight
+	// +optional
+	TrafficShiftedAt *metav1.Time `json:"trafficShiftedAt,omitempty"`
+	// HealthWatchFailures represents no. of consecutive failed health checks of the new active namespace
+	// +optional
+	HealthWatchFailures int `json:"healthWatchFailures,omitempty"`
+	// HealthCheckedAt represents the latest time when health of the new active namespace was checked
+	// +optional
+	HealthCheckedAt *metav1.Time `json:"healthCheckedAt,omitempty"`
 
 	// Conditions contains observations of the resource's state e.g.,
 	// Queue deployed, being tested
@@ -224,6 +262,13 @@ func (s *ActivePromotionStatus) SetDestroyedTime(destroyedTime metav1.Time) {
 	s.DestroyedTime = &destroyedTime
 }
 
+// SetTrafficWeight sets percentage of traffic shifted to the pre-active namespace
+func (s *ActivePromotionStatus) SetTrafficWeight(weight int) {
+	now := metav1.Now()
+	s.TrafficWeight = weight
+	s.TrafficShiftedAt = &now
+}
+
 func (s *ActivePromotionStatus) SetActivePromotionHistoryName(atpHistName string) {
 	s.ActivePromotionHistoryName = atpHistName
 }
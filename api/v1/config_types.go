@@ -19,6 +19,7 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,6 +45,10 @@ type Component struct {
 	Schedules []string `json:"schedules,omitempty"`
 	// +optional
 	Dependencies []*Dependency `json:"dependencies,omitempty"`
+	// DependsOn defines names of top-level components which have to be deployed and ready
+	// before this component is deployed
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 // Dependency represents a chart of dependency
@@ -70,6 +75,52 @@ type ComponentImage struct {
 	Tag string `json:"tag,omitempty"`
 	// +optional
 	Pattern string `json:"pattern,omitempty"`
+	// Constraint is a semantic version constraint of tag, e.g. `>=2.3 <3`,
+	// tags which are not semantic versions will be ignored,
+	// pre-release tags satisfy only the constraint which includes pre-release, e.g. `>=2.3.0-0`
+	// +optional
+	Constraint string `json:"constraint,omitempty"`
+	// Ordering defines how matched tags are ordered to choose the desired version,
+	// the latest one will be chosen
+	// +optional
+	Ordering *ImageOrdering `json:"ordering,omitempty"`
+}
+
+// ImageOrderingStrategy represents a strategy of ordering image tags
+type ImageOrderingStrategy string
+
+const (
+	// ImageOrderingDefault orders tags by splitting the numbers, e.g. `1.2.3-4`
+	ImageOrderingDefault ImageOrderingStrategy = ""
+	// ImageOrderingSemver orders tags by semantic version and ignores pre-release versions
+	ImageOrderingSemver ImageOrderingStrategy = "semver"
+	// ImageOrderingSemverWithPrerelease orders tags by semantic version including pre-release versions
+	ImageOrderingSemverWithPrerelease ImageOrderingStrategy = "semver-with-prerelease"
+	// ImageOrderingLexical orders tags alphabetically
+	ImageOrderingLexical ImageOrderingStrategy = "lexical"
+	// ImageOrderingCreatedTime orders tags by created time of images,
+	// it works only with checkers which provide created time of images
+	ImageOrderingCreatedTime ImageOrderingStrategy = "created-time"
+	// ImageOrderingCaptureGroup orders tags by capture groups of the pattern
+	ImageOrderingCaptureGroup ImageOrderingStrategy = "capture-group"
+)
+
+// ImageOrdering represents a strategy of ordering image tags
+type ImageOrdering struct {
+	// Strategy defines how tags are ordered
+	// - "" (default)
+	// - semver
+	// - semver-with-prerelease
+	// - lexical
+	// - created-time
+	// - capture-group
+	// +optional
+	Strategy ImageOrderingStrategy `json:"strategy,omitempty"`
+	// CaptureGroups defines names or indexes of capture groups in the pattern, which are compared in order,
+	// numbers are compared numerically and the others are compared alphabetically,
+	// used by `capture-group` strategy
+	// +optional
+	CaptureGroups []string `json:"captureGroups,omitempty"`
 }
 
 // ComponentChart represents a chart repository, name and version
@@ -94,9 +145,98 @@ type ConfigStaging struct {
 	// +optional
 	MaxRetry int `json:"maxRetry,omitempty"`
 
+	// RetryPolicy defines retry counts and backoff of each failure class,
+	// MaxRetry is used for failures which do not match any rule
+	// +optional
+	RetryPolicy *ConfigRetryPolicy `json:"retryPolicy,omitempty"`
+
 	// MaxHistoryDays defines maximum days of QueueHistory stored
 	// +optional
 	MaxHistoryDays int `json:"maxHistoryDays,omitempty"`
+
+	// Incremental enables incremental deployment of the upgrade queue,
+	// the namespace is kept between queues and only releases which values or chart version changed are upgraded.
+	// The namespace is fully cleaned up if the previous queue failed, the environment is unhealthy or on reverify
+	// +optional
+	Incremental bool `json:"incremental,omitempty"`
+}
+
+// QueueFailureClass represents a class of queue failure
+type QueueFailureClass string
+
+const (
+	// FailureImagePullBackOff means some pods cannot pull images
+	FailureImagePullBackOff QueueFailureClass = "ImagePullBackOff"
+	// FailureCrashLoopBackOff means some containers keep crashing
+	FailureCrashLoopBackOff QueueFailureClass = "CrashLoopBackOff"
+	// FailureDeployTimeout means the environment is not ready within the deployment timeout
+	FailureDeployTimeout QueueFailureClass = "DeployTimeout"
+	// FailureDeployFailed means the release cannot be deployed
+	FailureDeployFailed QueueFailureClass = "DeployFailed"
+	// FailureTestFailed means the environment is deployed but the testing failed
+	FailureTestFailed QueueFailureClass = "TestFailed"
+	// FailureTestTimeout means the testing is not finished within the testing timeout
+	FailureTestTimeout QueueFailureClass = "TestTimeout"
+)
+
+// maxRetryBackoff is the maximum duration to wait before retrying
+const maxRetryBackoff = 6 * time.Hour
+
+// ConfigRetryPolicy represents how to retry the failed queue based on the failure class
+type ConfigRetryPolicy struct {
+	// Rules defines retry counts and backoff of failure classes
+	// +optional
+	Rules []ConfigRetryRule `json:"rules,omitempty"`
+
+	// RetestOnly re-runs only the testing without redeploying
+	// if the testing failed and the environment is healthy
+	// +optional
+	RetestOnly bool `json:"retestOnly,omitempty"`
+}
+
+// ConfigRetryRule represents retry counts and backoff of a failure class
+type ConfigRetryRule struct {
+	// +kubebuilder:validation:Enum=ImagePullBackOff;CrashLoopBackOff;DeployTimeout;DeployFailed;TestFailed;TestTimeout
+	Failure QueueFailureClass `json:"failure"`
+
+	// MaxRetry defines max retry counts of the failure class
+	MaxRetry int `json:"maxRetry"`
+
+	// Backoff defines a duration to wait before retrying, the duration is doubled every retry
+	// +optional
+	Backoff metav1.Duration `json:"backoff,omitempty"`
+}
+
+// GetRule returns a retry rule of the failure class
+func (p *ConfigRetryPolicy) GetRule(failure QueueFailureClass) *ConfigRetryRule {
+	if p == nil || failure == "" {
+		return nil
+	}
+
+	for i := range p.Rules {
+		if p.Rules[i].Failure == failure {
+			return &p.Rules[i]
+		}
+	}
+
+	return nil
+}
+
+// GetBackoff returns a duration to wait before running the nth retry
+func (r *ConfigRetryRule) GetBackoff(noOfRetry int) time.Duration {
+	if r == nil || r.Backoff.Duration <= 0 || noOfRetry <= 0 {
+		return 0
+	}
+
+	backoff := r.Backoff.Duration
+	for i := 1; i < noOfRetry && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+
+	return backoff
 }
 
 type ConfigDeploy struct {
@@ -113,6 +253,8 @@ type ConfigDeploy struct {
 	// mock - for test only, always return success
 	//
 	// helm3 - deploy chart with helm3
+	//
+	// manifest - deploy kustomize base or raw manifests of chart repository with server-side apply
 	// +optional
 	Engine *string `json:"engine,omitempty"`
 
@@ -135,6 +277,104 @@ type ConfigTestRunner struct {
 	Teamcity *ConfigTeamcity `json:"teamcity,omitempty"`
 	// +optional
 	TestMock *ConfigTestMock `json:"testMock,omitempty"`
+	// +optional
+	Rest *ConfigRest `json:"rest,omitempty"`
+	// +optional
+	Jenkins *ConfigJenkins `json:"jenkins,omitempty"`
+	// +optional
+	K8sJob *ConfigK8sJob `json:"k8sJob,omitempty"`
+	// Stages defines an ordered list of named test stages,
+	// the test runners above are ignored if stages are defined
+	// +optional
+	Stages []ConfigTestStage `json:"stages,omitempty"`
+	// Parallel runs all stages at the same time, otherwise stages are run in order
+	// +optional
+	Parallel bool `json:"parallel,omitempty"`
+	// TestReport defines how to collect test results after testing
+	// +optional
+	TestReport *ConfigTestReport `json:"testReport,omitempty"`
+	// StageName is a name of the test stage which the test runners are run for
+	StageName string `json:"-"`
+}
+
+const defaultMaxFailedTests = 10
+
+// ConfigTestReport represents configuration of collecting test results in JUnit XML format
+type ConfigTestReport struct {
+	// URL defines a url of JUnit XML report, the url is rendered with the queue
+	// e.g. {{ .Status.TestRunner.Jenkins.BuildURL }}/artifact/junit.xml
+	// +optional
+	URL string `json:"url,omitempty"`
+	// GitlabArtifactPath defines a path of JUnit XML report in job artifacts of the GitLab pipeline
+	// +optional
+	GitlabArtifactPath string `json:"gitlabArtifactPath,omitempty"`
+	// Teamcity collects test occurrences of the Teamcity build
+	// +optional
+	Teamcity bool `json:"teamcity,omitempty"`
+	// MaxFailedTests defines a number of failed test names to be stored, default is 10
+	// +optional
+	MaxFailedTests int `json:"maxFailedTests,omitempty"`
+}
+
+// GetMaxFailedTests returns a number of failed test names to be stored
+func (c *ConfigTestReport) GetMaxFailedTests() int {
+	if c == nil || c.MaxFailedTests <= 0 {
+		return defaultMaxFailedTests
+	}
+	return c.MaxFailedTests
+}
+
+// ConfigTestStage represents a named test stage which runs with its own test runner
+type ConfigTestStage struct {
+	Name string `json:"name"`
+	// Required tells the queue testing fails if the stage fails, default is true
+	// +optional
+	Required *bool `json:"required,omitempty"`
+	// Timeout defines a timeout of the stage, the timeout of test runner is used if not defined
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// +optional
+	Gitlab *ConfigGitlab `json:"gitlab,omitempty"`
+	// +optional
+	Teamcity *ConfigTeamcity `json:"teamcity,omitempty"`
+	// +optional
+	TestMock *ConfigTestMock `json:"testMock,omitempty"`
+	// +optional
+	Rest *ConfigRest `json:"rest,omitempty"`
+	// +optional
+	Jenkins *ConfigJenkins `json:"jenkins,omitempty"`
+	// +optional
+	K8sJob *ConfigK8sJob `json:"k8sJob,omitempty"`
+}
+
+// IsRequired returns true if the stage is required
+func (s ConfigTestStage) IsRequired() bool {
+	return s.Required == nil || *s.Required
+}
+
+// GetTestRunner returns a test runner configuration of the stage,
+// the polling time of the parent is used
+func (s ConfigTestStage) GetTestRunner(parent *ConfigTestRunner) *ConfigTestRunner {
+	testRunner := &ConfigTestRunner{
+		Timeout:   s.Timeout,
+		Gitlab:    s.Gitlab.DeepCopy(),
+		Teamcity:  s.Teamcity.DeepCopy(),
+		TestMock:  s.TestMock.DeepCopy(),
+		Rest:      s.Rest.DeepCopy(),
+		Jenkins:   s.Jenkins.DeepCopy(),
+		K8sJob:    s.K8sJob.DeepCopy(),
+		StageName: s.Name,
+	}
+
+	if parent != nil {
+		testRunner.PollingTime = parent.PollingTime
+		testRunner.TestReport = parent.TestReport.DeepCopy()
+		if testRunner.Timeout.Duration == 0 {
+			testRunner.Timeout = parent.Timeout
+		}
+	}
+
+	return testRunner
 }
 
 // ConfigTestRunnerOverrider is data that overrides ConfigTestRunner field by field
@@ -149,6 +389,18 @@ type ConfigTestRunnerOverrider struct {
 	Teamcity *ConfigTeamcityOverrider `json:"teamcity,omitempty"`
 	// +optional
 	TestMock *ConfigTestMock `json:"testMock,omitempty"`
+	// +optional
+	Rest *ConfigRest `json:"rest,omitempty"`
+	// +optional
+	Jenkins *ConfigJenkinsOverrider `json:"jenkins,omitempty"`
+	// +optional
+	K8sJob *ConfigK8sJob `json:"k8sJob,omitempty"`
+	// +optional
+	Stages []ConfigTestStage `json:"stages,omitempty"`
+	// +optional
+	Parallel *bool `json:"parallel,omitempty"`
+	// +optional
+	TestReport *ConfigTestReport `json:"testReport,omitempty"`
 }
 
 // Override overrides ConfigTestRunner and return a reference to the overridden instance.
@@ -179,9 +431,81 @@ func (c ConfigTestRunnerOverrider) Override(confTestRunner *ConfigTestRunner) *C
 		ensureConfTestRunner()
 		confTestRunner.TestMock = c.TestMock.DeepCopy()
 	}
+	if c.Rest != nil {
+		ensureConfTestRunner()
+		confTestRunner.Rest = c.Rest.DeepCopy()
+	}
+	if c.Jenkins != nil {
+		ensureConfTestRunner()
+		confTestRunner.Jenkins = c.Jenkins.Override(confTestRunner.Jenkins)
+	}
+	if c.K8sJob != nil {
+		ensureConfTestRunner()
+		confTestRunner.K8sJob = c.K8sJob.DeepCopy()
+	}
+	if c.Stages != nil {
+		ensureConfTestRunner()
+		confTestRunner.Stages = make([]ConfigTestStage, len(c.Stages))
+		for i := range c.Stages {
+			c.Stages[i].DeepCopyInto(&confTestRunner.Stages[i])
+		}
+	}
+	if c.Parallel != nil {
+		ensureConfTestRunner()
+		confTestRunner.Parallel = *c.Parallel
+	}
+	if c.TestReport != nil {
+		ensureConfTestRunner()
+		confTestRunner.TestReport = c.TestReport.DeepCopy()
+	}
 	return confTestRunner
 }
 
+// ConfigRest defines a generic http rest configuration of test runner,
+// url and body of requests are rendered by go template with the queue data
+// e.g. `{{ .TeamName }}`, `{{ .Namespace }}`, `{{ .ComponentName }}`, `{{ .ComponentVersion }}`,
+// `{{ .QueueType }}`, `{{ .PRNumber }}` and `{{ .BuildID }}`
+type ConfigRest struct {
+	// Trigger defines a request for triggering the test, default method is POST
+	Trigger RestRequest `json:"trigger"`
+	// Status defines a request for getting the test status, default method is GET
+	Status RestRequest `json:"status"`
+	// BuildIDPath is a json path expression of build id in the trigger response e.g. `{.id}`
+	BuildIDPath string `json:"buildIDPath"`
+	// BuildNumberPath is a json path expression of build number in the trigger response,
+	// build id is used if not defined
+	// +optional
+	BuildNumberPath string `json:"buildNumberPath,omitempty"`
+	// BuildURLPath is a json path expression of build url in the trigger response
+	// +optional
+	BuildURLPath string `json:"buildURLPath,omitempty"`
+	// FinishedPath is a json path expression of the status response which tells the test has finished
+	FinishedPath string `json:"finishedPath"`
+	// FinishedValues are values of FinishedPath which mean the test has finished,
+	// any value except empty, `false` and `null` is considered as finished if not defined
+	// +optional
+	FinishedValues []string `json:"finishedValues,omitempty"`
+	// SuccessPath is a json path expression of the status response which tells the test has passed
+	SuccessPath string `json:"successPath"`
+	// SuccessValues are values of SuccessPath which mean the test has passed, default is `true`
+	// +optional
+	SuccessValues []string `json:"successValues,omitempty"`
+	// Headers defines http headers of requests,
+	// the headers from `restHeaders` of team credential will be added
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// RestRequest defines a http request of rest test runner
+type RestRequest struct {
+	URL string `json:"url"`
+	// +optional
+	Method string `json:"method,omitempty"`
+	// Body is a go template of request body
+	// +optional
+	Body string `json:"body,omitempty"`
+}
+
 // ConfigTeamcity defines a http rest configuration of teamcity
 type ConfigTeamcity struct {
 	// TODO: make every fields optional to reduce duplicate code in ConfigTeamcityOverrider
@@ -217,6 +541,76 @@ func (c ConfigTeamcityOverrider) Override(confTeamcity *ConfigTeamcity) *ConfigT
 	return confTeamcity
 }
 
+// ConfigJenkins defines a http rest configuration of jenkins
+type ConfigJenkins struct {
+	// URL is a base url of Jenkins e.g. https://jenkins.example.com
+	URL string `json:"url" yaml:"url"`
+	// JobName is a full name of the parameterized job, folders are separated by `/` e.g. `team/regression`
+	JobName string `json:"jobName" yaml:"jobName"`
+	// Branch is a branch name of multibranch pipeline job, supports `{{ .PRNumber }}` template
+	// +optional
+	Branch string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	// Parameters defines additional build parameters, supports `{{ .PRNumber }}` template
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+}
+
+// ConfigJenkinsOverrider is data that overrides ConfigJenkins field by field
+type ConfigJenkinsOverrider struct {
+	// +optional
+	URL *string `json:"url,omitempty"`
+	// +optional
+	JobName *string `json:"jobName,omitempty"`
+	// +optional
+	Branch *string `json:"branch,omitempty"`
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// Override overrides ConfigJenkins and return a reference to the overridden instance.
+// The operation will try to override an instance in-place if possible.
+func (c ConfigJenkinsOverrider) Override(confJenkins *ConfigJenkins) *ConfigJenkins {
+	ensureConfJenkins := func() {
+		if confJenkins == nil {
+			confJenkins = &ConfigJenkins{}
+		}
+	}
+	if c.URL != nil {
+		ensureConfJenkins()
+		confJenkins.URL = *c.URL
+	}
+	if c.JobName != nil {
+		ensureConfJenkins()
+		confJenkins.JobName = *c.JobName
+	}
+	if c.Branch != nil {
+		ensureConfJenkins()
+		confJenkins.Branch = *c.Branch
+	}
+	if c.Parameters != nil {
+		ensureConfJenkins()
+		if confJenkins.Parameters == nil {
+			confJenkins.Parameters = make(map[string]string)
+		}
+		for k, v := range c.Parameters {
+			confJenkins.Parameters[k] = v
+		}
+	}
+	return confJenkins
+}
+
+// ConfigK8sJob defines a Kubernetes Job which is run in the namespace of the queue,
+// the job is succeeded if all pods are completed successfully
+type ConfigK8sJob struct {
+	// Template is a pod template of the job,
+	// the namespace and queue components are injected as env vars into all containers
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template PodTemplate `json:"template"`
+	// BackoffLimit is a number of retries before marking the job failed, default is 0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+}
+
 // ConfigGitlab defines a http rest configuration of gitlab
 type ConfigGitlab struct {
 	// TODO: make every fields optional to reduce duplicate code in ConfigGitlabOverrider
@@ -325,6 +719,105 @@ type ConfigActivePromotion struct {
 	// Deployment represents configuration about deploy
 	// +optional
 	Deployment *ConfigDeploy `json:"deployment,omitempty"`
+
+	// Schedule defines times for creating active promotions automatically
+	// +optional
+	Schedule *ActivePromotionSchedule `json:"schedule,omitempty"`
+
+	// TrafficShift defines a configuration for shifting traffic to the new active namespace progressively,
+	// the new active namespace is always promoted before demoting the current active namespace
+	// +optional
+	TrafficShift *ConfigTrafficShift `json:"trafficShift,omitempty"`
+
+	// HealthWatch defines a configuration for watching health of the new active namespace after promoting
+	// +optional
+	HealthWatch *ConfigHealthWatch `json:"healthWatch,omitempty"`
+}
+
+// ConfigTrafficShift represents configuration about shifting traffic to the new active namespace progressively
+// by setting nginx ingress canary annotations to ingresses of the new active namespace
+type ConfigTrafficShift struct {
+	// Steps defines percentages of traffic shifted to the new active namespace in order e.g. [10, 50],
+	// all traffic is shifted to the new active namespace after the last step
+	Steps []int `json:"steps"`
+
+	// StepDuration defines duration of each step before checking the error rate and shifting to the next step,
+	// default is 5m, the duration of all steps is added to the active promotion timeout
+	// +optional
+	StepDuration metav1.Duration `json:"stepDuration,omitempty"`
+
+	// Prometheus defines a query of the error rate of the new active namespace
+	// +optional
+	Prometheus *PrometheusQuery `json:"prometheus,omitempty"`
+}
+
+// ConfigHealthWatch represents configuration about watching health of the new active namespace after promoting,
+// the active environment is rolled back to the previous active namespace if the new one is unhealthy
+type ConfigHealthWatch struct {
+	// Duration defines observation period after promoting, default is 10m,
+	// the previous active namespace is not destroyed until the end of the period
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// Interval defines duration between health checks, default is 30s
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// FailureThreshold defines no. of consecutive failed health checks before rolling back, default is 3
+	// +optional
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+
+	// HTTP defines an http endpoint of the new active namespace which has to respond successfully
+	// +optional
+	HTTP *HealthWatchHTTP `json:"http,omitempty"`
+
+	// Prometheus defines a query of the error rate of the new active namespace
+	// +optional
+	Prometheus *PrometheusQuery `json:"prometheus,omitempty"`
+}
+
+// HealthWatchHTTP represents an http health check of the new active namespace
+type HealthWatchHTTP struct {
+	// URL defines the health check url, {{ .Namespace }} is replaced by the new active namespace
+	// e.g. http://wordpress.{{ .Namespace }}/healthz
+	URL string `json:"url"`
+
+	// Timeout defines maximum duration of the health check request, default is 10s
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// PrometheusQuery represents a Prometheus query for checking the error rate of the new active namespace
+type PrometheusQuery struct {
+	// URL defines the Prometheus server url e.g. http://prometheus.monitoring:9090
+	URL string `json:"url"`
+
+	// Query defines a PromQL query of the error rate,
+	// {{ .Namespace }} is replaced by the new active namespace
+	Query string `json:"query"`
+
+	// Threshold defines maximum error rate e.g. "0.05",
+	// the active promotion is rolled back if the query result exceeds the threshold
+	Threshold string `json:"threshold"`
+}
+
+// ActivePromotionSchedule defines a configuration of scheduled active promotions
+type ActivePromotionSchedule struct {
+	// Crons defines cron expressions of the promotion times e.g. "0 6 * * 1-5" for every weekday at 06:00
+	Crons []string `json:"crons"`
+
+	// Timezone defines IANA timezone of the cron expressions e.g. "Asia/Bangkok", default is UTC
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// BlackoutDates defines dates in YYYY-MM-DD format which the scheduled promotions are skipped
+	// +optional
+	BlackoutDates []string `json:"blackoutDates,omitempty"`
+
+	// OnlyIfStableComponentsChanged defines a flag for skipping the scheduled promotion
+	// if stable components have not been changed since the last promotion
+	// +optional
+	OnlyIfStableComponentsChanged bool `json:"onlyIfStableComponentsChanged,omitempty"`
 }
 
 // OutdatedNotification defines a configuration of outdated notification
@@ -348,10 +841,16 @@ type ConfigReporter struct {
 	// +optional
 	Gitlab *ReporterGitlab `json:"gitlab,omitempty"`
 	// +optional
+	Bitbucket *ReporterBitbucket `json:"bitbucket,omitempty"`
+	// +optional
+	Gitea *ReporterGitea `json:"gitea,omitempty"`
+	// +optional
 	Rest *ReporterRest `json:"rest,omitempty"`
 	// +optional
 	Shell *ReporterShell `json:"cmd,omitempty"`
 	// +optional
+	Email *ReporterEmail `json:"email,omitempty"`
+	// +optional
 	ReportMock bool `json:"reportMock,omitempty"`
 }
 
@@ -475,6 +974,28 @@ type ReporterGitlab struct {
 	BaseURL string `json:"baseURL,omitempty"`
 }
 
+// ReporterBitbucket defines a configuration of bitbucket server reporter
+// supports pull request queue reporter type only
+type ReporterBitbucket struct {
+	// Enabled represents an enabled flag
+	// +optional
+	Enabled bool `json:"enabled"`
+	// BaseURL represents a bitbucket server base url e.g., https://bitbucket.example.com
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
+}
+
+// ReporterGitea defines a configuration of gitea reporter
+// supports pull request queue reporter type only
+type ReporterGitea struct {
+	// Enabled represents an enabled flag
+	// +optional
+	Enabled bool `json:"enabled"`
+	// BaseURL represents a gitea base url e.g., https://gitea.com
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
+}
+
 // ReporterRest defines a configuration of http rest
 type ReporterRest struct {
 	// +optional
@@ -509,6 +1030,58 @@ type ReporterShell struct {
 	ActiveEnvironmentDeleted *CommandAndArgs `json:"activeEnvironmentDeleted,omitempty"`
 }
 
+// ReporterEmail defines a configuration of email
+type ReporterEmail struct {
+	// Server represents a SMTP server host
+	Server string `json:"server"`
+	// Port represents a SMTP server port
+	Port int `json:"port"`
+	// From represents a sender email address
+	From string `json:"from"`
+	// To represents default recipients of every event
+	// +optional
+	To []string `json:"to,omitempty"`
+	// +optional
+	ComponentUpgrade *EmailComponentUpgradeReport `json:"componentUpgrade,omitempty"`
+	// +optional
+	ActivePromotion *EmailReport `json:"activePromotion,omitempty"`
+	// +optional
+	ImageMissing *EmailReport `json:"imageMissing,omitempty"`
+	// +optional
+	PullRequestTrigger *EmailPullRequestTriggerReport `json:"pullRequestTrigger,omitempty"`
+	// +optional
+	PullRequestQueue *EmailPullRequestQueueReport `json:"pullRequestQueue,omitempty"`
+	// +optional
+	PullRequestTestRunnerPending *EmailReport `json:"pullRequestTestRunnerPending,omitempty"`
+	// +optional
+	ActiveEnvironmentDeleted *EmailReport `json:"activeEnvironmentDeleted,omitempty"`
+}
+
+// EmailReport defines recipients of an email report
+type EmailReport struct {
+	// To represents recipients of the event, the default recipients will be used if empty
+	// +optional
+	To []string `json:"to,omitempty"`
+}
+
+// EmailComponentUpgradeReport defines a configuration of component upgrade email report
+type EmailComponentUpgradeReport struct {
+	ConfigComponentUpgradeReport `json:",inline"`
+	EmailReport                  `json:",inline"`
+}
+
+// EmailPullRequestTriggerReport defines a configuration of pull request trigger email report
+type EmailPullRequestTriggerReport struct {
+	ConfigPullRequestTriggerReport `json:",inline"`
+	EmailReport                    `json:",inline"`
+}
+
+// EmailPullRequestQueueReport defines a configuration of pull request queue email report
+type EmailPullRequestQueueReport struct {
+	ConfigPullRequestQueueReport `json:",inline"`
+	EmailReport                  `json:",inline"`
+}
+
 // CommandAndArgs defines commands and args
 type CommandAndArgs struct {
 	Command []string `json:"command"`
@@ -549,7 +1122,7 @@ type PullRequestBundle struct {
 	// +optional
 	Dependencies []string `json:"dependencies,omitempty"`
 	// GitRepository represents a string of git repository "<owner>/<repository>" e.g., agoda-com/samsahai
-	// used for publishing commit status
+	// used for publishing commit status, "<project key>/<repository slug>" is used for Bitbucket Server
 	// +optional
 	GitRepository string `json:"gitRepository,omitempty"`
 	// GitProjectID represents a git repository project id
@@ -634,6 +1207,47 @@ type PullRequestTriggerConfig struct {
 	MaxRetry *int `json:"maxRetry,omitempty"`
 }
 
+// PullRequestGitProvider represents a git provider of pull requests
+// +kubebuilder:validation:Enum=github;gitlab;bitbucket;gitea
+type PullRequestGitProvider string
+
+const (
+	// PullRequestGitProviderGithub represents Github provider
+	PullRequestGitProviderGithub PullRequestGitProvider = "github"
+	// PullRequestGitProviderGitlab represents Gitlab provider
+	PullRequestGitProviderGitlab PullRequestGitProvider = "gitlab"
+	// PullRequestGitProviderBitbucket represents Bitbucket Server provider
+	PullRequestGitProviderBitbucket PullRequestGitProvider = "bitbucket"
+	// PullRequestGitProviderGitea represents Gitea provider
+	PullRequestGitProviderGitea PullRequestGitProvider = "gitea"
+)
+
+// PullRequestStateCheckConfig represents a configuration of checking pull request states from the git provider
+type PullRequestStateCheckConfig struct {
+	// Enabled defines whether the pull request environments are destroyed
+	// once their pull requests have been closed or merged
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Provider defines a git provider which pull requests are queried from
+	Provider PullRequestGitProvider `json:"provider"`
+	// KeepAliveWhileOpen defines whether the tearDownDuration of pull request environments
+	// is extended while their pull requests are still open
+	// +optional
+	KeepAliveWhileOpen bool `json:"keepAliveWhileOpen,omitempty"`
+}
+
+// PullRequestCommandConfig represents a configuration of slash commands in pull request comments
+type PullRequestCommandConfig struct {
+	// AllowedUsers defines git users who are allowed to run `destroy`, `use` and `extend` commands,
+	// owners, members and collaborators of github repositories are always allowed
+	// +optional
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+	// MaxExtendDuration defines a maximum duration from now which the pull request environment
+	// can be extended by `extend` command, default is 24h
+	// +optional
+	MaxExtendDuration *metav1.Duration `json:"maxExtendDuration,omitempty"`
+}
+
 // PullRequestExtraConfig represents a pull request extra configuration
 type PullRequestExtraConfig struct {
 	// MaxRetry defines max retry counts of pull request component upgrade
@@ -660,6 +1274,12 @@ type ConfigPullRequest struct {
 	// Concurrences defines a parallel number of pull request queue
 	// +optional
 	Concurrences int `json:"concurrences,omitempty"`
+	// StateCheck represents a configuration of checking pull request states from the git provider
+	// +optional
+	StateCheck *PullRequestStateCheckConfig `json:"stateCheck,omitempty"`
+	// Commands represents a configuration of slash commands in pull request comments
+	// +optional
+	Commands *PullRequestCommandConfig `json:"commands,omitempty"`
 
 	PullRequestExtraConfig `json:",inline"`
 }
@@ -784,7 +1404,7 @@ func (cs *ConfigStatus) SetCondition(cond ConfigConditionType, status corev1.Con
 }
 
 // +k8s:deepcopy-gen=false
-//ComponentValues represents values of a component chart
+// ComponentValues represents values of a component chart
 type ComponentValues map[string]interface{}
 
 func (in *ComponentValues) DeepCopyInto(out *ComponentValues) {
@@ -804,6 +1424,39 @@ func (in *ComponentValues) DeepCopy() *ComponentValues {
 	return out
 }
 
+// +k8s:deepcopy-gen=false
+// PodTemplate represents a pod template in free form which is converted to corev1.PodTemplateSpec
+type PodTemplate map[string]interface{}
+
+func (in *PodTemplate) DeepCopyInto(out *PodTemplate) {
+	if in == nil {
+		*out = nil
+	} else {
+		*out = runtime.DeepCopyJSON(*in)
+	}
+}
+
+func (in *PodTemplate) DeepCopy() *PodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// PodTemplateSpec converts the pod template to corev1.PodTemplateSpec
+func (in PodTemplate) PodTemplateSpec() (corev1.PodTemplateSpec, error) {
+	spec := corev1.PodTemplateSpec{}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return spec, err
+	}
+
+	err = json.Unmarshal(b, &spec)
+	return spec, err
+}
+
 func init() {
 	SchemeBuilder.Register(&Config{}, &ConfigList{})
 }
@@ -1,9 +1,12 @@
 package v1_test
 
 import (
+	"time"
+
 	v1 "github.com/agoda-com/samsahai/api/v1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Config Overrider", func() {
@@ -202,4 +205,117 @@ var _ = Describe("Config Overrider", func() {
 			})
 		})
 	})
+
+	Describe("ConfigJenkinsOverrider", func() {
+		g := NewWithT(GinkgoT())
+		var overrider v1.ConfigJenkinsOverrider
+		var confJenkins *v1.ConfigJenkins
+		var beforeOverride *v1.ConfigJenkins
+		var res *v1.ConfigJenkins
+
+		BeforeEach(func() {
+			overrider = v1.ConfigJenkinsOverrider{}
+			confJenkins = nil
+			beforeOverride = nil
+			res = nil
+		})
+
+		It("should return new pointer if the old is nil", func() {
+			jobName := "team/regression"
+			branch := "PR-{{ .PRNumber }}"
+			overrider = v1.ConfigJenkinsOverrider{
+				JobName: &jobName,
+				Branch:  &branch,
+			}
+			res = overrider.Override(nil)
+			g.Expect(res).To(Equal(&v1.ConfigJenkins{
+				JobName: jobName,
+				Branch:  branch,
+			}))
+		})
+
+		Context("nothing to override", func() {
+			Specify("overridden is not nil", func() {
+				confJenkins = &v1.ConfigJenkins{
+					URL:     "https://jenkins.example.com",
+					JobName: "team/regression",
+				}
+				beforeOverride = confJenkins.DeepCopy()
+				res = overrider.Override(confJenkins)
+
+				// expect to not change anything
+				g.Expect(confJenkins).To(Equal(beforeOverride))
+				// expect to yield the input
+				g.Expect(res).To(BeIdenticalTo(confJenkins))
+			})
+		})
+
+		Context("there is something to override", func() {
+			Specify("override job name and merge parameters", func() {
+				jobName := "team/pr-regression"
+				overrider = v1.ConfigJenkinsOverrider{
+					JobName:    &jobName,
+					Parameters: map[string]string{"suite": "smoke"},
+				}
+				confJenkins = &v1.ConfigJenkins{
+					URL:        "https://jenkins.example.com",
+					JobName:    "team/regression",
+					Parameters: map[string]string{"suite": "full", "env": "staging"},
+				}
+				res = overrider.Override(confJenkins)
+				// expect to override fields
+				g.Expect(res).To(Equal(&v1.ConfigJenkins{
+					URL:        "https://jenkins.example.com",
+					JobName:    jobName,
+					Parameters: map[string]string{"suite": "smoke", "env": "staging"},
+				}))
+				// expect to yield the input pointer
+				g.Expect(res).To(BeIdenticalTo(confJenkins))
+			})
+		})
+	})
+
+	Describe("ConfigTestStage", func() {
+		g := NewWithT(GinkgoT())
+
+		It("should be required by default", func() {
+			notRequired := false
+			g.Expect(v1.ConfigTestStage{Name: "smoke"}.IsRequired()).To(BeTrue())
+			g.Expect(v1.ConfigTestStage{Name: "smoke", Required: &notRequired}.IsRequired()).To(BeFalse())
+		})
+
+		It("should correctly get test runner of the stage", func() {
+			parent := &v1.ConfigTestRunner{
+				Timeout:     metav1.Duration{Duration: 10 * time.Minute},
+				PollingTime: metav1.Duration{Duration: 10 * time.Second},
+			}
+			stage := v1.ConfigTestStage{
+				Name:     "smoke",
+				TestMock: &v1.ConfigTestMock{Result: true},
+			}
+
+			res := stage.GetTestRunner(parent)
+			g.Expect(res.TestMock).To(Equal(stage.TestMock))
+			g.Expect(res.TestMock).NotTo(BeIdenticalTo(stage.TestMock))
+			g.Expect(res.Timeout).To(Equal(parent.Timeout))
+			g.Expect(res.PollingTime).To(Equal(parent.PollingTime))
+
+			stage.Timeout = metav1.Duration{Duration: time.Minute}
+			res = stage.GetTestRunner(parent)
+			g.Expect(res.Timeout).To(Equal(stage.Timeout))
+		})
+
+		It("should replace stages when overriding test runner", func() {
+			parallel := true
+			overrider := v1.ConfigTestRunnerOverrider{
+				Stages:   []v1.ConfigTestStage{{Name: "regression"}},
+				Parallel: &parallel,
+			}
+			res := overrider.Override(&v1.ConfigTestRunner{
+				Stages: []v1.ConfigTestStage{{Name: "smoke"}, {Name: "e2e"}},
+			})
+			g.Expect(res.Stages).To(Equal([]v1.ConfigTestStage{{Name: "regression"}}))
+			g.Expect(res.Parallel).To(BeTrue())
+		})
+	})
 })
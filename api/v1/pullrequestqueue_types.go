@@ -85,6 +85,19 @@ type PullRequestQueueSpec struct {
 	// testRunner from config
 	// +optional
 	TestRunner *ConfigTestRunnerOverrider `json:"testRunner,omitempty"`
+
+	// RetestRequest represents a request for re-running the testing on the deployed pull request environment
+	// +optional
+	RetestRequest *PullRequestQueueRetestRequest `json:"retestRequest,omitempty"`
+}
+
+// PullRequestQueueRetestRequest defines a request for re-running the testing without redeploying
+type PullRequestQueueRetestRequest struct {
+	// RequestedBy represents a person who requested the retest
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+	// RequestedAt represents time at which the retest was requested
+	RequestedAt metav1.Time `json:"requestedAt"`
 }
 
 // PullRequestQueueConditionType represents a condition type of pull request queue
@@ -189,6 +202,29 @@ type PullRequestQueueStatus struct {
 	// DestroyedTime represents time at which the PR namespace will be destroyed
 	// +optional
 	DestroyedTime *metav1.Time `json:"destroyedTime,omitempty"`
+
+	// PreviewURLs represents endpoints of ingresses and load balancer services in the pull request namespace
+	// which have been discovered after the components were deployed
+	// +optional
+	PreviewURLs []PullRequestPreviewURL `json:"previewURLs,omitempty"`
+}
+
+// PullRequestPreviewKind represents a kind of object which exposes the preview url
+type PullRequestPreviewKind string
+
+const (
+	PullRequestPreviewIngress PullRequestPreviewKind = "Ingress"
+	PullRequestPreviewService PullRequestPreviewKind = "Service"
+)
+
+// PullRequestPreviewURL represents an endpoint of the pull request environment
+type PullRequestPreviewURL struct {
+	// Name represents a name of ingress or service
+	Name string `json:"name"`
+	// Kind represents a kind of object which exposes the url, Ingress or Service
+	Kind PullRequestPreviewKind `json:"kind"`
+	// URL represents an accessible url of the endpoint
+	URL string `json:"url"`
 }
 
 func (prqs *PullRequestQueueStatus) SetPullRequestNamespace(namespace string) {
@@ -214,6 +250,10 @@ func (prqs *PullRequestQueueStatus) SetDestroyedTime(t metav1.Time) {
 	prqs.DestroyedTime = &t
 }
 
+func (prqs *PullRequestQueueStatus) SetPreviewURLs(urls []PullRequestPreviewURL) {
+	prqs.PreviewURLs = urls
+}
+
 func (prqs *PullRequestQueueStatus) IsConditionTrue(cond PullRequestQueueConditionType) bool {
 	for i, c := range prqs.Conditions {
 		if c.Type == cond {
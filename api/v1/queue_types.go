@@ -110,6 +110,10 @@ type QueueSpec struct {
 	// NextProcessAt represents time to wait for process this queue
 	NextProcessAt *metav1.Time `json:"nextProcessAt,omitempty"`
 
+	// NoOfFailureRetry defines how many times this component has been retried for each failure class
+	// +optional
+	NoOfFailureRetry map[QueueFailureClass]int `json:"noOfFailureRetry,omitempty"`
+
 	// TeamName represents team owner of the queue
 	TeamName string `json:"teamName"`
 
@@ -124,6 +128,39 @@ type QueueSpec struct {
 	// QueueExtraParameters override default behavior of how to process this queue according to QueueType
 	// +optional
 	*QueueExtraParameters `json:"queueExtraParameters,omitempty"`
+
+	// Actions represents a list of actions which have been manually done on the queue
+	// +optional
+	Actions []QueueAction `json:"actions,omitempty"`
+}
+
+// QueueActionType represents a type of action which is manually done on the queue
+type QueueActionType string
+
+const (
+	// QueueActionEnqueue means the queue has been manually enqueued
+	QueueActionEnqueue QueueActionType = "enqueue"
+	// QueueActionMoveToTop means the queue has been moved to the top
+	QueueActionMoveToTop QueueActionType = "moveToTop"
+	// QueueActionCancel means the running queue has been canceled
+	QueueActionCancel QueueActionType = "cancel"
+	// QueueActionRemove means the waiting queue has been removed
+	QueueActionRemove QueueActionType = "remove"
+	// QueueActionReverify means the queue has been forced to reverify
+	QueueActionReverify QueueActionType = "reverify"
+)
+
+// QueueAction represents an action which is manually done on the queue
+type QueueAction struct {
+	// Type represents a type of the action
+	Type QueueActionType `json:"type"`
+
+	// By represents an actor who did the action
+	// +optional
+	By string `json:"by,omitempty"`
+
+	// At represents time when the action was done
+	At metav1.Time `json:"at"`
 }
 
 // QueueExtraParameters override default behavior of how to process this queue according to QueueType
@@ -172,6 +209,99 @@ type QueueCondition struct {
 type TestRunner struct {
 	Teamcity Teamcity `json:"teamcity,omitempty"`
 	Gitlab   Gitlab   `json:"gitlab,omitempty"`
+	// Rest represents a build of rest test runner
+	Rest Build `json:"rest,omitempty"`
+	// Jenkins represents a build of jenkins test runner
+	Jenkins Jenkins `json:"jenkins,omitempty"`
+	// K8sJob represents a job of k8sjob test runner
+	K8sJob K8sJob `json:"k8sJob,omitempty"`
+}
+
+// TestStageResult represents a result of test stage
+type TestStageResult string
+
+const (
+	TestStageRunning TestStageResult = "Running"
+	TestStagePassed  TestStageResult = "Passed"
+	TestStageFailed  TestStageResult = "Failed"
+	// TestStageSkipped means the stage has not been run because the previous required stage failed
+	TestStageSkipped TestStageResult = "Skipped"
+)
+
+// TestStage represents a status of named test stage
+type TestStage struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	// +optional
+	Result TestStageResult `json:"result,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// TestRunner represents builds of test runners of the stage
+	// +optional
+	TestRunner TestRunner `json:"testRunner,omitempty"`
+}
+
+// IsFinished returns true if the stage has been finished
+func (s TestStage) IsFinished() bool {
+	return s.Result == TestStagePassed || s.Result == TestStageFailed || s.Result == TestStageSkipped
+}
+
+// SetResult sets result of the stage and finished time
+func (s *TestStage) SetResult(result TestStageResult, message string) {
+	now := metav1.Now()
+	s.Result = result
+	s.Message = message
+	s.EndTime = &now
+}
+
+// TestReport represents a summary of test results
+type TestReport struct {
+	Total   int `json:"total"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+	// FailedTests represents names of failed tests, only top N names are stored
+	// +optional
+	FailedTests []string `json:"failedTests,omitempty"`
+}
+
+// Add adds test results of another report
+func (r *TestReport) Add(other *TestReport) {
+	if other == nil {
+		return
+	}
+
+	r.Total += other.Total
+	r.Failed += other.Failed
+	r.Skipped += other.Skipped
+	r.FailedTests = append(r.FailedTests, other.FailedTests...)
+}
+
+// TruncateFailedTests keeps only the first n names of failed tests
+func (r *TestReport) TruncateFailedTests(n int) {
+	if n >= 0 && len(r.FailedTests) > n {
+		r.FailedTests = r.FailedTests[:n]
+	}
+}
+
+type K8sJob struct {
+	JobName string `json:"jobName,omitempty"`
+}
+
+// Build represents a build of generic test runner
+type Build struct {
+	BuildID     string `json:"buildID,omitempty"`
+	BuildNumber string `json:"buildNumber,omitempty"`
+	BuildURL    string `json:"buildURL,omitempty"`
+}
+
+func (t *Build) SetBuild(buildID, buildNumber, buildURL string) {
+	t.BuildID = buildID
+	t.BuildNumber = buildNumber
+	t.BuildURL = buildURL
 }
 
 type Teamcity struct {
@@ -189,6 +319,26 @@ func (t *Teamcity) SetTeamcity(branch, buildID, buildTypeID, buildURL string) {
 	t.BuildURL = buildURL
 }
 
+type Jenkins struct {
+	JobName string `json:"jobName,omitempty"`
+	Branch  string `json:"branch,omitempty"`
+	// QueueItemURL is an url of the queue item which is resolved to the build
+	QueueItemURL string `json:"queueItemURL,omitempty"`
+	BuildNumber  string `json:"buildNumber,omitempty"`
+	BuildURL     string `json:"buildURL,omitempty"`
+}
+
+func (t *Jenkins) SetJenkins(jobName, branch, queueItemURL string) {
+	t.JobName = jobName
+	t.Branch = branch
+	t.QueueItemURL = queueItemURL
+}
+
+func (t *Jenkins) SetBuild(buildNumber, buildURL string) {
+	t.BuildNumber = buildNumber
+	t.BuildURL = buildURL
+}
+
 type Gitlab struct {
 	Branch         string `json:"branch,omitempty"`
 	PipelineID     string `json:"pipelineID,omitempty"`
@@ -260,6 +410,12 @@ const (
 	QueueTeamcityTestResult QueueConditionType = "QueueTeamcityTestResult"
 	// QueueGitlabTestResult means the test result of Gitlab
 	QueueGitlabTestResult QueueConditionType = "QueueGitlabTestResult"
+	// QueueRestTestResult means the test result of rest test runner
+	QueueRestTestResult QueueConditionType = "QueueRestTestResult"
+	// QueueJenkinsTestResult means the test result of Jenkins
+	QueueJenkinsTestResult QueueConditionType = "QueueJenkinsTestResult"
+	// QueueK8sJobTestResult means the test result of Kubernetes Job
+	QueueK8sJobTestResult QueueConditionType = "QueueK8sJobTestResult"
 	// QueueCleaningBeforeStarted means cleaning namespace before running task has been started
 	QueueCleaningBeforeStarted QueueConditionType = "QueueCleaningBeforeStarted"
 	// QueueCleanedBefore means the namespace has been cleaned before running task
@@ -309,6 +465,14 @@ type QueueStatus struct {
 	// TestRunner defines the test runner
 	TestRunner TestRunner `json:"testRunners,omitempty"`
 
+	// TestStages represents results of named test stages
+	// +optional
+	TestStages []TestStage `json:"testStages,omitempty"`
+
+	// TestReport represents a summary of test results
+	// +optional
+	TestReport *TestReport `json:"testReport,omitempty"`
+
 	// QueueHistoryName defines name of history of this queue
 	QueueHistoryName string `json:"queueHistoryName"`
 
@@ -324,6 +488,11 @@ type QueueStatus struct {
 
 	// DeployEngine represents engine using during installation
 	DeployEngine string `json:"deployEngine,omitempty"`
+
+	// DeployedWaves represents a number of deployment waves which have been deployed,
+	// components are deployed wave by wave following dependsOn of the components
+	// +optional
+	DeployedWaves int `json:"deployedWaves,omitempty"`
 }
 
 func (qs *QueueStatus) SetDeploymentIssues(deploymentIssues []DeploymentIssue) {
@@ -370,6 +539,17 @@ func (qs *QueueStatus) SetCondition(cond QueueConditionType, status corev1.Condi
 	})
 }
 
+// GetCondition returns the condition of the condition type, nil is returned if not found
+func (qs *QueueStatus) GetCondition(cond QueueConditionType) *QueueCondition {
+	for i := range qs.Conditions {
+		if qs.Conditions[i].Type == cond {
+			return &qs.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
 func (qs *QueueStatus) GetConditionLatestTime(cond QueueConditionType) *metav1.Time {
 	for _, c := range qs.Conditions {
 		if c.Type == cond {
@@ -407,6 +587,23 @@ func (q *Queue) ContainSameComponent(dName string, dComp *QueueComponent) bool {
 	return false
 }
 
+// AddAction records the action which is manually done on the queue
+func (q *Queue) AddAction(actionType QueueActionType, by string) {
+	q.Spec.Actions = append(q.Spec.Actions, QueueAction{
+		Type: actionType,
+		By:   by,
+		At:   metav1.Now(),
+	})
+}
+
+// GetLastAction returns the last action which has been manually done on the queue, nil if there is no action
+func (q *Queue) GetLastAction() *QueueAction {
+	if len(q.Spec.Actions) == 0 {
+		return nil
+	}
+	return &q.Spec.Actions[len(q.Spec.Actions)-1]
+}
+
 func (q *Queue) SetState(state QueueState) {
 	now := metav1.Now()
 	q.Status.UpdatedAt = &now
@@ -429,6 +626,18 @@ func (q *Queue) IsGitlabTestSuccess() bool {
 	return q.Status.IsConditionTrue(QueueGitlabTestResult)
 }
 
+func (q *Queue) IsRestTestSuccess() bool {
+	return q.Status.IsConditionTrue(QueueRestTestResult)
+}
+
+func (q *Queue) IsJenkinsTestSuccess() bool {
+	return q.Status.IsConditionTrue(QueueJenkinsTestResult)
+}
+
+func (q *Queue) IsK8sJobTestSuccess() bool {
+	return q.Status.IsConditionTrue(QueueK8sJobTestResult)
+}
+
 func (q *Queue) IsReverify() bool {
 	return q.Spec.Type == QueueTypeReverify
 }
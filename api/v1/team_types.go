@@ -69,6 +69,10 @@ type Credential struct {
 	// +optional
 	Teamcity *UsernamePasswordCredential `json:"teamcity,omitempty"`
 
+	// Jenkins represents a username and api token of Jenkins which are used by jenkins test runner
+	// +optional
+	Jenkins *UsernamePasswordCredential `json:"jenkins,omitempty"`
+
 	// Github
 	// +optional
 	Github *TokenCredential `json:"github,omitempty"`
@@ -76,6 +80,51 @@ type Credential struct {
 	// Gitlab
 	// +optional
 	Gitlab *TokenCredential `json:"gitlab,omitempty"`
+
+	// Bitbucket represents an http access token of Bitbucket Server
+	// +optional
+	Bitbucket *TokenCredential `json:"bitbucket,omitempty"`
+
+	// Gitea represents an access token of Gitea
+	// +optional
+	Gitea *TokenCredential `json:"gitea,omitempty"`
+
+	// Registries represents credentials of container registries which are used by registryv2 checker
+	// +optional
+	Registries []RegistryCredential `json:"registries,omitempty"`
+
+	// RestHeaders represents http headers e.g. Authorization which are sent by rest test runner
+	// +optional
+	RestHeaders []HeaderCredential `json:"restHeaders,omitempty"`
+
+	// APIToken represents a bearer token which is allowed to call mutating APIs of the team
+	// +optional
+	APIToken *TokenCredential `json:"apiToken,omitempty"`
+
+	// WebhookSecret represents a secret which is used for verifying github, bitbucket and gitea signatures
+	// and gitlab token of webhooks of the team, the webhook secret of samsahai is used if not specified
+	// +optional
+	WebhookSecret *TokenCredential `json:"webhookSecret,omitempty"`
+}
+
+// HeaderCredential represents a http header whose value is stored in the secret
+type HeaderCredential struct {
+	// Name is a name of http header
+	Name     string                    `json:"name"`
+	ValueRef *corev1.SecretKeySelector `json:"value"`
+	Value    string                    `json:"-"`
+}
+
+// RegistryCredential represents a username and password of a container registry
+type RegistryCredential struct {
+	// Server is a host of the registry e.g. ghcr.io
+	Server string `json:"server"`
+
+	// Insecure connects to the registry via plain http instead of https
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	UsernamePasswordCredential `json:",inline"`
 }
 
 type UsernamePasswordCredential struct {
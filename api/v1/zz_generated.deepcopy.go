@@ -221,6 +221,47 @@ func (in *ActivePromotionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionRollbackRequest) DeepCopyInto(out *ActivePromotionRollbackRequest) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionRollbackRequest.
+func (in *ActivePromotionRollbackRequest) DeepCopy() *ActivePromotionRollbackRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionRollbackRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionSchedule) DeepCopyInto(out *ActivePromotionSchedule) {
+	*out = *in
+	if in.Crons != nil {
+		in, out := &in.Crons, &out.Crons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlackoutDates != nil {
+		in, out := &in.BlackoutDates, &out.BlackoutDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionSchedule.
+func (in *ActivePromotionSchedule) DeepCopy() *ActivePromotionSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActivePromotionSpec) DeepCopyInto(out *ActivePromotionSpec) {
 	*out = *in
@@ -234,6 +275,11 @@ func (in *ActivePromotionSpec) DeepCopyInto(out *ActivePromotionSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RollbackRequest != nil {
+		in, out := &in.RollbackRequest, &out.RollbackRequest
+		*out = new(ActivePromotionRollbackRequest)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionSpec.
@@ -276,6 +322,14 @@ func (in *ActivePromotionStatus) DeepCopyInto(out *ActivePromotionStatus) {
 		}
 	}
 	in.PreActiveQueue.DeepCopyInto(&out.PreActiveQueue)
+	if in.TrafficShiftedAt != nil {
+		in, out := &in.TrafficShiftedAt, &out.TrafficShiftedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.HealthCheckedAt != nil {
+		in, out := &in.HealthCheckedAt, &out.HealthCheckedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]ActivePromotionCondition, len(*in))
@@ -295,6 +349,21 @@ func (in *ActivePromotionStatus) DeepCopy() *ActivePromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Build) DeepCopyInto(out *Build) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Build.
+func (in *Build) DeepCopy() *Build {
+	if in == nil {
+		return nil
+	}
+	out := new(Build)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ChartValuesURLs) DeepCopyInto(out *ChartValuesURLs) {
 	{
@@ -353,7 +422,7 @@ func (in *CommandAndArgs) DeepCopy() *CommandAndArgs {
 func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
 	out.Chart = in.Chart
-	out.Image = in.Image
+	in.Image.DeepCopyInto(&out.Image)
 	in.Values.DeepCopyInto(&out.Values)
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
@@ -376,6 +445,11 @@ func (in *Component) DeepCopyInto(out *Component) {
 			}
 		}
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
@@ -431,6 +505,11 @@ func (in *ComponentChart) DeepCopy() *ComponentChart {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentImage) DeepCopyInto(out *ComponentImage) {
 	*out = *in
+	if in.Ordering != nil {
+		in, out := &in.Ordering, &out.Ordering
+		*out = new(ImageOrdering)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImage.
@@ -492,6 +571,21 @@ func (in *ConfigActivePromotion) DeepCopyInto(out *ConfigActivePromotion) {
 		*out = new(ConfigDeploy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ActivePromotionSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficShift != nil {
+		in, out := &in.TrafficShift, &out.TrafficShift
+		*out = new(ConfigTrafficShift)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthWatch != nil {
+		in, out := &in.HealthWatch, &out.HealthWatch
+		*out = new(ConfigHealthWatch)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigActivePromotion.
@@ -661,6 +755,113 @@ func (in *ConfigGitlabOverrider) DeepCopy() *ConfigGitlabOverrider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigHealthWatch) DeepCopyInto(out *ConfigHealthWatch) {
+	*out = *in
+	out.Duration = in.Duration
+	out.Interval = in.Interval
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HealthWatchHTTP)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusQuery)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigHealthWatch.
+func (in *ConfigHealthWatch) DeepCopy() *ConfigHealthWatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigHealthWatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigJenkins) DeepCopyInto(out *ConfigJenkins) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigJenkins.
+func (in *ConfigJenkins) DeepCopy() *ConfigJenkins {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigJenkins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigJenkinsOverrider) DeepCopyInto(out *ConfigJenkinsOverrider) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.JobName != nil {
+		in, out := &in.JobName, &out.JobName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Branch != nil {
+		in, out := &in.Branch, &out.Branch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigJenkinsOverrider.
+func (in *ConfigJenkinsOverrider) DeepCopy() *ConfigJenkinsOverrider {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigJenkinsOverrider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigK8sJob) DeepCopyInto(out *ConfigK8sJob) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigK8sJob.
+func (in *ConfigK8sJob) DeepCopy() *ConfigK8sJob {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigK8sJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigList) DeepCopyInto(out *ConfigList) {
 	*out = *in
@@ -708,6 +909,16 @@ func (in *ConfigPullRequest) DeepCopyInto(out *ConfigPullRequest) {
 			}
 		}
 	}
+	if in.StateCheck != nil {
+		in, out := &in.StateCheck, &out.StateCheck
+		*out = new(PullRequestStateCheckConfig)
+		**out = **in
+	}
+	if in.Commands != nil {
+		in, out := &in.Commands, &out.Commands
+		*out = new(PullRequestCommandConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.PullRequestExtraConfig.DeepCopyInto(&out.PullRequestExtraConfig)
 }
 
@@ -779,6 +990,16 @@ func (in *ConfigReporter) DeepCopyInto(out *ConfigReporter) {
 		*out = new(ReporterGitlab)
 		**out = **in
 	}
+	if in.Bitbucket != nil {
+		in, out := &in.Bitbucket, &out.Bitbucket
+		*out = new(ReporterBitbucket)
+		**out = **in
+	}
+	if in.Gitea != nil {
+		in, out := &in.Gitea, &out.Gitea
+		*out = new(ReporterGitea)
+		**out = **in
+	}
 	if in.Rest != nil {
 		in, out := &in.Rest, &out.Rest
 		*out = new(ReporterRest)
@@ -789,6 +1010,11 @@ func (in *ConfigReporter) DeepCopyInto(out *ConfigReporter) {
 		*out = new(ReporterShell)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(ReporterEmail)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigReporter.
@@ -801,6 +1027,76 @@ func (in *ConfigReporter) DeepCopy() *ConfigReporter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRest) DeepCopyInto(out *ConfigRest) {
+	*out = *in
+	out.Trigger = in.Trigger
+	out.Status = in.Status
+	if in.FinishedValues != nil {
+		in, out := &in.FinishedValues, &out.FinishedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuccessValues != nil {
+		in, out := &in.SuccessValues, &out.SuccessValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRest.
+func (in *ConfigRest) DeepCopy() *ConfigRest {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRetryPolicy) DeepCopyInto(out *ConfigRetryPolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ConfigRetryRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRetryPolicy.
+func (in *ConfigRetryPolicy) DeepCopy() *ConfigRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRetryRule) DeepCopyInto(out *ConfigRetryRule) {
+	*out = *in
+	out.Backoff = in.Backoff
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRetryRule.
+func (in *ConfigRetryRule) DeepCopy() *ConfigRetryRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRetryRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigSpec) DeepCopyInto(out *ConfigSpec) {
 	*out = *in
@@ -900,6 +1196,11 @@ func (in *ConfigStaging) DeepCopyInto(out *ConfigStaging) {
 		*out = new(ConfigDeploy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(ConfigRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigStaging.
@@ -990,6 +1291,21 @@ func (in *ConfigTestMock) DeepCopy() *ConfigTestMock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTestReport) DeepCopyInto(out *ConfigTestReport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTestReport.
+func (in *ConfigTestReport) DeepCopy() *ConfigTestReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigTestReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigTestRunner) DeepCopyInto(out *ConfigTestRunner) {
 	*out = *in
@@ -1010,6 +1326,33 @@ func (in *ConfigTestRunner) DeepCopyInto(out *ConfigTestRunner) {
 		*out = new(ConfigTestMock)
 		**out = **in
 	}
+	if in.Rest != nil {
+		in, out := &in.Rest, &out.Rest
+		*out = new(ConfigRest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jenkins != nil {
+		in, out := &in.Jenkins, &out.Jenkins
+		*out = new(ConfigJenkins)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sJob != nil {
+		in, out := &in.K8sJob, &out.K8sJob
+		*out = new(ConfigK8sJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]ConfigTestStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TestReport != nil {
+		in, out := &in.TestReport, &out.TestReport
+		*out = new(ConfigTestReport)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTestRunner.
@@ -1050,6 +1393,38 @@ func (in *ConfigTestRunnerOverrider) DeepCopyInto(out *ConfigTestRunnerOverrider
 		*out = new(ConfigTestMock)
 		**out = **in
 	}
+	if in.Rest != nil {
+		in, out := &in.Rest, &out.Rest
+		*out = new(ConfigRest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jenkins != nil {
+		in, out := &in.Jenkins, &out.Jenkins
+		*out = new(ConfigJenkinsOverrider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sJob != nil {
+		in, out := &in.K8sJob, &out.K8sJob
+		*out = new(ConfigK8sJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]ConfigTestStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TestReport != nil {
+		in, out := &in.TestReport, &out.TestReport
+		*out = new(ConfigTestReport)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTestRunnerOverrider.
@@ -1062,6 +1437,83 @@ func (in *ConfigTestRunnerOverrider) DeepCopy() *ConfigTestRunnerOverrider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTestStage) DeepCopyInto(out *ConfigTestStage) {
+	*out = *in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+	out.Timeout = in.Timeout
+	if in.Gitlab != nil {
+		in, out := &in.Gitlab, &out.Gitlab
+		*out = new(ConfigGitlab)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Teamcity != nil {
+		in, out := &in.Teamcity, &out.Teamcity
+		*out = new(ConfigTeamcity)
+		**out = **in
+	}
+	if in.TestMock != nil {
+		in, out := &in.TestMock, &out.TestMock
+		*out = new(ConfigTestMock)
+		**out = **in
+	}
+	if in.Rest != nil {
+		in, out := &in.Rest, &out.Rest
+		*out = new(ConfigRest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jenkins != nil {
+		in, out := &in.Jenkins, &out.Jenkins
+		*out = new(ConfigJenkins)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.K8sJob != nil {
+		in, out := &in.K8sJob, &out.K8sJob
+		*out = new(ConfigK8sJob)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTestStage.
+func (in *ConfigTestStage) DeepCopy() *ConfigTestStage {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigTestStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTrafficShift) DeepCopyInto(out *ConfigTrafficShift) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	out.StepDuration = in.StepDuration
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusQuery)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTrafficShift.
+func (in *ConfigTrafficShift) DeepCopy() *ConfigTrafficShift {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigTrafficShift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Credential) DeepCopyInto(out *Credential) {
 	*out = *in
@@ -1070,6 +1522,11 @@ func (in *Credential) DeepCopyInto(out *Credential) {
 		*out = new(UsernamePasswordCredential)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Jenkins != nil {
+		in, out := &in.Jenkins, &out.Jenkins
+		*out = new(UsernamePasswordCredential)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Github != nil {
 		in, out := &in.Github, &out.Github
 		*out = new(TokenCredential)
@@ -1080,6 +1537,40 @@ func (in *Credential) DeepCopyInto(out *Credential) {
 		*out = new(TokenCredential)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bitbucket != nil {
+		in, out := &in.Bitbucket, &out.Bitbucket
+		*out = new(TokenCredential)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gitea != nil {
+		in, out := &in.Gitea, &out.Gitea
+		*out = new(TokenCredential)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]RegistryCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RestHeaders != nil {
+		in, out := &in.RestHeaders, &out.RestHeaders
+		*out = make([]HeaderCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.APIToken != nil {
+		in, out := &in.APIToken, &out.APIToken
+		*out = new(TokenCredential)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(TokenCredential)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
@@ -1096,7 +1587,7 @@ func (in *Credential) DeepCopy() *Credential {
 func (in *Dependency) DeepCopyInto(out *Dependency) {
 	*out = *in
 	out.Chart = in.Chart
-	out.Image = in.Image
+	in.Image.DeepCopyInto(&out.Image)
 	in.Values.DeepCopyInto(&out.Values)
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
@@ -1204,116 +1695,273 @@ func (in *DesiredComponentSpec) DeepCopyInto(out *DesiredComponentSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredComponentSpec.
-func (in *DesiredComponentSpec) DeepCopy() *DesiredComponentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredComponentSpec.
+func (in *DesiredComponentSpec) DeepCopy() *DesiredComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesiredComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesiredComponentStatus) DeepCopyInto(out *DesiredComponentStatus) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredComponentStatus.
+func (in *DesiredComponentStatus) DeepCopy() *DesiredComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesiredComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesiredImageTime) DeepCopyInto(out *DesiredImageTime) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(Image)
+		**out = **in
+	}
+	in.CreatedTime.DeepCopyInto(&out.CreatedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredImageTime.
+func (in *DesiredImageTime) DeepCopy() *DesiredImageTime {
+	if in == nil {
+		return nil
+	}
+	out := new(DesiredImageTime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailComponentUpgradeReport) DeepCopyInto(out *EmailComponentUpgradeReport) {
+	*out = *in
+	out.ConfigComponentUpgradeReport = in.ConfigComponentUpgradeReport
+	in.EmailReport.DeepCopyInto(&out.EmailReport)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailComponentUpgradeReport.
+func (in *EmailComponentUpgradeReport) DeepCopy() *EmailComponentUpgradeReport {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailComponentUpgradeReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailPullRequestQueueReport) DeepCopyInto(out *EmailPullRequestQueueReport) {
+	*out = *in
+	out.ConfigPullRequestQueueReport = in.ConfigPullRequestQueueReport
+	in.EmailReport.DeepCopyInto(&out.EmailReport)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailPullRequestQueueReport.
+func (in *EmailPullRequestQueueReport) DeepCopy() *EmailPullRequestQueueReport {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailPullRequestQueueReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailPullRequestTriggerReport) DeepCopyInto(out *EmailPullRequestTriggerReport) {
+	*out = *in
+	out.ConfigPullRequestTriggerReport = in.ConfigPullRequestTriggerReport
+	in.EmailReport.DeepCopyInto(&out.EmailReport)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailPullRequestTriggerReport.
+func (in *EmailPullRequestTriggerReport) DeepCopy() *EmailPullRequestTriggerReport {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailPullRequestTriggerReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailReport) DeepCopyInto(out *EmailReport) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailReport.
+func (in *EmailReport) DeepCopy() *EmailReport {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureComponent) DeepCopyInto(out *FailureComponent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureComponent.
+func (in *FailureComponent) DeepCopy() *FailureComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gitlab) DeepCopyInto(out *Gitlab) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gitlab.
+func (in *Gitlab) DeepCopy() *Gitlab {
 	if in == nil {
 		return nil
 	}
-	out := new(DesiredComponentSpec)
+	out := new(Gitlab)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DesiredComponentStatus) DeepCopyInto(out *DesiredComponentStatus) {
+func (in *HeaderCredential) DeepCopyInto(out *HeaderCredential) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = (*in).DeepCopy()
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredComponentStatus.
-func (in *DesiredComponentStatus) DeepCopy() *DesiredComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderCredential.
+func (in *HeaderCredential) DeepCopy() *HeaderCredential {
 	if in == nil {
 		return nil
 	}
-	out := new(DesiredComponentStatus)
+	out := new(HeaderCredential)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DesiredImageTime) DeepCopyInto(out *DesiredImageTime) {
+func (in *HealthWatchHTTP) DeepCopyInto(out *HealthWatchHTTP) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(Image)
-		**out = **in
-	}
-	in.CreatedTime.DeepCopyInto(&out.CreatedTime)
+	out.Timeout = in.Timeout
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredImageTime.
-func (in *DesiredImageTime) DeepCopy() *DesiredImageTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthWatchHTTP.
+func (in *HealthWatchHTTP) DeepCopy() *HealthWatchHTTP {
 	if in == nil {
 		return nil
 	}
-	out := new(DesiredImageTime)
+	out := new(HealthWatchHTTP)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
 	if in == nil {
 		return nil
 	}
-	out := new(Endpoint)
+	out := new(Image)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailureComponent) DeepCopyInto(out *FailureComponent) {
+func (in *ImageOrdering) DeepCopyInto(out *ImageOrdering) {
 	*out = *in
+	if in.CaptureGroups != nil {
+		in, out := &in.CaptureGroups, &out.CaptureGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureComponent.
-func (in *FailureComponent) DeepCopy() *FailureComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOrdering.
+func (in *ImageOrdering) DeepCopy() *ImageOrdering {
 	if in == nil {
 		return nil
 	}
-	out := new(FailureComponent)
+	out := new(ImageOrdering)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Gitlab) DeepCopyInto(out *Gitlab) {
+func (in *Jenkins) DeepCopyInto(out *Jenkins) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gitlab.
-func (in *Gitlab) DeepCopy() *Gitlab {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Jenkins.
+func (in *Jenkins) DeepCopy() *Jenkins {
 	if in == nil {
 		return nil
 	}
-	out := new(Gitlab)
+	out := new(Jenkins)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *K8sJob) DeepCopyInto(out *K8sJob) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sJob.
+func (in *K8sJob) DeepCopy() *K8sJob {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(K8sJob)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1379,6 +2027,21 @@ func (in *OutdatedNotification) DeepCopy() *OutdatedNotification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusQuery) DeepCopyInto(out *PrometheusQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusQuery.
+func (in *PrometheusQuery) DeepCopy() *PrometheusQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestBundle) DeepCopyInto(out *PullRequestBundle) {
 	*out = *in
@@ -1416,10 +2079,35 @@ func (in *PullRequestBundle) DeepCopy() *PullRequestBundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestCommandConfig) DeepCopyInto(out *PullRequestCommandConfig) {
+	*out = *in
+	if in.AllowedUsers != nil {
+		in, out := &in.AllowedUsers, &out.AllowedUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxExtendDuration != nil {
+		in, out := &in.MaxExtendDuration, &out.MaxExtendDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestCommandConfig.
+func (in *PullRequestCommandConfig) DeepCopy() *PullRequestCommandConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestCommandConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestComponent) DeepCopyInto(out *PullRequestComponent) {
 	*out = *in
-	out.Image = in.Image
+	in.Image.DeepCopyInto(&out.Image)
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
 		*out = new(UpdatingSource)
@@ -1470,6 +2158,21 @@ func (in *PullRequestExtraConfig) DeepCopy() *PullRequestExtraConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestPreviewURL) DeepCopyInto(out *PullRequestPreviewURL) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestPreviewURL.
+func (in *PullRequestPreviewURL) DeepCopy() *PullRequestPreviewURL {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestPreviewURL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestQueue) DeepCopyInto(out *PullRequestQueue) {
 	*out = *in
@@ -1660,6 +2363,22 @@ func (in *PullRequestQueueList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestQueueRetestRequest) DeepCopyInto(out *PullRequestQueueRetestRequest) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestQueueRetestRequest.
+func (in *PullRequestQueueRetestRequest) DeepCopy() *PullRequestQueueRetestRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestQueueRetestRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestQueueSpec) DeepCopyInto(out *PullRequestQueueSpec) {
 	*out = *in
@@ -1709,6 +2428,11 @@ func (in *PullRequestQueueSpec) DeepCopyInto(out *PullRequestQueueSpec) {
 		*out = new(ConfigTestRunnerOverrider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetestRequest != nil {
+		in, out := &in.RetestRequest, &out.RetestRequest
+		*out = new(PullRequestQueueRetestRequest)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestQueueSpec.
@@ -1748,6 +2472,11 @@ func (in *PullRequestQueueStatus) DeepCopyInto(out *PullRequestQueueStatus) {
 		in, out := &in.DestroyedTime, &out.DestroyedTime
 		*out = (*in).DeepCopy()
 	}
+	if in.PreviewURLs != nil {
+		in, out := &in.PreviewURLs, &out.PreviewURLs
+		*out = make([]PullRequestPreviewURL, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestQueueStatus.
@@ -1760,6 +2489,21 @@ func (in *PullRequestQueueStatus) DeepCopy() *PullRequestQueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestStateCheckConfig) DeepCopyInto(out *PullRequestStateCheckConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestStateCheckConfig.
+func (in *PullRequestStateCheckConfig) DeepCopy() *PullRequestStateCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestStateCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestTearDownDuration) DeepCopyInto(out *PullRequestTearDownDuration) {
 	*out = *in
@@ -1999,6 +2743,22 @@ func (in *Queue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueAction) DeepCopyInto(out *QueueAction) {
+	*out = *in
+	in.At.DeepCopyInto(&out.At)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueAction.
+func (in *QueueAction) DeepCopy() *QueueAction {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in QueueByNoOfOrder) DeepCopyInto(out *QueueByNoOfOrder) {
 	{
@@ -2273,11 +3033,25 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 		in, out := &in.NextProcessAt, &out.NextProcessAt
 		*out = (*in).DeepCopy()
 	}
+	if in.NoOfFailureRetry != nil {
+		in, out := &in.NoOfFailureRetry, &out.NoOfFailureRetry
+		*out = make(map[QueueFailureClass]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.QueueExtraParameters != nil {
 		in, out := &in.QueueExtraParameters, &out.QueueExtraParameters
 		*out = new(QueueExtraParameters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]QueueAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSpec.
@@ -2321,6 +3095,18 @@ func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 		}
 	}
 	out.TestRunner = in.TestRunner
+	if in.TestStages != nil {
+		in, out := &in.TestStages, &out.TestStages
+		*out = make([]TestStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TestReport != nil {
+		in, out := &in.TestReport, &out.TestReport
+		*out = new(TestReport)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeploymentIssues != nil {
 		in, out := &in.DeploymentIssues, &out.DeploymentIssues
 		*out = make([]DeploymentIssue, len(*in))
@@ -2345,6 +3131,22 @@ func (in *QueueStatus) DeepCopy() *QueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryCredential) DeepCopyInto(out *RegistryCredential) {
+	*out = *in
+	in.UsernamePasswordCredential.DeepCopyInto(&out.UsernamePasswordCredential)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCredential.
+func (in *RegistryCredential) DeepCopy() *RegistryCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportOption) DeepCopyInto(out *ReportOption) {
 	*out = *in
@@ -2360,6 +3162,91 @@ func (in *ReportOption) DeepCopy() *ReportOption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReporterBitbucket) DeepCopyInto(out *ReporterBitbucket) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReporterBitbucket.
+func (in *ReporterBitbucket) DeepCopy() *ReporterBitbucket {
+	if in == nil {
+		return nil
+	}
+	out := new(ReporterBitbucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReporterEmail) DeepCopyInto(out *ReporterEmail) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ComponentUpgrade != nil {
+		in, out := &in.ComponentUpgrade, &out.ComponentUpgrade
+		*out = new(EmailComponentUpgradeReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActivePromotion != nil {
+		in, out := &in.ActivePromotion, &out.ActivePromotion
+		*out = new(EmailReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageMissing != nil {
+		in, out := &in.ImageMissing, &out.ImageMissing
+		*out = new(EmailReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullRequestTrigger != nil {
+		in, out := &in.PullRequestTrigger, &out.PullRequestTrigger
+		*out = new(EmailPullRequestTriggerReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullRequestQueue != nil {
+		in, out := &in.PullRequestQueue, &out.PullRequestQueue
+		*out = new(EmailPullRequestQueueReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullRequestTestRunnerPending != nil {
+		in, out := &in.PullRequestTestRunnerPending, &out.PullRequestTestRunnerPending
+		*out = new(EmailReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveEnvironmentDeleted != nil {
+		in, out := &in.ActiveEnvironmentDeleted, &out.ActiveEnvironmentDeleted
+		*out = new(EmailReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReporterEmail.
+func (in *ReporterEmail) DeepCopy() *ReporterEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(ReporterEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReporterGitea) DeepCopyInto(out *ReporterGitea) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReporterGitea.
+func (in *ReporterGitea) DeepCopy() *ReporterGitea {
+	if in == nil {
+		return nil
+	}
+	out := new(ReporterGitea)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReporterGithub) DeepCopyInto(out *ReporterGithub) {
 	*out = *in
@@ -2578,6 +3465,21 @@ func (in *RestObject) DeepCopy() *RestObject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestRequest) DeepCopyInto(out *RestRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestRequest.
+func (in *RestRequest) DeepCopy() *RestRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RestRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StableComponent) DeepCopyInto(out *StableComponent) {
 	*out = *in
@@ -2926,11 +3828,34 @@ func (in *Teamcity) DeepCopy() *Teamcity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestReport) DeepCopyInto(out *TestReport) {
+	*out = *in
+	if in.FailedTests != nil {
+		in, out := &in.FailedTests, &out.FailedTests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestReport.
+func (in *TestReport) DeepCopy() *TestReport {
+	if in == nil {
+		return nil
+	}
+	out := new(TestReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestRunner) DeepCopyInto(out *TestRunner) {
 	*out = *in
 	out.Teamcity = in.Teamcity
 	out.Gitlab = in.Gitlab
+	out.Rest = in.Rest
+	out.Jenkins = in.Jenkins
+	out.K8sJob = in.K8sJob
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunner.
@@ -2943,6 +3868,30 @@ func (in *TestRunner) DeepCopy() *TestRunner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestStage) DeepCopyInto(out *TestStage) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	out.TestRunner = in.TestRunner
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestStage.
+func (in *TestStage) DeepCopy() *TestStage {
+	if in == nil {
+		return nil
+	}
+	out := new(TestStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenCredential) DeepCopyInto(out *TokenCredential) {
 	*out = *in
@@ -46,6 +46,7 @@ import (
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	docs2 "github.com/agoda-com/samsahai/docs"
 	s2h "github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/auth"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
 	"github.com/agoda-com/samsahai/internal/samsahai"
 	"github.com/agoda-com/samsahai/internal/samsahai/activepromotion"
@@ -54,6 +55,7 @@ import (
 	"github.com/agoda-com/samsahai/internal/stablecomponent"
 	"github.com/agoda-com/samsahai/internal/util"
 	"github.com/agoda-com/samsahai/internal/util/random"
+	"github.com/agoda-com/samsahai/internal/util/tracing"
 )
 
 var (
@@ -108,6 +110,12 @@ func startCtrlCmd() *cobra.Command {
 			//create metrics description
 			exporter.RegisterMetrics()
 
+			shutdownTracing, err := setupTracing("samsahai")
+			if err != nil {
+				logger.Error(err, "cannot set up tracing")
+				os.Exit(1)
+			}
+
 			logger.Debug(fmt.Sprintf("running on: %s", namespace))
 			// Get a config to talk to the apiserver
 			logger.Info("setting up client for manager")
@@ -139,9 +147,11 @@ func startCtrlCmd() *cobra.Command {
 			atpMaxRetry := viper.GetInt(s2h.VKActivePromotionMaxRetry)
 			configs := s2h.SamsahaiConfig{
 				// TODO: move to credential
-				TeamcityURL: viper.GetString(s2h.VKTeamcityURL),
-				GithubURL:   viper.GetString(s2h.VKGithubURL),
-				GitlabURL:   viper.GetString(s2h.VKGitlabURL),
+				TeamcityURL:  viper.GetString(s2h.VKTeamcityURL),
+				GithubURL:    viper.GetString(s2h.VKGithubURL),
+				GitlabURL:    viper.GetString(s2h.VKGitlabURL),
+				BitbucketURL: viper.GetString(s2h.VKBitbucketURL),
+				GiteaURL:     viper.GetString(s2h.VKGiteaURL),
 				SamsahaiURL: fmt.Sprintf("%s://%s.%s:%s",
 					viper.GetString(s2h.VKS2HServiceScheme),
 					viper.GetString(s2h.VKS2HServiceName),
@@ -150,6 +160,7 @@ func startCtrlCmd() *cobra.Command {
 				SamsahaiExternalURL: viper.GetString(s2h.VKS2HExternalURL),
 				SamsahaiImage:       viper.GetString(s2h.VKS2HImage),
 				ClusterDomain:       viper.GetString(s2h.VKClusterDomain),
+				InsecureRegistries:  viper.GetStringSlice(s2h.VKInsecureRegistries),
 				ActivePromotion: s2h.ActivePromotionConfig{
 					Concurrences:          viper.GetInt(s2h.VKActivePromotionConcurrences),
 					Timeout:               metav1.Duration{Duration: viper.GetDuration(s2h.VKActivePromotionTimeout)},
@@ -166,6 +177,7 @@ func startCtrlCmd() *cobra.Command {
 					MaxTriggerRetryCounts:      viper.GetInt(s2h.VKPRTriggerMaxRetry),
 					TriggerPollingTime:         metav1.Duration{Duration: viper.GetDuration(s2h.VKPRTriggerPollingTime)},
 					MaxHistoryDays:             viper.GetInt(s2h.VKPullRequestQueueMaxHistoryDays),
+					StateCheckInterval:         metav1.Duration{Duration: viper.GetDuration(s2h.VKPRStateCheckInterval)},
 				},
 				SamsahaiCredential: s2h.SamsahaiCredential{
 					InternalAuthToken: authToken,
@@ -174,6 +186,9 @@ func startCtrlCmd() *cobra.Command {
 					TeamcityUsername:  viper.GetString(s2h.VKTeamcityUsername),
 					TeamcityPassword:  viper.GetString(s2h.VKTeamcityPassword),
 					GitlabToken:       viper.GetString(s2h.VKGitlabToken),
+					BitbucketToken:    viper.GetString(s2h.VKBitbucketToken),
+					GiteaToken:        viper.GetString(s2h.VKGiteaToken),
+					WebhookSecret:     viper.GetString(s2h.VKS2HWebhookSecret),
 					MSTeams: s2h.MSTeamsCredential{
 						TenantID:     viper.GetString(s2h.VKMSTeamsTenantID),
 						ClientID:     viper.GetString(s2h.VKMSTeamsClientID),
@@ -215,7 +230,7 @@ func startCtrlCmd() *cobra.Command {
 				//The url pointing to API definition"
 			))
 
-			mux.Handle("/", s2hhttp.New(s2hCtrl))
+			mux.Handle("/", s2hhttp.New(s2hCtrl, httpOptions(mgr, configs)...))
 			httpServer := http.Server{Handler: mux, Addr: ":" + httpServerPort}
 
 			logger.Info("starting http server")
@@ -243,6 +258,10 @@ func startCtrlCmd() *cobra.Command {
 			if err := httpServer.Shutdown(context.TODO()); err != nil {
 				logger.Error(err, "http server shutdown")
 			}
+
+			if err := shutdownTracing(context.TODO()); err != nil {
+				logger.Error(err, "tracing shutdown")
+			}
 		},
 	}
 
@@ -254,6 +273,8 @@ func startCtrlCmd() *cobra.Command {
 	cmd.Flags().String(s2h.VKPodNamespace, "default", "Namespace that the controller works on.")
 	cmd.Flags().String(s2h.VKS2HConfigPath, "samsahai.yaml", "Samsahai configuration file path.")
 	cmd.Flags().String(s2h.VKClusterDomain, "cluster.local", "Internal domain of the cluster.")
+	cmd.Flags().StringSlice(s2h.VKInsecureRegistries, nil,
+		"Container registries which are connected via plain http by registryv2 checker.")
 	cmd.Flags().String(s2h.VKServerHTTPPort, s2h.SamsahaiDefaultPort, "The port for http server to listens to.")
 	cmd.Flags().String(s2h.VKMetricHTTPPort, "8081", "The port for prometheus metric to binds to.")
 	cmd.Flags().String(s2h.VKS2HAuthToken, "<random>", "Samsahai server authentication token.")
@@ -262,10 +283,21 @@ func startCtrlCmd() *cobra.Command {
 	cmd.Flags().String(s2h.VKS2HServiceScheme, "http", "Scheme to use for connecting to Samsahai.")
 	cmd.Flags().String(s2h.VKS2HServiceName, "samsahai", "Service name for connecting to Samsahai.")
 	cmd.Flags().String(s2h.VKS2HExternalURL, "http://localhost:8080", "External url for Samsahai.")
+	cmd.Flags().String(s2h.VKS2HWebhookSecret, "",
+		"Secret for verifying github signature and gitlab token of webhooks which do not belong to any teams.")
+	cmd.Flags().Bool(s2h.VKAPIAuthEnabled, false,
+		"Require authentication for mutating http apis.")
+	cmd.Flags().Bool(s2h.VKAPIAuthReadOnlyPublic, true,
+		"Allow read-only http apis to be public when authentication is enabled.")
 	cmd.Flags().String(s2h.VKGithubToken, "", "Github access token for publishing commit status into github.")
 	cmd.Flags().String(s2h.VKGitlabToken, "", "Gitlab access token for publishing commit status into gitlab.")
 	cmd.Flags().String(s2h.VKGitlabURL, "", "Gitlab base URL used for initializing Gitlab reporter.")
 	cmd.Flags().String(s2h.VKGithubURL, "", "Github base URL used for initializing Github reporter.")
+	cmd.Flags().String(s2h.VKBitbucketToken, "",
+		"Bitbucket Server http access token for publishing build status into bitbucket.")
+	cmd.Flags().String(s2h.VKBitbucketURL, "", "Bitbucket Server base URL used for initializing Bitbucket reporter.")
+	cmd.Flags().String(s2h.VKGiteaToken, "", "Gitea access token for publishing commit status into gitea.")
+	cmd.Flags().String(s2h.VKGiteaURL, "", "Gitea base URL used for initializing Gitea reporter.")
 	cmd.Flags().String(s2h.VKTeamcityURL, "",
 		"Teamcity base URL used for initializing Teamcity test runner.")
 	cmd.Flags().String(s2h.VKTeamcityUsername, "",
@@ -298,6 +330,8 @@ func startCtrlCmd() *cobra.Command {
 	cmd.Flags().Int(s2h.VKPRVerificationMaxRetry, 0, "Max pull request verification retry counts.")
 	cmd.Flags().Duration(s2h.VKPRTriggerPollingTime, 5*time.Minute,
 		"Waiting duration time to re-check pull request image in the registry.")
+	cmd.Flags().Duration(s2h.VKPRStateCheckInterval, 5*time.Minute,
+		"Duration time to re-check states of pull requests from the git providers.")
 	cmd.Flags().Int(s2h.VKPullRequestQueueMaxHistoryDays, 7,
 		"Max stored pull request queue histories in day.")
 	cmd.Flags().String(s2h.VKCheckerCPU, "100m",
@@ -308,9 +342,49 @@ func startCtrlCmd() *cobra.Command {
 		"Required minimum cpu of resources quota which will be used for mock deployment engine.")
 	cmd.Flags().String(s2h.VKInitialResourcesQuotaMemory, "3Gi",
 		"Required minimum memory of resources quota which will be used for mock deployment engine.")
+	cmd.Flags().String(s2h.VKTracingExporter, "",
+		"Exporter of traces, 'otlp' or 'stdout'. Tracing is disabled if empty.")
+	cmd.Flags().String(s2h.VKTracingOTLPEndpoint, "",
+		"Host and port of OTLP collector for exporting traces e.g. localhost:4318.")
+	cmd.Flags().Bool(s2h.VKTracingOTLPInsecure, false, "Export traces to OTLP collector without TLS.")
 	return cmd
 }
 
+// httpOptions returns webhook and authentication options of http apis
+func httpOptions(mgr manager.Manager, configs s2h.SamsahaiConfig) []s2hhttp.Option {
+	cred := configs.SamsahaiCredential
+	opts := []s2hhttp.Option{s2hhttp.WithWebhookSecret(cred.WebhookSecret)}
+	if !viper.GetBool(s2h.VKAPIAuthEnabled) {
+		logger.Warn(fmt.Sprintf("authentication of http apis is disabled, mutating http apis are public, "+
+			"set --%s to protect them", s2h.VKAPIAuthEnabled))
+		return opts
+	}
+
+	opts = append(opts,
+		s2hhttp.WithAuthenticators(
+			auth.NewInternalToken(cred.InternalAuthToken),
+			auth.NewTeamToken(),
+			auth.NewGithubSignature(cred.WebhookSecret),
+			auth.NewGitlabToken(cred.WebhookSecret),
+			auth.NewBitbucketSignature(cred.WebhookSecret),
+			auth.NewGiteaSignature(cred.WebhookSecret),
+			auth.NewKubernetes(mgr.GetClient()),
+		),
+	)
+	if !viper.GetBool(s2h.VKAPIAuthReadOnlyPublic) {
+		opts = append(opts, s2hhttp.WithReadOnlyAuthPolicy(s2hhttp.AuthPolicyAuthenticated))
+	}
+
+	return opts
+}
+
+// setupTracing sets up exporting traces of the service from the tracing configuration
+func setupTracing(serviceName string) (tracing.ShutdownFunc, error) {
+	return tracing.Setup(context.Background(), serviceName,
+		tracing.WithExporter(tracing.ExporterType(viper.GetString(s2h.VKTracingExporter))),
+		tracing.WithOTLPEndpoint(viper.GetString(s2h.VKTracingOTLPEndpoint), viper.GetBool(s2h.VKTracingOTLPInsecure)))
+}
+
 func getVersion() string {
 	return fmt.Sprintf("v%s (commit:%s)", s2h.Version, s2h.GitCommit)
 }
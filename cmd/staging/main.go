@@ -45,6 +45,7 @@ import (
 	"github.com/agoda-com/samsahai/internal/queue"
 	stagingctrl "github.com/agoda-com/samsahai/internal/staging"
 	"github.com/agoda-com/samsahai/internal/util"
+	"github.com/agoda-com/samsahai/internal/util/tracing"
 	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
 )
 
@@ -105,6 +106,12 @@ func startCtrlCmd() *cobra.Command {
 			httpMetricPort := viper.GetString(s2h.VKMetricHTTPPort)
 			teamName := viper.GetString(s2h.VKS2HTeamName)
 
+			shutdownTracing, err := setupTracing(s2h.StagingCtrlName)
+			if err != nil {
+				logger.Error(err, "cannot set up tracing")
+				os.Exit(1)
+			}
+
 			logger.Debug(fmt.Sprintf("running on: %s", namespace))
 			// Get a config to talk to the apiserver
 			logger.Info("setting up client for manager")
@@ -138,7 +145,8 @@ func startCtrlCmd() *cobra.Command {
 
 			logger.Info("setting up internal components")
 
-			samsahaiClient := rpc.NewRPCProtobufClient(viper.GetString(s2h.VKS2HServerURL), &http.Client{})
+			samsahaiClient := rpc.NewRPCProtobufClient(viper.GetString(s2h.VKS2HServerURL),
+				&http.Client{Transport: tracing.NewTransport(nil)})
 			configCtrl := configctrl.New(mgr)
 			queueCtrl := queue.New(namespace, runtimeClient)
 			authToken := viper.GetString(s2h.VKS2HAuthToken)
@@ -187,6 +195,10 @@ func startCtrlCmd() *cobra.Command {
 				logger.Error(err, "http server shutdown")
 			}
 
+			if err := shutdownTracing(context.Background()); err != nil {
+				logger.Error(err, "tracing shutdown")
+			}
+
 			return nil
 		},
 	}
@@ -203,6 +215,11 @@ func startCtrlCmd() *cobra.Command {
 	cmd.Flags().String(s2h.VKServerHTTPPort, "8090", "The port for http server to listens to.")
 	cmd.Flags().String(s2h.VKMetricHTTPPort, "8091", "The port for prometheus metric to binds to.")
 	cmd.Flags().Int(s2h.VKQueueMaxHistoryDays, 7, "Max stored queue histories in day.")
+	cmd.Flags().String(s2h.VKTracingExporter, "",
+		"Exporter of traces, 'otlp' or 'stdout'. Tracing is disabled if empty.")
+	cmd.Flags().String(s2h.VKTracingOTLPEndpoint, "",
+		"Host and port of OTLP collector for exporting traces e.g. localhost:4318.")
+	cmd.Flags().Bool(s2h.VKTracingOTLPInsecure, false, "Export traces to OTLP collector without TLS.")
 
 	return cmd
 }
@@ -215,6 +232,13 @@ func checkRequiredConfig(name string) (string, error) {
 	return v, nil
 }
 
+// setupTracing sets up exporting traces of the service from the tracing configuration
+func setupTracing(serviceName string) (tracing.ShutdownFunc, error) {
+	return tracing.Setup(context.Background(), serviceName,
+		tracing.WithExporter(tracing.ExporterType(viper.GetString(s2h.VKTracingExporter))),
+		tracing.WithOTLPEndpoint(viper.GetString(s2h.VKTracingOTLPEndpoint), viper.GetBool(s2h.VKTracingOTLPInsecure)))
+}
+
 func versionCmd() *cobra.Command {
 	isShortVersion := false
 	cmd := &cobra.Command{
@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2022-10-27 17:25:07.465703 +0700 +07 m=+0.264431515
+// 2026-10-17 04:04:12.349526164 +0000 UTC m=+0.133029465
 
 package docs
 
@@ -169,6 +169,120 @@ var doc = `{
                         }
                     }
                 }
+            },
+            "post": {
+                "description": "Creates a new active promotion of the team, the caller is recorded as a person who promoted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Trigger Active Promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Active promotion options",
+                        "name": "triggerActivePromotionJSON",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.triggerActivePromotionJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Active promotion already exists",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancels the waiting or running active promotion of the team.\nActive promotion which is destroying namespaces or rolling back cannot be canceled.",
+                "tags": [
+                    "DELETE"
+                ],
+                "summary": "Cancel Active Promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or active promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Active promotion cannot be canceled",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
             }
         },
         "/teams/{team}/activepromotions/histories": {
@@ -320,6 +434,56 @@ var doc = `{
                 }
             }
         },
+        "/teams/{team}/activepromotions/rollback": {
+            "post": {
+                "description": "Switches the active environment back to the previous active namespace\nwhile it has not been destroyed yet after promoting successfully.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Rollback Active Promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or active promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Active promotion cannot be rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
         "/teams/{team}/components": {
             "get": {
                 "description": "Returns list of components of team",
@@ -725,6 +889,67 @@ var doc = `{
                         }
                     }
                 }
+            },
+            "post": {
+                "description": "Manually adds a specific component version to the staging queue.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Enqueue Component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Component version",
+                        "name": "enqueueComponentJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.enqueueComponentJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or component not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
             }
         },
         "/teams/{team}/queue/histories/{queue}": {
@@ -817,49 +1042,33 @@ var doc = `{
                 }
             }
         },
-        "/version": {
-            "get": {
-                "description": "Get service version information.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "GET"
-                ],
-                "summary": "Service Version",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/webhook.versionJSON"
-                        }
-                    }
-                }
-            }
-        },
-        "/webhook/component": {
-            "post": {
-                "description": "Endpoint for manually triggering new component update",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/teams/{team}/queue/{queue}": {
+            "delete": {
+                "description": "Removes the waiting queue from the staging queue.",
                 "tags": [
-                    "POST"
+                    "DELETE"
                 ],
-                "summary": "Webhook New Component",
+                "summary": "Remove Queue",
                 "parameters": [
                     {
-                        "description": "New Component",
-                        "name": "newComponentEventJSON",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/webhook.newComponentEventJSON"
-                        }
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -869,17 +1078,477 @@ var doc = `{
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid JSON",
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Queue is running",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "/teams/{team}/queue/{queue}/cancel": {
+            "post": {
+                "description": "Cancels the running queue.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Cancel Queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Queue is not running",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/{queue}/reverify": {
+            "post": {
+                "description": "Forces the waiting queue to be reverified at first.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Reverify Queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Queue is running",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/{queue}/top": {
+            "post": {
+                "description": "Moves the waiting queue to the top of the staging queue.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Move Queue To Top",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action by, ignored if the request is authenticated",
+                        "name": "action_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "409": {
+                        "description": "Queue is running",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Get service version information.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Service Version",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.versionJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/bitbucket": {
+            "post": {
+                "description": "Receives native bitbucket server ` + "`" + `pr:opened` + "`" + `, ` + "`" + `pr:from_ref_updated` + "`" + `, ` + "`" + `pr:merged` + "`" + `, ` + "`" + `pr:declined` + "`" + `\nand ` + "`" + `pr:deleted` + "`" + ` events of repositories which are configured in pull request bundles.\nRepositories are matched by ` + "`" + `\u003cproject key\u003e/\u003crepository slug\u003e` + "`" + ` of the target repository.\nNew commits are deployed to pull request environments, merged or declined pull requests are destroyed.\n` + "`" + `X-Hub-Signature` + "`" + ` is verified with the webhook secret of the team or samsahai.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook For Bitbucket Server Pull Request Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bitbucket Server event key",
+                        "name": "X-Event-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bitbucket Server signature",
+                        "name": "X-Hub-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/component": {
+            "post": {
+                "description": "Endpoint for manually triggering new component update",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook New Component",
+                "parameters": [
+                    {
+                        "description": "New Component",
+                        "name": "newComponentEventJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.newComponentEventJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/gitea": {
+            "post": {
+                "description": "Receives native gitea ` + "`" + `pull_request` + "`" + ` events of repositories which are configured in pull request bundles.\nNew commits are deployed to pull request environments, closed pull requests are destroyed.\n` + "`" + `X-Gitea-Signature` + "`" + ` is verified with the webhook secret of the team or samsahai.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook For Gitea Pull Request Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gitea event type",
+                        "name": "X-Gitea-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gitea signature",
+                        "name": "X-Gitea-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/github": {
+            "post": {
+                "description": "Receives native github ` + "`" + `pull_request` + "`" + ` and ` + "`" + `issue_comment` + "`" + ` events of repositories\nwhich are configured in pull request bundles.\nNew commits are deployed to pull request environments, closed pull requests are destroyed.\nPull request comments with slash commands e.g. ` + "`" + `/s2h redeploy` + "`" + `, ` + "`" + `/s2h retest` + "`" + `, ` + "`" + `/s2h extend 4h` + "`" + `,\n` + "`" + `/s2h destroy` + "`" + ` and ` + "`" + `/s2h use svc-a=1.2.3` + "`" + ` control the pull request environments.\n` + "`" + `X-Hub-Signature-256` + "`" + ` is verified with the webhook secret of the team or samsahai.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook For GitHub Pull Request Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GitHub event type",
+                        "name": "X-GitHub-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "GitHub signature",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/gitlab": {
+            "post": {
+                "description": "Receives native gitlab ` + "`" + `Merge Request Hook` + "`" + ` and ` + "`" + `Note Hook` + "`" + ` events of projects\nwhich are configured in pull request bundles.\nNew commits are deployed to pull request environments, closed or merged requests are destroyed.\nMerge request comments with slash commands control the pull request environments.\n` + "`" + `X-Gitlab-Token` + "`" + ` is verified with the webhook secret of the team or samsahai.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook For GitLab Merge Request Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GitLab event type",
+                        "name": "X-Gitlab-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "GitLab secret token",
+                        "name": "X-Gitlab-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid secret token",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
         "v1.ActivePromotion": {
             "type": "object",
             "properties": {
@@ -951,6 +1620,46 @@ var doc = `{
         "v1.ActivePromotionHistoryStatus": {
             "type": "object"
         },
+        "v1.ActivePromotionRollbackRequest": {
+            "type": "object",
+            "properties": {
+                "requestedAt": {
+                    "description": "RequestedAt represents time at which the rollback was requested",
+                    "type": "string"
+                },
+                "requestedBy": {
+                    "description": "RequestedBy represents a person who requested the rollback\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ActivePromotionSchedule": {
+            "type": "object",
+            "properties": {
+                "blackoutDates": {
+                    "description": "BlackoutDates defines dates in YYYY-MM-DD format which the scheduled promotions are skipped\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "crons": {
+                    "description": "Crons defines cron expressions of the promotion times e.g. \"0 6 * * 1-5\" for every weekday at 06:00",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "onlyIfStableComponentsChanged": {
+                    "description": "OnlyIfStableComponentsChanged defines a flag for skipping the scheduled promotion\nif stable components have not been changed since the last promotion\n+optional",
+                    "type": "boolean"
+                },
+                "timezone": {
+                    "description": "Timezone defines IANA timezone of the cron expressions e.g. \"Asia/Bangkok\", default is UTC\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ActivePromotionSpec": {
             "type": "object",
             "properties": {
@@ -966,6 +1675,11 @@ var doc = `{
                     "description": "PromotedBy represents a person who promoted the ActivePromotion\n+optional",
                     "type": "string"
                 },
+                "rollbackRequest": {
+                    "description": "RollbackRequest represents a request for rolling back to the previous active namespace\nwhich has not been destroyed yet\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionRollbackRequest"
+                },
                 "skipTestRunner": {
                     "description": "SkipTestRunner represents a flag for skipping running pre-active test\n+optional",
                     "type": "boolean"
@@ -1006,6 +1720,14 @@ var doc = `{
                     "description": "HasOutdatedComponent defines whether current active promotion has outdated component or not\n+optional",
                     "type": "boolean"
                 },
+                "healthCheckedAt": {
+                    "description": "HealthCheckedAt represents the latest time when health of the new active namespace was checked\n+optional",
+                    "type": "string"
+                },
+                "healthWatchFailures": {
+                    "description": "HealthWatchFailures represents no. of consecutive failed health checks of the new active namespace\n+optional",
+                    "type": "integer"
+                },
                 "isTimeout": {
                     "description": "IsTimeout defines whether the active promotion has been timeout or not\n+optional",
                     "type": "boolean"
@@ -1047,12 +1769,34 @@ var doc = `{
                     "description": "TargetNamespace represents a pre-active namespace\n+optional",
                     "type": "string"
                 },
+                "trafficShiftedAt": {
+                    "description": "TrafficShiftedAt represents time when traffic was shifted to the current weight\n+optional",
+                    "type": "string"
+                },
+                "trafficWeight": {
+                    "description": "TrafficWeight represents percentage of traffic shifted to the pre-active namespace\n+optional",
+                    "type": "integer"
+                },
                 "updatedAt": {
                     "description": "UpdatedAt represents time at which the active promotion finished\n+optional",
                     "type": "string"
                 }
             }
         },
+        "v1.Build": {
+            "type": "object",
+            "properties": {
+                "buildID": {
+                    "type": "string"
+                },
+                "buildNumber": {
+                    "type": "string"
+                },
+                "buildURL": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.CommandAndArgs": {
             "type": "object",
             "properties": {
@@ -1085,6 +1829,13 @@ var doc = `{
                         "$ref": "#/definitions/v1.Dependency"
                     }
                 },
+                "dependsOn": {
+                    "description": "DependsOn defines names of top-level components which have to be deployed and ready\nbefore this component is deployed\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "image": {
                     "type": "object",
                     "$ref": "#/definitions/v1.ComponentImage"
@@ -1132,6 +1883,15 @@ var doc = `{
         "v1.ComponentImage": {
             "type": "object",
             "properties": {
+                "constraint": {
+                    "description": "Constraint is a semantic version constraint of tag, e.g. ` + "`" + `\u003e=2.3 \u003c3` + "`" + `,\ntags which are not semantic versions will be ignored,\npre-release tags satisfy only the constraint which includes pre-release, e.g. ` + "`" + `\u003e=2.3.0-0` + "`" + `\n+optional",
+                    "type": "string"
+                },
+                "ordering": {
+                    "description": "Ordering defines how matched tags are ordered to choose the desired version,\nthe latest one will be chosen\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ImageOrdering"
+                },
                 "pattern": {
                     "description": "+optional",
                     "type": "string"
@@ -1163,6 +1923,11 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigDeploy"
                 },
+                "healthWatch": {
+                    "description": "HealthWatch defines a configuration for watching health of the new active namespace after promoting\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigHealthWatch"
+                },
                 "maxHistories": {
                     "description": "MaxHistories defines maximum length of ActivePromotionHistory stored per team\n+optional",
                     "type": "integer"
@@ -1184,6 +1949,11 @@ var doc = `{
                     "description": "RollbackTimeout defines maximum duration for rolling back active promotion\n+optional",
                     "type": "string"
                 },
+                "schedule": {
+                    "description": "Schedule defines times for creating active promotions automatically\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionSchedule"
+                },
                 "tearDownDuration": {
                     "description": "TearDownDuration defines duration before teardown the previous active namespace\n+optional",
                     "type": "string"
@@ -1191,6 +1961,11 @@ var doc = `{
                 "timeout": {
                     "description": "Timeout defines maximum duration for doing active promotion\n+optional",
                     "type": "string"
+                },
+                "trafficShift": {
+                    "description": "TrafficShift defines a configuration for shifting traffic to the new active namespace progressively,\nthe new active namespace is always promoted before demoting the current active namespace\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTrafficShift"
                 }
             }
         },
@@ -1235,7 +2010,7 @@ var doc = `{
                     "type": "string"
                 },
                 "engine": {
-                    "description": "Engine defines method of deploying\n\nmock - for test only, always return success\n\nhelm3 - deploy chart with helm3\n+optional",
+                    "description": "Engine defines method of deploying\n\nmock - for test only, always return success\n\nhelm3 - deploy chart with helm3\n\nmanifest - deploy kustomize base or raw manifests of chart repository with server-side apply\n+optional",
                     "type": "string"
                 },
                 "testRunner": {
@@ -1289,6 +2064,89 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigHealthWatch": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "Duration defines observation period after promoting, default is 10m,\nthe previous active namespace is not destroyed until the end of the period\n+optional",
+                    "type": "string"
+                },
+                "failureThreshold": {
+                    "description": "FailureThreshold defines no. of consecutive failed health checks before rolling back, default is 3\n+optional",
+                    "type": "integer"
+                },
+                "http": {
+                    "description": "HTTP defines an http endpoint of the new active namespace which has to respond successfully\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.HealthWatchHTTP"
+                },
+                "interval": {
+                    "description": "Interval defines duration between health checks, default is 30s\n+optional",
+                    "type": "string"
+                },
+                "prometheus": {
+                    "description": "Prometheus defines a query of the error rate of the new active namespace\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.PrometheusQuery"
+                }
+            }
+        },
+        "v1.ConfigJenkins": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "description": "Branch is a branch name of multibranch pipeline job, supports ` + "`" + `{{ .PRNumber }}` + "`" + ` template\n+optional",
+                    "type": "string"
+                },
+                "jobName": {
+                    "description": "JobName is a full name of the parameterized job, folders are separated by ` + "`" + `/` + "`" + ` e.g. ` + "`" + `team/regression` + "`" + `",
+                    "type": "string"
+                },
+                "parameters": {
+                    "description": "Parameters defines additional build parameters, supports ` + "`" + `{{ .PRNumber }}` + "`" + ` template\n+optional",
+                    "type": "object"
+                },
+                "url": {
+                    "description": "URL is a base url of Jenkins e.g. https://jenkins.example.com",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConfigJenkinsOverrider": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "jobName": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "parameters": {
+                    "description": "+optional",
+                    "type": "object"
+                },
+                "url": {
+                    "description": "+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConfigK8sJob": {
+            "type": "object",
+            "properties": {
+                "backoffLimit": {
+                    "description": "BackoffLimit is a number of retries before marking the job failed, default is 0\n+optional",
+                    "type": "integer"
+                },
+                "template": {
+                    "description": "Template is a pod template of the job,\nthe namespace and queue components are injected as env vars into all containers\n+kubebuilder:pruning:PreserveUnknownFields",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.PodTemplate"
+                }
+            }
+        },
         "v1.ConfigPullRequest": {
             "type": "object",
             "properties": {
@@ -1299,6 +2157,11 @@ var doc = `{
                         "$ref": "#/definitions/v1.PullRequestBundle"
                     }
                 },
+                "commands": {
+                    "description": "Commands represents a configuration of slash commands in pull request comments\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.PullRequestCommandConfig"
+                },
                 "concurrences": {
                     "description": "Concurrences defines a parallel number of pull request queue\n+optional",
                     "type": "integer"
@@ -1315,6 +2178,11 @@ var doc = `{
                     "description": "Resources represents how many resources of pull request namespace\n+optional",
                     "type": "string"
                 },
+                "stateCheck": {
+                    "description": "StateCheck represents a configuration of checking pull request states from the git provider\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.PullRequestStateCheckConfig"
+                },
                 "tearDownDuration": {
                     "description": "TearDownDuration defines duration before teardown the pull request components\n+optional",
                     "type": "object",
@@ -1360,11 +2228,26 @@ var doc = `{
         "v1.ConfigReporter": {
             "type": "object",
             "properties": {
+                "bitbucket": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ReporterBitbucket"
+                },
                 "cmd": {
                     "description": "+optional",
                     "type": "object",
                     "$ref": "#/definitions/v1.ReporterShell"
                 },
+                "email": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ReporterEmail"
+                },
+                "gitea": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ReporterGitea"
+                },
                 "github": {
                     "description": "+optional",
                     "type": "object",
@@ -1403,6 +2286,92 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigRest": {
+            "type": "object",
+            "properties": {
+                "buildIDPath": {
+                    "description": "BuildIDPath is a json path expression of build id in the trigger response e.g. ` + "`" + `{.id}` + "`" + `",
+                    "type": "string"
+                },
+                "buildNumberPath": {
+                    "description": "BuildNumberPath is a json path expression of build number in the trigger response,\nbuild id is used if not defined\n+optional",
+                    "type": "string"
+                },
+                "buildURLPath": {
+                    "description": "BuildURLPath is a json path expression of build url in the trigger response\n+optional",
+                    "type": "string"
+                },
+                "finishedPath": {
+                    "description": "FinishedPath is a json path expression of the status response which tells the test has finished",
+                    "type": "string"
+                },
+                "finishedValues": {
+                    "description": "FinishedValues are values of FinishedPath which mean the test has finished,\nany value except empty, ` + "`" + `false` + "`" + ` and ` + "`" + `null` + "`" + ` is considered as finished if not defined\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "description": "Headers defines http headers of requests,\nthe headers from ` + "`" + `restHeaders` + "`" + ` of team credential will be added\n+optional",
+                    "type": "object"
+                },
+                "status": {
+                    "description": "Status defines a request for getting the test status, default method is GET",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.RestRequest"
+                },
+                "successPath": {
+                    "description": "SuccessPath is a json path expression of the status response which tells the test has passed",
+                    "type": "string"
+                },
+                "successValues": {
+                    "description": "SuccessValues are values of SuccessPath which mean the test has passed, default is ` + "`" + `true` + "`" + `\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "trigger": {
+                    "description": "Trigger defines a request for triggering the test, default method is POST",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.RestRequest"
+                }
+            }
+        },
+        "v1.ConfigRetryPolicy": {
+            "type": "object",
+            "properties": {
+                "retestOnly": {
+                    "description": "RetestOnly re-runs only the testing without redeploying\nif the testing failed and the environment is healthy\n+optional",
+                    "type": "boolean"
+                },
+                "rules": {
+                    "description": "Rules defines retry counts and backoff of failure classes\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ConfigRetryRule"
+                    }
+                }
+            }
+        },
+        "v1.ConfigRetryRule": {
+            "type": "object",
+            "properties": {
+                "backoff": {
+                    "description": "Backoff defines a duration to wait before retrying, the duration is doubled every retry\n+optional",
+                    "type": "string"
+                },
+                "failure": {
+                    "description": "+kubebuilder:validation:Enum=ImagePullBackOff;CrashLoopBackOff;DeployTimeout;DeployFailed;TestFailed;TestTimeout",
+                    "type": "string"
+                },
+                "maxRetry": {
+                    "description": "MaxRetry defines max retry counts of the failure class",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.ConfigSpec": {
             "type": "object",
             "properties": {
@@ -1463,6 +2432,10 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigDeploy"
                 },
+                "incremental": {
+                    "description": "Incremental enables incremental deployment of the upgrade queue,\nthe namespace is kept between queues and only releases which values or chart version changed are upgraded.\nThe namespace is fully cleaned up if the previous queue failed, the environment is unhealthy or on reverify\n+optional",
+                    "type": "boolean"
+                },
                 "maxHistoryDays": {
                     "description": "MaxHistoryDays defines maximum days of QueueHistory stored\n+optional",
                     "type": "integer"
@@ -1470,6 +2443,11 @@ var doc = `{
                 "maxRetry": {
                     "description": "MaxRetry defines max retry counts of component upgrade\n+optional",
                     "type": "integer"
+                },
+                "retryPolicy": {
+                    "description": "RetryPolicy defines retry counts and backoff of each failure class,\nMaxRetry is used for failures which do not match any rule\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigRetryPolicy"
                 }
             }
         },
@@ -1505,6 +2483,27 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigTestReport": {
+            "type": "object",
+            "properties": {
+                "gitlabArtifactPath": {
+                    "description": "GitlabArtifactPath defines a path of JUnit XML report in job artifacts of the GitLab pipeline\n+optional",
+                    "type": "string"
+                },
+                "maxFailedTests": {
+                    "description": "MaxFailedTests defines a number of failed test names to be stored, default is 10\n+optional",
+                    "type": "integer"
+                },
+                "teamcity": {
+                    "description": "Teamcity collects test occurrences of the Teamcity build\n+optional",
+                    "type": "boolean"
+                },
+                "url": {
+                    "description": "URL defines a url of JUnit XML report, the url is rendered with the queue\ne.g. {{ .Status.TestRunner.Jenkins.BuildURL }}/artifact/junit.xml\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ConfigTestRunner": {
             "type": "object",
             "properties": {
@@ -1513,10 +2512,36 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigGitlab"
                 },
+                "jenkins": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigJenkins"
+                },
+                "k8sJob": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigK8sJob"
+                },
+                "parallel": {
+                    "description": "Parallel runs all stages at the same time, otherwise stages are run in order\n+optional",
+                    "type": "boolean"
+                },
                 "pollingTime": {
                     "description": "+optional",
                     "type": "string"
                 },
+                "rest": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigRest"
+                },
+                "stages": {
+                    "description": "Stages defines an ordered list of named test stages,\nthe test runners above are ignored if stages are defined\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ConfigTestStage"
+                    }
+                },
                 "teamcity": {
                     "description": "+optional",
                     "type": "object",
@@ -1527,6 +2552,11 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigTestMock"
                 },
+                "testReport": {
+                    "description": "TestReport defines how to collect test results after testing\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTestReport"
+                },
                 "timeout": {
                     "description": "+optional",
                     "type": "string"
@@ -1541,10 +2571,36 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigGitlabOverrider"
                 },
+                "jenkins": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigJenkinsOverrider"
+                },
+                "k8sJob": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigK8sJob"
+                },
+                "parallel": {
+                    "description": "+optional",
+                    "type": "boolean"
+                },
                 "pollingTime": {
                     "description": "+optional",
                     "type": "string"
                 },
+                "rest": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigRest"
+                },
+                "stages": {
+                    "description": "+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ConfigTestStage"
+                    }
+                },
                 "teamcity": {
                     "description": "+optional",
                     "type": "object",
@@ -1555,15 +2611,102 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigTestMock"
                 },
+                "testReport": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTestReport"
+                },
                 "timeout": {
                     "description": "+optional",
                     "type": "string"
                 }
             }
         },
+        "v1.ConfigTestStage": {
+            "type": "object",
+            "properties": {
+                "gitlab": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigGitlab"
+                },
+                "jenkins": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigJenkins"
+                },
+                "k8sJob": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigK8sJob"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "required": {
+                    "description": "Required tells the queue testing fails if the stage fails, default is true\n+optional",
+                    "type": "boolean"
+                },
+                "rest": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigRest"
+                },
+                "teamcity": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTeamcity"
+                },
+                "testMock": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTestMock"
+                },
+                "timeout": {
+                    "description": "Timeout defines a timeout of the stage, the timeout of test runner is used if not defined\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConfigTrafficShift": {
+            "type": "object",
+            "properties": {
+                "prometheus": {
+                    "description": "Prometheus defines a query of the error rate of the new active namespace\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.PrometheusQuery"
+                },
+                "stepDuration": {
+                    "description": "StepDuration defines duration of each step before checking the error rate and shifting to the next step,\ndefault is 5m, the duration of all steps is added to the active promotion timeout\n+optional",
+                    "type": "string"
+                },
+                "steps": {
+                    "description": "Steps defines percentages of traffic shifted to the new active namespace in order e.g. [10, 50],\nall traffic is shifted to the new active namespace after the last step",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "v1.Credential": {
             "type": "object",
             "properties": {
+                "apiToken": {
+                    "description": "APIToken represents a bearer token which is allowed to call mutating APIs of the team\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TokenCredential"
+                },
+                "bitbucket": {
+                    "description": "Bitbucket represents an http access token of Bitbucket Server\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TokenCredential"
+                },
+                "gitea": {
+                    "description": "Gitea represents an access token of Gitea\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TokenCredential"
+                },
                 "github": {
                     "description": "Github\n+optional",
                     "type": "object",
@@ -1574,6 +2717,25 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.TokenCredential"
                 },
+                "jenkins": {
+                    "description": "Jenkins represents a username and api token of Jenkins which are used by jenkins test runner\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.UsernamePasswordCredential"
+                },
+                "registries": {
+                    "description": "Registries represents credentials of container registries which are used by registryv2 checker\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.RegistryCredential"
+                    }
+                },
+                "restHeaders": {
+                    "description": "RestHeaders represents http headers e.g. Authorization which are sent by rest test runner\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.HeaderCredential"
+                    }
+                },
                 "secretName": {
                     "description": "SecretName",
                     "type": "string"
@@ -1582,6 +2744,11 @@ var doc = `{
                     "description": "Teamcity\n+optional",
                     "type": "object",
                     "$ref": "#/definitions/v1.UsernamePasswordCredential"
+                },
+                "webhookSecret": {
+                    "description": "WebhookSecret represents a secret which is used for verifying github, bitbucket and gitea signatures\nand gitlab token of webhooks of the team, the webhook secret of samsahai is used if not specified\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TokenCredential"
                 }
             }
         },
@@ -1638,6 +2805,86 @@ var doc = `{
                 }
             }
         },
+        "v1.EmailComponentUpgradeReport": {
+            "type": "object",
+            "properties": {
+                "criteria": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "extraMessage": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "interval": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "To represents recipients of the event, the default recipients will be used if empty\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.EmailPullRequestQueueReport": {
+            "type": "object",
+            "properties": {
+                "criteria": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "extraMessage": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "interval": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "To represents recipients of the event, the default recipients will be used if empty\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.EmailPullRequestTriggerReport": {
+            "type": "object",
+            "properties": {
+                "criteria": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "extraMessage": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "To represents recipients of the event, the default recipients will be used if empty\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.EmailReport": {
+            "type": "object",
+            "properties": {
+                "to": {
+                    "description": "To represents recipients of the event, the default recipients will be used if empty\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "v1.Endpoint": {
             "type": "object",
             "properties": {
@@ -1684,6 +2931,31 @@ var doc = `{
                 }
             }
         },
+        "v1.HeaderCredential": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is a name of http header",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.HealthWatchHTTP": {
+            "type": "object",
+            "properties": {
+                "timeout": {
+                    "description": "Timeout defines maximum duration of the health check request, default is 10s\n+optional",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL defines the health check url, {{ .Namespace }} is replaced by the new active namespace\ne.g. http://wordpress.{{ .Namespace }}/healthz",
+                    "type": "string"
+                }
+            }
+        },
         "v1.Image": {
             "type": "object",
             "properties": {
@@ -1695,6 +2967,51 @@ var doc = `{
                 }
             }
         },
+        "v1.ImageOrdering": {
+            "type": "object",
+            "properties": {
+                "captureGroups": {
+                    "description": "CaptureGroups defines names or indexes of capture groups in the pattern, which are compared in order,\nnumbers are compared numerically and the others are compared alphabetically,\nused by ` + "`" + `capture-group` + "`" + ` strategy\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "strategy": {
+                    "description": "Strategy defines how tags are ordered\n- \"\" (default)\n- semver\n- semver-with-prerelease\n- lexical\n- created-time\n- capture-group\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.Jenkins": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "buildNumber": {
+                    "type": "string"
+                },
+                "buildURL": {
+                    "type": "string"
+                },
+                "jobName": {
+                    "type": "string"
+                },
+                "queueItemURL": {
+                    "description": "QueueItemURL is an url of the queue item which is resolved to the build",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.K8sJob": {
+            "type": "object",
+            "properties": {
+                "jobName": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.MSTeamsGroup": {
             "type": "object",
             "properties": {
@@ -1709,16 +3026,39 @@ var doc = `{
                 }
             }
         },
-        "v1.OutdatedNotification": {
+        "v1.OutdatedNotification": {
+            "type": "object",
+            "properties": {
+                "exceedDuration": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "excludeWeekendCalculation": {
+                    "description": "+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.PodTemplate": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "object"
+            }
+        },
+        "v1.PrometheusQuery": {
             "type": "object",
             "properties": {
-                "exceedDuration": {
-                    "description": "+optional",
+                "query": {
+                    "description": "Query defines a PromQL query of the error rate,\n{{ .Namespace }} is replaced by the new active namespace",
                     "type": "string"
                 },
-                "excludeWeekendCalculation": {
-                    "description": "+optional",
-                    "type": "boolean"
+                "threshold": {
+                    "description": "Threshold defines maximum error rate e.g. \"0.05\",\nthe active promotion is rolled back if the query result exceeds the threshold",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL defines the Prometheus server url e.g. http://prometheus.monitoring:9090",
+                    "type": "string"
                 }
             }
         },
@@ -1749,7 +3089,7 @@ var doc = `{
                     "type": "string"
                 },
                 "gitRepository": {
-                    "description": "GitRepository represents a string of git repository \"\u003cowner\u003e/\u003crepository\u003e\" e.g., agoda-com/samsahai\nused for publishing commit status\n+optional",
+                    "description": "GitRepository represents a string of git repository \"\u003cowner\u003e/\u003crepository\u003e\" e.g., agoda-com/samsahai\nused for publishing commit status, \"\u003cproject key\u003e/\u003crepository slug\u003e\" is used for Bitbucket Server\n+optional",
                     "type": "string"
                 },
                 "maxRetry": {
@@ -1771,6 +3111,22 @@ var doc = `{
                 }
             }
         },
+        "v1.PullRequestCommandConfig": {
+            "type": "object",
+            "properties": {
+                "allowedUsers": {
+                    "description": "AllowedUsers defines git users who are allowed to run ` + "`" + `destroy` + "`" + `, ` + "`" + `use` + "`" + ` and ` + "`" + `extend` + "`" + ` commands,\nowners, members and collaborators of github repositories are always allowed\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxExtendDuration": {
+                    "description": "MaxExtendDuration defines a maximum duration from now which the pull request environment\ncan be extended by ` + "`" + `extend` + "`" + ` command, default is 24h\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.PullRequestComponent": {
             "type": "object",
             "properties": {
@@ -1802,6 +3158,23 @@ var doc = `{
                 }
             }
         },
+        "v1.PullRequestPreviewURL": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "Kind represents a kind of object which exposes the url, Ingress or Service",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name represents a name of ingress or service",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL represents an accessible url of the endpoint",
+                    "type": "string"
+                }
+            }
+        },
         "v1.PullRequestQueue": {
             "type": "object",
             "properties": {
@@ -1863,6 +3236,19 @@ var doc = `{
         "v1.PullRequestQueueHistoryStatus": {
             "type": "object"
         },
+        "v1.PullRequestQueueRetestRequest": {
+            "type": "object",
+            "properties": {
+                "requestedAt": {
+                    "description": "RequestedAt represents time at which the retest was requested",
+                    "type": "string"
+                },
+                "requestedBy": {
+                    "description": "RequestedBy represents a person who requested the retest\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.PullRequestQueueSpec": {
             "type": "object",
             "properties": {
@@ -1914,6 +3300,11 @@ var doc = `{
                     "description": "PRTriggerFinishedAt represents time when pull request trigger has been finish\n+optional",
                     "type": "string"
                 },
+                "retestRequest": {
+                    "description": "RetestRequest represents a request for re-running the testing on the deployed pull request environment\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.PullRequestQueueRetestRequest"
+                },
                 "teamName": {
                     "description": "TeamName represents team owner of the pull request queue",
                     "type": "string"
@@ -1962,6 +3353,13 @@ var doc = `{
                     "description": "DestroyedTime represents time at which the PR namespace will be destroyed\n+optional",
                     "type": "string"
                 },
+                "previewURLs": {
+                    "description": "PreviewURLs represents endpoints of ingresses and load balancer services in the pull request namespace\nwhich have been discovered after the components were deployed\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PullRequestPreviewURL"
+                    }
+                },
                 "pullRequestNamespace": {
                     "description": "PullRequestNamespace represents a current pull request namespace",
                     "type": "string"
@@ -1984,6 +3382,23 @@ var doc = `{
                 }
             }
         },
+        "v1.PullRequestStateCheckConfig": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "description": "Enabled defines whether the pull request environments are destroyed\nonce their pull requests have been closed or merged\n+optional",
+                    "type": "boolean"
+                },
+                "keepAliveWhileOpen": {
+                    "description": "KeepAliveWhileOpen defines whether the tearDownDuration of pull request environments\nis extended while their pull requests are still open\n+optional",
+                    "type": "boolean"
+                },
+                "provider": {
+                    "description": "Provider defines a git provider which pull requests are queried from",
+                    "type": "string"
+                }
+            }
+        },
         "v1.PullRequestTearDownDuration": {
             "type": "object",
             "properties": {
@@ -2023,6 +3438,23 @@ var doc = `{
                 }
             }
         },
+        "v1.QueueAction": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "description": "At represents time when the action was done",
+                    "type": "string"
+                },
+                "by": {
+                    "description": "By represents an actor who did the action\n+optional",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type represents a type of the action",
+                    "type": "string"
+                }
+            }
+        },
         "v1.QueueComponent": {
             "type": "object",
             "properties": {
@@ -2133,6 +3565,13 @@ var doc = `{
         "v1.QueueSpec": {
             "type": "object",
             "properties": {
+                "actions": {
+                    "description": "Actions represents a list of actions which have been manually done on the queue\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.QueueAction"
+                    }
+                },
                 "bundle": {
                     "description": "Bundle represents a bundle name of component\n+optional",
                     "type": "string"
@@ -2150,6 +3589,10 @@ var doc = `{
                     "description": "NextProcessAt represents time to wait for process this queue",
                     "type": "string"
                 },
+                "noOfFailureRetry": {
+                    "description": "NoOfFailureRetry defines how many times this component has been retried for each failure class\n+optional",
+                    "type": "object"
+                },
                 "noOfOrder": {
                     "description": "NoOfOrder defines the position in queue\nlower is will be picked first",
                     "type": "integer"
@@ -2199,6 +3642,10 @@ var doc = `{
                     "description": "DeployEngine represents engine using during installation",
                     "type": "string"
                 },
+                "deployedWaves": {
+                    "description": "DeployedWaves represents a number of deployment waves which have been deployed,\ncomponents are deployed wave by wave following dependsOn of the components\n+optional",
+                    "type": "integer"
+                },
                 "deploymentIssues": {
                     "description": "DeploymentIssues defines a list of deployment issue types\n+optional",
                     "type": "array",
@@ -2241,17 +3688,48 @@ var doc = `{
                     "description": "State represents current status of this queue",
                     "type": "string"
                 },
+                "testReport": {
+                    "description": "TestReport represents a summary of test results\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TestReport"
+                },
                 "testRunners": {
                     "description": "TestRunner defines the test runner",
                     "type": "object",
                     "$ref": "#/definitions/v1.TestRunner"
                 },
+                "testStages": {
+                    "description": "TestStages represents results of named test stages\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TestStage"
+                    }
+                },
                 "updatedAt": {
                     "description": "UpdatedAt represents time when the component was processed",
                     "type": "string"
                 }
             }
         },
+        "v1.RegistryCredential": {
+            "type": "object",
+            "properties": {
+                "insecure": {
+                    "description": "Insecure connects to the registry via plain http instead of https\n+optional",
+                    "type": "boolean"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "server": {
+                    "description": "Server is a host of the registry e.g. ghcr.io",
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.ReportOption": {
             "type": "object",
             "properties": {
@@ -2263,6 +3741,91 @@ var doc = `{
                 }
             }
         },
+        "v1.ReporterBitbucket": {
+            "type": "object",
+            "properties": {
+                "baseURL": {
+                    "description": "BaseURL represents a bitbucket server base url e.g., https://bitbucket.example.com\n+optional",
+                    "type": "string"
+                },
+                "enabled": {
+                    "description": "Enabled represents an enabled flag\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.ReporterEmail": {
+            "type": "object",
+            "properties": {
+                "activeEnvironmentDeleted": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailReport"
+                },
+                "activePromotion": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailReport"
+                },
+                "componentUpgrade": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailComponentUpgradeReport"
+                },
+                "from": {
+                    "description": "From represents a sender email address",
+                    "type": "string"
+                },
+                "imageMissing": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailReport"
+                },
+                "port": {
+                    "description": "Port represents a SMTP server port",
+                    "type": "integer"
+                },
+                "pullRequestQueue": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailPullRequestQueueReport"
+                },
+                "pullRequestTestRunnerPending": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailReport"
+                },
+                "pullRequestTrigger": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.EmailPullRequestTriggerReport"
+                },
+                "server": {
+                    "description": "Server represents a SMTP server host",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "To represents default recipients of every event\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.ReporterGitea": {
+            "type": "object",
+            "properties": {
+                "baseURL": {
+                    "description": "BaseURL represents a gitea base url e.g., https://gitea.com\n+optional",
+                    "type": "string"
+                },
+                "enabled": {
+                    "description": "Enabled represents an enabled flag\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
         "v1.ReporterGithub": {
             "type": "object",
             "properties": {
@@ -2426,6 +3989,22 @@ var doc = `{
                 }
             }
         },
+        "v1.RestRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is a go template of request body\n+optional",
+                    "type": "string"
+                },
+                "method": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.StableComponent": {
             "type": "object",
             "properties": {
@@ -2642,6 +4221,27 @@ var doc = `{
                 }
             }
         },
+        "v1.TestReport": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "failedTests": {
+                    "description": "FailedTests represents names of failed tests, only top N names are stored\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "v1.TestRunner": {
             "type": "object",
             "properties": {
@@ -2649,12 +4249,59 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.Gitlab"
                 },
+                "jenkins": {
+                    "description": "Jenkins represents a build of jenkins test runner",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.Jenkins"
+                },
+                "k8sJob": {
+                    "description": "K8sJob represents a job of k8sjob test runner",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.K8sJob"
+                },
+                "rest": {
+                    "description": "Rest represents a build of rest test runner",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.Build"
+                },
                 "teamcity": {
                     "type": "object",
                     "$ref": "#/definitions/v1.Teamcity"
                 }
             }
         },
+        "v1.TestStage": {
+            "type": "object",
+            "properties": {
+                "endTime": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "result": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "testRunner": {
+                    "description": "TestRunner represents builds of test runners of the stage\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TestRunner"
+                }
+            }
+        },
         "v1.TokenCredential": {
             "type": "object",
             "properties": {
@@ -2730,6 +4377,21 @@ var doc = `{
                 }
             }
         },
+        "webhook.enqueueComponentJSON": {
+            "type": "object",
+            "properties": {
+                "atTop": {
+                    "description": "+optional",
+                    "type": "boolean"
+                },
+                "component": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
         "webhook.errResp": {
             "type": "object",
             "properties": {
@@ -2826,6 +4488,13 @@ var doc = `{
                             "$ref": "#/definitions/v1.Dependency"
                         }
                     },
+                    "dependsOn": {
+                        "description": "DependsOn defines names of top-level components which have to be deployed and ready\nbefore this component is deployed\n+optional",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    },
                     "image": {
                         "type": "object",
                         "$ref": "#/definitions/v1.ComponentImage"
@@ -2985,6 +4654,24 @@ var doc = `{
                 }
             }
         },
+        "webhook.triggerActivePromotionJSON": {
+            "type": "object",
+            "properties": {
+                "noDowntimeGuarantee": {
+                    "description": "+optional",
+                    "type": "boolean"
+                },
+                "skipTestRunner": {
+                    "description": "+optional",
+                    "type": "boolean"
+                },
+                "tearDownDuration": {
+                    "description": "+optional",
+                    "type": "string",
+                    "example": "30m"
+                }
+            }
+        },
         "webhook.versionJSON": {
             "type": "object",
             "properties": {
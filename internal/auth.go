@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"net/http"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+// Authenticator authenticates and authorizes http requests to samsahai api
+type Authenticator interface {
+	// GetName returns name of the authenticator
+	GetName() string
+
+	// Authenticate verifies credentials of the request and sets the user of the request if succeeded,
+	// ErrAuthTokenNotFound is returned if the request does not contain credentials of the authenticator
+	Authenticate(req *AuthRequest) error
+}
+
+// AuthRequest represents a http request to be authenticated
+type AuthRequest struct {
+	// Request is an incoming http request
+	Request *http.Request
+
+	// Body is a body of the request which is used for verifying webhook signature
+	Body []byte
+
+	// Team is a team which is accessed by the request, nil if the route does not belong to any teams.
+	// Credentials of the team have been loaded from the team secret.
+	Team *s2hv1.Team
+
+	// Verb is a kubernetes verb of the request e.g. get, create, delete
+	Verb string
+
+	// Webhook is true if the route receives webhooks,
+	// webhook signatures and tokens are accepted only on webhook routes
+	Webhook bool
+
+	// User is an identity of the caller which is set by the authenticator after authenticated
+	User string
+}
@@ -0,0 +1,171 @@
+package auth_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2h "github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/auth"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestUnit(t *testing.T) {
+	unittest.InitGinkgo(t, "Authenticators")
+}
+
+var _ = Describe("Authenticators", func() {
+	g := NewWithT(GinkgoT())
+
+	newTeam := func(apiToken, webhookSecret string) *s2hv1.Team {
+		team := &s2hv1.Team{ObjectMeta: metav1.ObjectMeta{Name: "teamtest"}}
+		team.Status.Used.Credential.APIToken = &s2hv1.TokenCredential{Token: apiToken}
+		team.Status.Used.Credential.WebhookSecret = &s2hv1.TokenCredential{Token: webhookSecret}
+		return team
+	}
+
+	newRequest := func(team *s2hv1.Team, body string, headers map[string]string) *s2h.AuthRequest {
+		r := httptest.NewRequest(http.MethodPost, "/teams/teamtest/pullrequest/trigger", nil)
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		return &s2h.AuthRequest{Request: r, Body: []byte(body), Team: team, Verb: "create", Webhook: true}
+	}
+
+	sign := func(secret, body string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write([]byte(body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	It("should correctly verify internal token", func() {
+		a := auth.NewInternalToken("123456")
+
+		err := a.Authenticate(newRequest(nil, "", map[string]string{s2h.SamsahaiAuthHeader: "123456"}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(nil, "", map[string]string{s2h.SamsahaiAuthHeader: "654321"}))
+		g.Expect(err).To(Equal(s2herrors.ErrUnauthorized))
+
+		err = a.Authenticate(newRequest(nil, "", nil))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+	})
+
+	It("should correctly verify bearer token of the team", func() {
+		a := auth.NewTeamToken()
+		team := newTeam("team-token", "")
+
+		req := newRequest(team, "", map[string]string{"Authorization": "Bearer team-token"})
+		err := a.Authenticate(req)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(req.User).To(Equal("team-token:" + team.Name))
+
+		err = a.Authenticate(newRequest(team, "", map[string]string{"Authorization": "Bearer other-token"}))
+		g.Expect(err).To(Equal(s2herrors.ErrUnauthorized))
+
+		By("no bearer token")
+		err = a.Authenticate(newRequest(team, "", map[string]string{"Authorization": "Basic dXNlcjpwYXNz"}))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+
+		By("no api token of the team")
+		err = a.Authenticate(newRequest(newTeam("", ""), "",
+			map[string]string{"Authorization": "Bearer team-token"}))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+
+		By("route without team")
+		err = a.Authenticate(newRequest(nil, "", map[string]string{"Authorization": "Bearer team-token"}))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+	})
+
+	It("should correctly verify github signature", func() {
+		a := auth.NewGithubSignature("global-secret")
+		body := `{"bundleName":"redis","prNumber":"1"}`
+
+		err := a.Authenticate(newRequest(newTeam("", "team-secret"), body,
+			map[string]string{"X-Hub-Signature-256": sign("team-secret", body)}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(newTeam("", "team-secret"), body,
+			map[string]string{"X-Hub-Signature-256": sign("global-secret", body)}))
+		g.Expect(err).To(Equal(s2herrors.ErrUnauthorized))
+
+		err = a.Authenticate(newRequest(newTeam("", "team-secret"), body,
+			map[string]string{"X-Hub-Signature-256": "sha256=invalid"}))
+		g.Expect(err).To(Equal(s2herrors.ErrUnauthorized))
+
+		By("route without team")
+		err = a.Authenticate(newRequest(nil, body,
+			map[string]string{"X-Hub-Signature-256": sign("global-secret", body)}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(nil, body, nil))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+
+		By("non-webhook route")
+		req := newRequest(newTeam("", "team-secret"), body,
+			map[string]string{"X-Hub-Signature-256": sign("team-secret", body)})
+		req.Webhook = false
+		g.Expect(a.Authenticate(req)).To(Equal(s2herrors.ErrForbidden))
+	})
+
+	It("should correctly verify bitbucket and gitea signatures", func() {
+		body := `{"eventKey":"pr:opened"}`
+
+		a := auth.NewBitbucketSignature("global-secret")
+		err := a.Authenticate(newRequest(newTeam("", "team-secret"), body,
+			map[string]string{"X-Hub-Signature": sign("team-secret", body)}))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(a.GetName()).To(Equal(auth.BitbucketSignatureName))
+
+		err = a.Authenticate(newRequest(nil, body,
+			map[string]string{"X-Hub-Signature-256": sign("global-secret", body)}))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+
+		a = auth.NewGiteaSignature("global-secret")
+		err = a.Authenticate(newRequest(nil, body,
+			map[string]string{"X-Gitea-Signature": strings.TrimPrefix(sign("global-secret", body), "sha256=")}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(newTeam("", "team-secret"), body,
+			map[string]string{"X-Gitea-Signature": strings.TrimPrefix(sign("global-secret", body), "sha256=")}))
+		g.Expect(err).To(Equal(s2herrors.ErrUnauthorized))
+	})
+
+	It("should correctly verify gitlab token", func() {
+		a := auth.NewGitlabToken("global-secret")
+
+		err := a.Authenticate(newRequest(newTeam("", "team-secret"), "",
+			map[string]string{"X-Gitlab-Token": "team-secret"}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(newTeam("", "team-secret"), "",
+			map[string]string{"X-Gitlab-Token": "global-secret"}))
+		g.Expect(err).To(Equal(s2herrors.ErrUnauthorized))
+
+		By("team without webhook secret")
+		err = a.Authenticate(newRequest(newTeam("", ""), "",
+			map[string]string{"X-Gitlab-Token": "global-secret"}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(nil, "", map[string]string{"X-Gitlab-Token": "global-secret"}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = a.Authenticate(newRequest(nil, "", nil))
+		g.Expect(err).To(Equal(s2herrors.ErrAuthTokenNotFound))
+
+		By("non-webhook route")
+		req := newRequest(newTeam("", "team-secret"), "", map[string]string{"X-Gitlab-Token": "team-secret"})
+		req.Webhook = false
+		g.Expect(a.Authenticate(req)).To(Equal(s2herrors.ErrForbidden))
+	})
+})
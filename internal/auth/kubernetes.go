@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2h "github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+const (
+	KubernetesName = "kubernetes"
+
+	teamResource         = "teams"
+	reviewRequestTimeout = 10 * time.Second
+)
+
+var _ s2h.Authenticator = &kubernetes{}
+
+type kubernetes struct {
+	client client.Client
+}
+
+// NewKubernetes creates a new authenticator which verifies the bearer token by kubernetes TokenReview
+// and authorizes the user on the team resource by kubernetes SubjectAccessReview
+func NewKubernetes(c client.Client) s2h.Authenticator {
+	return &kubernetes{client: c}
+}
+
+// GetName implements the authenticator GetName function
+func (a *kubernetes) GetName() string {
+	return KubernetesName
+}
+
+// Authenticate implements the authenticator Authenticate function
+func (a *kubernetes) Authenticate(req *s2h.AuthRequest) error {
+	token := getBearerToken(req.Request)
+	if token == "" {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), reviewRequestTimeout)
+	defer cancel()
+
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := a.client.Create(ctx, tokenReview); err != nil {
+		return errors.Wrap(err, "cannot create token review")
+	}
+
+	if !tokenReview.Status.Authenticated {
+		return s2herrors.ErrUnauthorized
+	}
+
+	user := tokenReview.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	resourceAttrs := &authorizationv1.ResourceAttributes{
+		Group:    s2hv1.GroupVersion.Group,
+		Version:  s2hv1.GroupVersion.Version,
+		Resource: teamResource,
+		Verb:     req.Verb,
+	}
+	if req.Team != nil {
+		resourceAttrs.Name = req.Team.Name
+	}
+
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: resourceAttrs,
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+			Extra:              extra,
+		},
+	}
+	if err := a.client.Create(ctx, sar); err != nil {
+		return errors.Wrap(err, "cannot create subject access review")
+	}
+
+	if !sar.Status.Allowed {
+		return s2herrors.ErrForbidden
+	}
+
+	req.User = user.Username
+	return nil
+}
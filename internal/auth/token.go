@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	s2h "github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+const (
+	InternalTokenName = "internal-token"
+	TeamTokenName     = "team-token"
+
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var _ s2h.Authenticator = &internalToken{}
+var _ s2h.Authenticator = &teamToken{}
+
+type internalToken struct {
+	token string
+}
+
+// NewInternalToken creates a new authenticator which verifies the internal auth token of samsahai
+// from `x-samsahai-auth` header, the internal token is allowed to access all teams
+func NewInternalToken(token string) s2h.Authenticator {
+	return &internalToken{token: token}
+}
+
+// GetName implements the authenticator GetName function
+func (a *internalToken) GetName() string {
+	return InternalTokenName
+}
+
+// Authenticate implements the authenticator Authenticate function
+func (a *internalToken) Authenticate(req *s2h.AuthRequest) error {
+	token := req.Request.Header.Get(s2h.SamsahaiAuthHeader)
+	if token == "" || a.token == "" {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	if !isTokenMatched(token, a.token) {
+		return s2herrors.ErrUnauthorized
+	}
+
+	req.User = InternalTokenName
+	return nil
+}
+
+type teamToken struct{}
+
+// NewTeamToken creates a new authenticator which verifies the bearer token
+// with the api token stored in the team secret, the token is allowed to access only its own team
+func NewTeamToken() s2h.Authenticator {
+	return &teamToken{}
+}
+
+// GetName implements the authenticator GetName function
+func (a *teamToken) GetName() string {
+	return TeamTokenName
+}
+
+// Authenticate implements the authenticator Authenticate function
+func (a *teamToken) Authenticate(req *s2h.AuthRequest) error {
+	if req.Team == nil {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	apiToken := req.Team.Status.Used.Credential.APIToken
+	if apiToken == nil || apiToken.Token == "" {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	token := getBearerToken(req.Request)
+	if token == "" {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	if !isTokenMatched(token, apiToken.Token) {
+		return s2herrors.ErrUnauthorized
+	}
+
+	req.User = fmt.Sprintf("%s:%s", TeamTokenName, req.Team.Name)
+	return nil
+}
+
+func getBearerToken(r *http.Request) string {
+	authHeader := r.Header.Get(authorizationHeader)
+	if len(authHeader) <= len(bearerPrefix) || !strings.EqualFold(authHeader[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+
+	return strings.TrimSpace(authHeader[len(bearerPrefix):])
+}
+
+func isTokenMatched(token, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1
+}
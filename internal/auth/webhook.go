@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	s2h "github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+const (
+	GithubSignatureName    = "github-signature"
+	GitlabTokenName        = "gitlab-token"
+	BitbucketSignatureName = "bitbucket-signature"
+	GiteaSignatureName     = "gitea-signature"
+
+	githubSignatureHeader    = "X-Hub-Signature-256"
+	githubSignaturePrefix    = "sha256="
+	gitlabTokenHeader        = "X-Gitlab-Token"
+	bitbucketSignatureHeader = "X-Hub-Signature"
+	giteaSignatureHeader     = "X-Gitea-Signature"
+)
+
+var _ s2h.Authenticator = &hmacSignature{}
+var _ s2h.Authenticator = &gitlabToken{}
+
+// hmacSignature verifies hex encoded HMAC SHA256 signature of the request body
+type hmacSignature struct {
+	name   string
+	header string
+	prefix string
+	secret string
+}
+
+// NewGithubSignature creates a new authenticator which verifies HMAC SHA256 signature of github webhooks,
+// the webhook secret of the team is used for team routes if specified, otherwise the given secret is used
+func NewGithubSignature(secret string) s2h.Authenticator {
+	return &hmacSignature{
+		name:   GithubSignatureName,
+		header: githubSignatureHeader,
+		prefix: githubSignaturePrefix,
+		secret: secret,
+	}
+}
+
+// NewBitbucketSignature creates a new authenticator which verifies HMAC SHA256 signature
+// of bitbucket server webhooks, the webhook secret of the team is used for team routes if specified,
+// otherwise the given secret is used
+func NewBitbucketSignature(secret string) s2h.Authenticator {
+	return &hmacSignature{
+		name:   BitbucketSignatureName,
+		header: bitbucketSignatureHeader,
+		prefix: githubSignaturePrefix,
+		secret: secret,
+	}
+}
+
+// NewGiteaSignature creates a new authenticator which verifies HMAC SHA256 signature of gitea webhooks,
+// the webhook secret of the team is used for team routes if specified, otherwise the given secret is used
+func NewGiteaSignature(secret string) s2h.Authenticator {
+	return &hmacSignature{
+		name:   GiteaSignatureName,
+		header: giteaSignatureHeader,
+		secret: secret,
+	}
+}
+
+// GetName implements the authenticator GetName function
+func (a *hmacSignature) GetName() string {
+	return a.name
+}
+
+// Authenticate implements the authenticator Authenticate function
+func (a *hmacSignature) Authenticate(req *s2h.AuthRequest) error {
+	signature := req.Request.Header.Get(a.header)
+	if signature == "" {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	if !req.Webhook {
+		return s2herrors.ErrForbidden
+	}
+
+	secret := getWebhookSecret(req, a.secret)
+	if secret == "" || !strings.HasPrefix(signature, a.prefix) {
+		return s2herrors.ErrUnauthorized
+	}
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, a.prefix))
+	if err != nil {
+		return s2herrors.ErrUnauthorized
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(req.Body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return s2herrors.ErrUnauthorized
+	}
+
+	req.User = a.name
+	return nil
+}
+
+type gitlabToken struct {
+	secret string
+}
+
+// NewGitlabToken creates a new authenticator which verifies secret token of gitlab webhooks,
+// the webhook secret of the team is used for team routes if specified, otherwise the given secret is used
+func NewGitlabToken(secret string) s2h.Authenticator {
+	return &gitlabToken{secret: secret}
+}
+
+// GetName implements the authenticator GetName function
+func (a *gitlabToken) GetName() string {
+	return GitlabTokenName
+}
+
+// Authenticate implements the authenticator Authenticate function
+func (a *gitlabToken) Authenticate(req *s2h.AuthRequest) error {
+	token := req.Request.Header.Get(gitlabTokenHeader)
+	if token == "" {
+		return s2herrors.ErrAuthTokenNotFound
+	}
+
+	if !req.Webhook {
+		return s2herrors.ErrForbidden
+	}
+
+	secret := getWebhookSecret(req, a.secret)
+	if secret == "" || !isTokenMatched(token, secret) {
+		return s2herrors.ErrUnauthorized
+	}
+
+	req.User = GitlabTokenName
+	return nil
+}
+
+// getWebhookSecret returns the webhook secret of the team,
+// the default secret is used if the route does not belong to any teams or the team has no webhook secret
+func getWebhookSecret(req *s2h.AuthRequest, defaultSecret string) string {
+	if req.Team == nil {
+		return defaultSecret
+	}
+
+	webhookSecret := req.Team.Status.Used.Credential.WebhookSecret
+	if webhookSecret == nil || webhookSecret.Token == "" {
+		return defaultSecret
+	}
+
+	return webhookSecret.Token
+}
@@ -179,11 +179,12 @@ func (c *controller) GetPullRequestComponents(configName, prBundleName string, d
 				for _, prComp := range prBundle.Components {
 					if prComp.Name == compName {
 						filteredPRComps[compName] = &s2hv1.Component{
-							Parent: comp.Parent,
-							Name:   prComp.Name,
-							Chart:  comp.Chart,
-							Image:  prComp.Image,
-							Source: prComp.Source,
+							Parent:    comp.Parent,
+							Name:      prComp.Name,
+							Chart:     comp.Chart,
+							Image:     prComp.Image,
+							Source:    prComp.Source,
+							DependsOn: comp.DependsOn,
 						}
 					}
 				}
@@ -759,6 +760,7 @@ func (c *controller) detectRemovedQueues(comps map[string]*s2hv1.Component, team
 
 			// reset NoOfRetry/NextProcessAt if there are removed components
 			q.Spec.NoOfRetry = 0
+			q.Spec.NoOfFailureRetry = nil
 			q.Spec.NextProcessAt = nil
 			if err := c.client.Update(ctx, &q); err != nil {
 				return err
@@ -843,6 +845,23 @@ func ValidateConfigRequiredField(config *s2hv1.Config) error {
 	if len(config.Status.Used.Components) == 0 || config.Status.Used.Staging == nil {
 		return errors.ErrConfigurationRequiredField
 	}
+
+	if err := ValidateComponentDependencies(config.Status.Used.Components); err != nil {
+		return err
+	}
+
+	if atpConfig := config.Status.Used.ActivePromotion; atpConfig != nil {
+		if err := ValidateActivePromotionSchedule(atpConfig.Schedule); err != nil {
+			return err
+		}
+
+		if err := ValidateTrafficShift(atpConfig.TrafficShift); err != nil {
+			return err
+		}
+
+		return ValidateHealthWatch(atpConfig.HealthWatch)
+	}
+
 	return nil
 }
 
@@ -898,7 +917,7 @@ func (c *controller) Reconcile(ctx context.Context, req cr.Request) (cr.Result,
 		configComp.Status.SetCondition(
 			s2hv1.ConfigRequiredFieldsValidated,
 			corev1.ConditionFalse,
-			"invalid required fields")
+			fmt.Sprintf("invalid required fields: %s", err.Error()))
 
 		if err := c.Update(configComp); err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "cannot update config conditions when require fields is invalid")
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+// ValidateComponentDependencies verifies dependsOn of the top-level components,
+// all dependsOn have to refer to the top-level components and must not contain a cycle
+func ValidateComponentDependencies(comps []*s2hv1.Component) error {
+	compMap := make(map[string]*s2hv1.Component, len(comps))
+	for _, comp := range comps {
+		compMap[comp.Name] = comp
+	}
+
+	for _, comp := range comps {
+		for _, dep := range comp.DependsOn {
+			if _, ok := compMap[dep]; !ok {
+				return errors.New(fmt.Sprintf("component %s depends on unknown component %s", comp.Name, dep))
+			}
+		}
+	}
+
+	_, err := GetComponentDeploymentWaves(compMap)
+	return err
+}
+
+// GetComponentDeploymentWaves groups the components into deployment waves following dependsOn of the components,
+// components of a wave only depend on components of the previous waves.
+// dependsOn which refer to components outside the given components are ignored.
+func GetComponentDeploymentWaves(comps map[string]*s2hv1.Component) ([][]string, error) {
+	inDegrees := make(map[string]int, len(comps))
+	dependents := make(map[string][]string)
+	for name, comp := range comps {
+		inDegree := 0
+		for _, dep := range comp.DependsOn {
+			if _, ok := comps[dep]; !ok {
+				continue
+			}
+			inDegree++
+			dependents[dep] = append(dependents[dep], name)
+		}
+		inDegrees[name] = inDegree
+	}
+
+	waves := make([][]string, 0)
+	wave := getZeroInDegreeNames(inDegrees)
+	for len(wave) > 0 {
+		waves = append(waves, wave)
+
+		for _, name := range wave {
+			delete(inDegrees, name)
+			for _, dependent := range dependents[name] {
+				inDegrees[dependent]--
+			}
+		}
+
+		wave = getZeroInDegreeNames(inDegrees)
+	}
+
+	if len(inDegrees) > 0 {
+		cycleNames := make([]string, 0, len(inDegrees))
+		for name := range inDegrees {
+			cycleNames = append(cycleNames, name)
+		}
+		sort.Strings(cycleNames)
+
+		return nil, errors.Wrapf(errors.ErrComponentDependencyCycle, "components: %s",
+			strings.Join(cycleNames, ", "))
+	}
+
+	return waves, nil
+}
+
+func getZeroInDegreeNames(inDegrees map[string]int) []string {
+	names := make([]string, 0)
+	for name, inDegree := range inDegrees {
+		if inDegree == 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
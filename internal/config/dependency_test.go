@@ -0,0 +1,80 @@
+package config
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+var _ = Describe("Component dependencies", func() {
+	g := NewWithT(GinkgoT())
+
+	newComp := func(name string, dependsOn ...string) *s2hv1.Component {
+		return &s2hv1.Component{Name: name, DependsOn: dependsOn}
+	}
+
+	toMap := func(comps ...*s2hv1.Component) map[string]*s2hv1.Component {
+		compMap := make(map[string]*s2hv1.Component)
+		for _, comp := range comps {
+			compMap[comp.Name] = comp
+		}
+		return compMap
+	}
+
+	It("should group components into deployment waves correctly", func() {
+		waves, err := GetComponentDeploymentWaves(toMap(
+			newComp("wall", "mariadb", "redis"),
+			newComp("mariadb"),
+			newComp("redis"),
+			newComp("web", "wall"),
+			newComp("cache"),
+		))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(waves).To(Equal([][]string{
+			{"cache", "mariadb", "redis"},
+			{"wall"},
+			{"web"},
+		}))
+	})
+
+	It("should ignore dependencies outside given components", func() {
+		waves, err := GetComponentDeploymentWaves(toMap(
+			newComp("wall", "mariadb"),
+			newComp("web", "wall"),
+		))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(waves).To(Equal([][]string{{"wall"}, {"web"}}))
+	})
+
+	It("should detect cycle of the dependencies", func() {
+		_, err := GetComponentDeploymentWaves(toMap(
+			newComp("mariadb"),
+			newComp("wall", "mariadb", "web"),
+			newComp("web", "wall"),
+		))
+		g.Expect(s2herrors.IsErrComponentDependencyCycle(err)).To(BeTrue())
+		g.Expect(err.Error()).To(ContainSubstring("wall, web"))
+	})
+
+	It("should validate component dependencies correctly", func() {
+		err := ValidateComponentDependencies([]*s2hv1.Component{
+			newComp("mariadb"),
+			newComp("wall", "mariadb"),
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = ValidateComponentDependencies([]*s2hv1.Component{
+			newComp("wall", "mariadb"),
+		})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("unknown component mariadb"))
+
+		err = ValidateComponentDependencies([]*s2hv1.Component{
+			newComp("wall", "web"),
+			newComp("web", "wall"),
+		})
+		g.Expect(s2herrors.IsErrComponentDependencyCycle(err)).To(BeTrue())
+	})
+})
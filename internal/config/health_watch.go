@@ -0,0 +1,24 @@
+package config
+
+import (
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+// ValidateHealthWatch verifies the optional http and prometheus health checks of the health watch,
+// pods of the new active namespace are always checked
+func ValidateHealthWatch(healthWatch *s2hv1.ConfigHealthWatch) error {
+	if healthWatch == nil {
+		return nil
+	}
+
+	if healthWatch.FailureThreshold < 0 {
+		return errors.New("failure threshold of health watch cannot be negative")
+	}
+
+	if healthWatch.HTTP != nil && healthWatch.HTTP.URL == "" {
+		return errors.New("http health check of health watch requires url")
+	}
+
+	return validatePrometheusQuery(healthWatch.Prometheus)
+}
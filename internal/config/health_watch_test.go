@@ -0,0 +1,32 @@
+package config
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Health watch", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should validate health watch correctly", func() {
+		g.Expect(ValidateHealthWatch(nil)).To(Succeed())
+		g.Expect(ValidateHealthWatch(&s2hv1.ConfigHealthWatch{})).To(Succeed())
+		g.Expect(ValidateHealthWatch(&s2hv1.ConfigHealthWatch{
+			FailureThreshold: 3,
+			HTTP:             &s2hv1.HealthWatchHTTP{URL: "http://app.{{ .Namespace }}/healthz"},
+			Prometheus: &s2hv1.PrometheusQuery{
+				URL:       "http://prometheus:9090",
+				Query:     `sum(rate(http_errors_total{namespace="{{ .Namespace }}"}[1m]))`,
+				Threshold: "0.05",
+			},
+		})).To(Succeed())
+
+		g.Expect(ValidateHealthWatch(&s2hv1.ConfigHealthWatch{FailureThreshold: -1})).NotTo(Succeed())
+		g.Expect(ValidateHealthWatch(&s2hv1.ConfigHealthWatch{HTTP: &s2hv1.HealthWatchHTTP{}})).NotTo(Succeed())
+		g.Expect(ValidateHealthWatch(&s2hv1.ConfigHealthWatch{
+			Prometheus: &s2hv1.PrometheusQuery{URL: "http://prometheus:9090", Query: "errors", Threshold: "high"},
+		})).NotTo(Succeed())
+	})
+})
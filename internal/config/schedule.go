@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+const blackoutDateFormat = "2006-01-02"
+
+// ValidateActivePromotionSchedule verifies cron expressions, timezone and blackout dates of the schedule
+func ValidateActivePromotionSchedule(schedule *s2hv1.ActivePromotionSchedule) error {
+	if schedule == nil {
+		return nil
+	}
+
+	if len(schedule.Crons) == 0 {
+		return errors.New("active promotion schedule requires at least one cron expression")
+	}
+
+	if _, err := getScheduleLocation(schedule); err != nil {
+		return err
+	}
+
+	for _, expr := range schedule.Crons {
+		if _, err := cron.ParseStandard(expr); err != nil {
+			return errors.Wrapf(err, "invalid cron expression %q of active promotion schedule", expr)
+		}
+	}
+
+	for _, date := range schedule.BlackoutDates {
+		if _, err := time.Parse(blackoutDateFormat, date); err != nil {
+			return errors.New(fmt.Sprintf("invalid blackout date %q of active promotion schedule, "+
+				"date must be in YYYY-MM-DD format", date))
+		}
+	}
+
+	return nil
+}
+
+// IsActivePromotionScheduled checks any scheduled time of the schedule is after `from` and not after `to`,
+// the scheduled times on the blackout dates in the timezone of the schedule are skipped
+func IsActivePromotionScheduled(schedule *s2hv1.ActivePromotionSchedule, from, to time.Time) (bool, error) {
+	if schedule == nil {
+		return false, nil
+	}
+
+	loc, err := getScheduleLocation(schedule)
+	if err != nil {
+		return false, err
+	}
+
+	blackoutDates := make(map[string]struct{}, len(schedule.BlackoutDates))
+	for _, date := range schedule.BlackoutDates {
+		blackoutDates[date] = struct{}{}
+	}
+
+	from, to = from.In(loc), to.In(loc)
+	for _, expr := range schedule.Crons {
+		sched, err := cron.ParseStandard(expr)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid cron expression %q of active promotion schedule", expr)
+		}
+
+		for next := sched.Next(from); !next.IsZero() && !next.After(to); next = sched.Next(next) {
+			if _, ok := blackoutDates[next.Format(blackoutDateFormat)]; !ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func getScheduleLocation(schedule *s2hv1.ActivePromotionSchedule) (*time.Location, error) {
+	if schedule.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid timezone %q of active promotion schedule", schedule.Timezone)
+	}
+
+	return loc, nil
+}
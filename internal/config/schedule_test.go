@@ -0,0 +1,100 @@
+package config
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Active promotion schedule", func() {
+	g := NewWithT(GinkgoT())
+
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	schedule := &s2hv1.ActivePromotionSchedule{
+		Crons:         []string{"0 6 * * 1-5"},
+		Timezone:      "Asia/Bangkok",
+		BlackoutDates: []string{"2021-12-31"},
+	}
+
+	It("should validate schedule correctly", func() {
+		g.Expect(ValidateActivePromotionSchedule(nil)).To(Succeed())
+		g.Expect(ValidateActivePromotionSchedule(schedule)).To(Succeed())
+
+		g.Expect(ValidateActivePromotionSchedule(&s2hv1.ActivePromotionSchedule{})).NotTo(Succeed())
+		g.Expect(ValidateActivePromotionSchedule(&s2hv1.ActivePromotionSchedule{
+			Crons: []string{"0 6 * *"},
+		})).NotTo(Succeed())
+		g.Expect(ValidateActivePromotionSchedule(&s2hv1.ActivePromotionSchedule{
+			Crons:    []string{"0 6 * * *"},
+			Timezone: "Unknown/Timezone",
+		})).NotTo(Succeed())
+		g.Expect(ValidateActivePromotionSchedule(&s2hv1.ActivePromotionSchedule{
+			Crons:         []string{"0 6 * * *"},
+			BlackoutDates: []string{"31-12-2021"},
+		})).NotTo(Succeed())
+	})
+
+	It("should check scheduled time in timezone of the schedule correctly", func() {
+		// Thursday
+		scheduledTime := time.Date(2021, 12, 30, 6, 0, 0, 0, bangkok)
+
+		isScheduled, err := IsActivePromotionScheduled(schedule,
+			scheduledTime.Add(-time.Minute), scheduledTime)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeTrue())
+
+		By("checking the same time in UTC")
+		isScheduled, err = IsActivePromotionScheduled(schedule,
+			scheduledTime.UTC().Add(-time.Minute), scheduledTime.UTC())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeTrue())
+
+		By("checking after the scheduled time")
+		isScheduled, err = IsActivePromotionScheduled(schedule,
+			scheduledTime, scheduledTime.Add(time.Minute))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeFalse())
+	})
+
+	It("should not be scheduled on weekend and blackout dates", func() {
+		// Saturday
+		weekendTime := time.Date(2022, 1, 1, 6, 0, 0, 0, bangkok)
+		isScheduled, err := IsActivePromotionScheduled(schedule, weekendTime.Add(-time.Minute), weekendTime)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeFalse())
+
+		// Friday
+		blackoutTime := time.Date(2021, 12, 31, 6, 0, 0, 0, bangkok)
+		isScheduled, err = IsActivePromotionScheduled(schedule, blackoutTime.Add(-time.Minute), blackoutTime)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeFalse())
+	})
+
+	It("should check blackout dates by the scheduled time", func() {
+		nightlySchedule := &s2hv1.ActivePromotionSchedule{
+			Crons:         []string{"59 23 * * *"},
+			Timezone:      "Asia/Bangkok",
+			BlackoutDates: []string{"2021-12-31"},
+		}
+
+		// the schedule fires on 2021-12-30 which is not a blackout date,
+		// even if it is checked after midnight
+		scheduledTime := time.Date(2021, 12, 30, 23, 59, 0, 0, bangkok)
+		isScheduled, err := IsActivePromotionScheduled(nightlySchedule,
+			scheduledTime.Add(-time.Minute), scheduledTime.Add(2*time.Minute))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeTrue())
+
+		By("checking the scheduled time on the blackout date before midnight")
+		blackoutTime := time.Date(2021, 12, 31, 23, 59, 0, 0, bangkok)
+		isScheduled, err = IsActivePromotionScheduled(nightlySchedule,
+			blackoutTime.Add(-time.Minute), blackoutTime.Add(2*time.Minute))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isScheduled).To(BeFalse())
+	})
+})
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+// ValidateTrafficShift verifies steps and the error rate query of the traffic shift,
+// steps must be percentages in ascending order
+func ValidateTrafficShift(trafficShift *s2hv1.ConfigTrafficShift) error {
+	if trafficShift == nil {
+		return nil
+	}
+
+	if len(trafficShift.Steps) == 0 {
+		return errors.New("traffic shift requires at least one step")
+	}
+
+	prev := 0
+	for _, step := range trafficShift.Steps {
+		if step <= prev || step > 100 {
+			return errors.New(fmt.Sprintf("invalid traffic shift steps %v, "+
+				"steps must be percentages in ascending order", trafficShift.Steps))
+		}
+		prev = step
+	}
+
+	return validatePrometheusQuery(trafficShift.Prometheus)
+}
+
+func validatePrometheusQuery(prom *s2hv1.PrometheusQuery) error {
+	if prom == nil {
+		return nil
+	}
+
+	if prom.URL == "" || prom.Query == "" {
+		return errors.New("prometheus query requires url and query")
+	}
+
+	if _, err := strconv.ParseFloat(prom.Threshold, 64); err != nil {
+		return errors.Wrapf(err, "invalid error rate threshold %q of prometheus query", prom.Threshold)
+	}
+
+	return nil
+}
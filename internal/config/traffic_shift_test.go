@@ -0,0 +1,32 @@
+package config
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Traffic shift", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should validate traffic shift correctly", func() {
+		g.Expect(ValidateTrafficShift(nil)).To(Succeed())
+		g.Expect(ValidateTrafficShift(&s2hv1.ConfigTrafficShift{
+			Steps: []int{10, 50, 100},
+			Prometheus: &s2hv1.PrometheusQuery{
+				URL:       "http://prometheus:9090",
+				Query:     `sum(rate(http_errors_total{namespace="{{ .Namespace }}"}[1m]))`,
+				Threshold: "0.05",
+			},
+		})).To(Succeed())
+
+		g.Expect(ValidateTrafficShift(&s2hv1.ConfigTrafficShift{})).NotTo(Succeed())
+		g.Expect(ValidateTrafficShift(&s2hv1.ConfigTrafficShift{Steps: []int{50, 10}})).NotTo(Succeed())
+		g.Expect(ValidateTrafficShift(&s2hv1.ConfigTrafficShift{Steps: []int{10, 150}})).NotTo(Succeed())
+		g.Expect(ValidateTrafficShift(&s2hv1.ConfigTrafficShift{
+			Steps:      []int{10},
+			Prometheus: &s2hv1.PrometheusQuery{URL: "http://prometheus:9090", Query: "errors"},
+		})).NotTo(Succeed())
+	})
+})
@@ -40,11 +40,18 @@ const (
 	VKTeamcityURL                     = "teamcity-url"
 	VKTeamcityUsername                = "teamcity-username"
 	VKTeamcityPassword                = "teamcity-password"
+	VKJenkinsUsername                 = "jenkins-username"
+	VKJenkinsAPIToken                 = "jenkins-api-token"
 	VKGitlabURL                       = "gitlab-url"
 	VKGitlabToken                     = "gitlab-token"
 	VKSlackToken                      = "slack-token"
 	VKGithubURL                       = "github-url"
 	VKGithubToken                     = "github-token"
+	VKBitbucketURL                    = "bitbucket-url"
+	VKBitbucketToken                  = "bitbucket-token"
+	VKGiteaURL                        = "gitea-url"
+	VKGiteaToken                      = "gitea-token"
+	VKInsecureRegistries              = "insecure-registries"
 	VKMSTeamsTenantID                 = "ms-teams-tenant-id"
 	VKMSTeamsClientID                 = "ms-teams-client-id"
 	VKMSTeamsClientSecret             = "ms-teams-client-secret"
@@ -63,11 +70,18 @@ const (
 	VKPRVerificationMaxRetry          = "pr-verification-max-retry"
 	VKPRTriggerMaxRetry               = "pr-trigger-max-retry"
 	VKPRTriggerPollingTime            = "pr-trigger-polling-time"
+	VKPRStateCheckInterval            = "pr-state-check-interval"
 	VKPullRequestQueueMaxHistoryDays  = "pr-queue-max-history-days"
 	VKCheckerCPU                      = "checker-cpu"
 	VKCheckerMemory                   = "checker-memory"
 	VKInitialResourcesQuotaCPU        = "initial-resources-quota-cpu"
 	VKInitialResourcesQuotaMemory     = "initial-resources-quota-memory"
+	VKAPIAuthEnabled                  = "api-auth-enabled"
+	VKAPIAuthReadOnlyPublic           = "api-auth-read-only-public"
+	VKS2HWebhookSecret                = "s2h-webhook-secret"
+	VKTracingExporter                 = "tracing-exporter"
+	VKTracingOTLPEndpoint             = "tracing-otlp-endpoint"
+	VKTracingOTLPInsecure             = "tracing-otlp-insecure"
 )
 
 type ConfigurationJSON struct {
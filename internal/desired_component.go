@@ -1,12 +1,16 @@
 package internal
 
+import (
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
 // DesiredComponentChecker represents standard interface for checking component version
 type DesiredComponentChecker interface {
 	// GetName returns name of checker
 	GetName() string
 
 	// GetVersion returns version from defined pattern
-	GetVersion(repository string, name string, pattern string) (string, error)
+	GetVersion(repository string, name string, pattern string, opts ...CheckOption) (string, error)
 
 	//EnsureVersion ensures the defined version is exist on repository
 	EnsureVersion(repository string, name string, version string) error
@@ -14,3 +18,45 @@ type DesiredComponentChecker interface {
 
 type DesiredComponentController interface {
 }
+
+// CheckOptions represents options of checking desired component version
+type CheckOptions struct {
+	// Constraint is a semantic version constraint of version
+	Constraint string
+	// Ordering is a strategy of ordering matched versions
+	Ordering *s2hv1.ImageOrdering
+}
+
+// CheckOption is a function to set checking options
+type CheckOption func(*CheckOptions)
+
+// WithVersionConstraint sets semantic version constraint, e.g. `>=2.3 <3`
+func WithVersionConstraint(constraint string) CheckOption {
+	return func(o *CheckOptions) {
+		o.Constraint = constraint
+	}
+}
+
+// WithVersionOrdering sets strategy of ordering matched versions
+func WithVersionOrdering(ordering *s2hv1.ImageOrdering) CheckOption {
+	return func(o *CheckOptions) {
+		o.Ordering = ordering
+	}
+}
+
+// WithComponentImage sets constraint and ordering from the component image
+func WithComponentImage(image s2hv1.ComponentImage) CheckOption {
+	return func(o *CheckOptions) {
+		o.Constraint = image.Constraint
+		o.Ordering = image.Ordering
+	}
+}
+
+// NewCheckOptions returns checking options from the option functions
+func NewCheckOptions(opts ...CheckOption) CheckOptions {
+	o := CheckOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -19,6 +19,9 @@ const (
 	ErrImageVersionNotFound      = Error("image version not found")
 	ErrInternalCheckerError      = Error("internal checker error")
 	ErrNoDesiredComponentVersion = Error("no desired component version")
+	ErrComponentNotFound         = Error("component not found in configuration")
+	ErrQueueRunning              = Error("queue is running")
+	ErrQueueNotRunning           = Error("queue is not running")
 
 	ErrTeamNamespaceStillCreating     = Error("still creating namespace")
 	ErrTeamNamespaceStillExists       = Error("destroyed namespace still exists")
@@ -29,10 +32,15 @@ const (
 	ErrActivePromotionTimeout            = Error("active promotion timeout")
 	ErrActiveDemotionTimeout             = Error("demoted active environment timeout")
 	ErrRollbackActivePromotionTimeout    = Error("rollback active promotion timeout")
+	ErrActivePromotionNotFound           = Error("active promotion not found")
+	ErrActivePromotionCannotBeCanceled   = Error("active promotion cannot be canceled in the current state")
+	ErrActivePromotionCannotBeRolledBack = Error("active promotion cannot be rolled back to previous active namespace")
 	ErrEnsurePreActiveEnvironmentCreated = Error("pre-active environment is being created")
 	ErrEnsureNamespaceDestroyed          = Error("namespace has not been destroyed")
 	ErrEnsureActiveDemoted               = Error("active environment is being demoted")
 	ErrEnsureActivePromoted              = Error("active environment is being promoted")
+	ErrEnsureTrafficShifted              = Error("traffic is being shifted to active environment")
+	ErrEnsureActiveHealthWatched         = Error("active environment health is being watched")
 	ErrEnsureComponentDeployed           = Error("components are being deployed")
 	ErrEnsureComponentTested             = Error("components are being tested")
 	ErrDeletingReleases                  = Error("deleting releases")
@@ -42,8 +50,12 @@ const (
 
 	ErrPullRequestBundleNotFound                     = Error("pull request bundle name not found in configuration")
 	ErrPullRequestRPCTearDownDurationCriteriaUnknown = Error("pull request tearDownDuration criteria unknown")
+	ErrPullRequestQueueNotFound                      = Error("pull request queue not found")
+	ErrPullRequestQueueCannotBeRetested              = Error("pull request queue cannot be retested in the current state")
+	ErrPullRequestEnvironmentDestroyed               = Error("pull request environment has been destroyed")
 
 	ErrUnauthorized      = Error("unauthorized")
+	ErrForbidden         = Error("forbidden")
 	ErrAuthTokenNotFound = Error("auth token not found")
 	ErrInvalidJSONData   = Error("invalid json data")
 	ErrCannotMarshalJSON = Error("cannot marshal to json")
@@ -52,6 +64,7 @@ const (
 	ErrTestConfigurationNotFound  = Error("test configuration not found")
 	ErrTestPipelineIDNotFound     = Error("test pipeline id not found")
 	ErrConfigurationRequiredField = Error("required filed cannot be empty")
+	ErrComponentDependencyCycle   = Error("component dependencies contain a cycle")
 
 	ErrEnsureConfigDestroyed = Error("config been being destroyed")
 
@@ -119,6 +132,16 @@ func IsEnsuringActivePromoted(err error) bool {
 	return ErrEnsureActivePromoted.Error() == err.Error()
 }
 
+// IsEnsuringTrafficShifted checks ensuring traffic shifted
+func IsEnsuringTrafficShifted(err error) bool {
+	return ErrEnsureTrafficShifted.Error() == err.Error()
+}
+
+// IsEnsuringActiveHealthWatched checks ensuring active environment health watched
+func IsEnsuringActiveHealthWatched(err error) bool {
+	return ErrEnsureActiveHealthWatched.Error() == err.Error()
+}
+
 // ErrEnsureActiveDemoted checks ensuring active demoted
 func IsEnsuringActiveDemoted(err error) bool {
 	return ErrEnsureActiveDemoted.Error() == err.Error()
@@ -193,3 +216,23 @@ func IsErrPullRequestBundleNotFound(err error) bool {
 func IsErrPullRequestRPCTearDownDurationCriteriaUnknown(err error) bool {
 	return ErrPullRequestRPCTearDownDurationCriteriaUnknown.Error() == err.Error()
 }
+
+// IsErrAuthTokenNotFound checks auth token not found error
+func IsErrAuthTokenNotFound(err error) bool {
+	return ErrAuthTokenNotFound.Error() == err.Error()
+}
+
+// IsErrUnauthorized checks unauthorized error
+func IsErrUnauthorized(err error) bool {
+	return ErrUnauthorized.Error() == err.Error()
+}
+
+// IsErrForbidden checks forbidden error
+func IsErrForbidden(err error) bool {
+	return ErrForbidden.Error() == err.Error()
+}
+
+// IsErrComponentDependencyCycle checks component dependencies contain a cycle
+func IsErrComponentDependencyCycle(err error) bool {
+	return ErrComponentDependencyCycle.Error() == Cause(err).Error()
+}
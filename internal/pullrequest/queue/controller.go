@@ -491,6 +491,13 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 
 	case s2hv1.PullRequestQueueEnvDestroying:
+		if prQueue.Spec.RetestRequest != nil {
+			if err := c.retestPullRequestQueue(prQueue); err != nil {
+				return reconcile.Result{}, errors.Wrapf(err, "cannot retest pull request queue")
+			}
+			break
+		}
+
 		if skipReconcile, err := c.destroyPullRequestEnvironment(ctx, prQueue); err != nil || skipReconcile {
 			if err != nil {
 				if s2herrors.IsNamespaceStillExists(err) {
@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+)
+
+// discoverPreviewURLs returns urls of ingresses and load balancer services in the pull request namespace,
+// services which are managed by samsahai are ignored
+func discoverPreviewURLs(ctx context.Context, c client.Client, prNamespace string) (
+	[]s2hv1.PullRequestPreviewURL, error) {
+
+	ingresses := &networkingv1.IngressList{}
+	if err := c.List(ctx, ingresses, client.InNamespace(prNamespace)); err != nil {
+		return nil, errors.Wrapf(err, "cannot list ingresses of namespace %s", prNamespace)
+	}
+
+	services := &corev1.ServiceList{}
+	if err := c.List(ctx, services, client.InNamespace(prNamespace)); err != nil {
+		return nil, errors.Wrapf(err, "cannot list services of namespace %s", prNamespace)
+	}
+
+	urls := make([]s2hv1.PullRequestPreviewURL, 0)
+	for _, ing := range ingresses.Items {
+		urls = append(urls, getIngressPreviewURLs(&ing)...)
+	}
+
+	for _, svc := range services.Items {
+		if svc.Labels["app.kubernetes.io/managed-by"] == internal.AppName {
+			continue
+		}
+		urls = append(urls, getServicePreviewURLs(&svc)...)
+	}
+
+	return urls, nil
+}
+
+func getIngressPreviewURLs(ing *networkingv1.Ingress) []s2hv1.PullRequestPreviewURL {
+	tlsHosts := make(map[string]bool)
+	for _, tls := range ing.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	urls := make([]s2hv1.PullRequestPreviewURL, 0)
+	for _, rule := range ing.Spec.Rules {
+		if rule.Host == "" {
+			continue
+		}
+
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+
+		path := ""
+		if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 && rule.HTTP.Paths[0].Path != "/" {
+			path = rule.HTTP.Paths[0].Path
+		}
+
+		urls = append(urls, s2hv1.PullRequestPreviewURL{
+			Name: ing.Name,
+			Kind: s2hv1.PullRequestPreviewIngress,
+			URL:  fmt.Sprintf("%s://%s%s", scheme, rule.Host, path),
+		})
+	}
+
+	return urls
+}
+
+// getServicePreviewURLs returns the url of load balancer service which has been assigned an external address,
+// cluster ip services are ignored as they are not accessible outside the cluster
+func getServicePreviewURLs(svc *corev1.Service) []s2hv1.PullRequestPreviewURL {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer || len(svc.Spec.Ports) == 0 {
+		return nil
+	}
+
+	host := ""
+	for _, lb := range svc.Status.LoadBalancer.Ingress {
+		if lb.Hostname != "" {
+			host = lb.Hostname
+			break
+		}
+		if lb.IP != "" {
+			host = lb.IP
+			break
+		}
+	}
+
+	if host == "" {
+		return nil
+	}
+
+	// sort ports to make the first url consistent
+	ports := append([]corev1.ServicePort{}, svc.Spec.Ports...)
+	sort.SliceStable(ports, func(i, j int) bool { return ports[i].Port < ports[j].Port })
+	port := ports[0].Port
+
+	return []s2hv1.PullRequestPreviewURL{
+		{
+			Name: svc.Name,
+			Kind: s2hv1.PullRequestPreviewService,
+			URL:  fmt.Sprintf("http://%s:%d", host, port),
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestPullRequestQueue(t *testing.T) {
+	unittest.InitGinkgo(t, "Pull Request Queue Controller")
+}
+
+var _ = Describe("Pull request preview urls", func() {
+	g := NewWithT(GinkgoT())
+
+	const prNamespace = "s2h-teamtest-wordpress-12"
+
+	It("should discover urls of ingresses and load balancer services", func() {
+		c := unittest.NewFakeClient(
+			&networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "wordpress", Namespace: prNamespace},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{{Hosts: []string{"wordpress.example.com"}}},
+					Rules: []networkingv1.IngressRule{
+						{Host: "wordpress.example.com"},
+						{
+							Host: "blog.example.com",
+							IngressRuleValue: networkingv1.IngressRuleValue{
+								HTTP: &networkingv1.HTTPIngressRuleValue{
+									Paths: []networkingv1.HTTPIngressPath{{Path: "/blog"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "mariadb", Namespace: prNamespace},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 9104}, {Port: 3306}},
+				},
+			},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "mariadb-headless", Namespace: prNamespace},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: corev1.ClusterIPNone,
+					Ports:     []corev1.ServicePort{{Port: 3306}},
+				},
+			},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      internal.StagingCtrlName,
+					Namespace: prNamespace,
+					Labels:    map[string]string{"app.kubernetes.io/managed-by": internal.AppName},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: internal.StagingDefaultPort}},
+				},
+			},
+		)
+
+		urls, err := discoverPreviewURLs(context.TODO(), c, prNamespace)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(urls).To(Equal([]s2hv1.PullRequestPreviewURL{
+			{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://wordpress.example.com"},
+			{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "http://blog.example.com/blog"},
+		}))
+	})
+
+	It("should use load balancer address of service", func() {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "wordpress", Namespace: prNamespace},
+			Spec: corev1.ServiceSpec{
+				Type:  corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{{Port: 80}},
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+				},
+			},
+		}
+
+		urls := getServicePreviewURLs(svc)
+		g.Expect(urls).To(HaveLen(1))
+		g.Expect(urls[0].URL).To(Equal("http://10.0.0.1:80"))
+	})
+
+	It("should ignore load balancer service without address", func() {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "wordpress", Namespace: prNamespace},
+			Spec: corev1.ServiceSpec{
+				Type:  corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{{Port: 80}},
+			},
+		}
+
+		g.Expect(getServicePreviewURLs(svc)).To(BeEmpty())
+	})
+})
@@ -106,6 +106,9 @@ func (c *controller) ensurePullRequestComponentsDeploying(ctx context.Context, p
 	prComps := prQueue.Spec.Components
 	prNamespace := prQueue.Status.PullRequestNamespace
 
+	// preview urls of the previous deployment are no longer valid
+	prQueue.Status.SetPreviewURLs(nil)
+
 	err := c.updatePullRequestComponentDependenciesVersion(ctx, c.teamName, prQueue.Spec.BundleName, &prComps)
 	if err != nil {
 		return err
@@ -131,6 +134,7 @@ func (c *controller) ensurePullRequestComponentsDeploying(ctx context.Context, p
 				"prNumber", prQueue.Spec.PRNumber)
 			prQueue.Status.SetCondition(s2hv1.PullRequestQueueCondDeployed, corev1.ConditionTrue,
 				"Components have been deployed successfully")
+			c.setPreviewURLs(ctx, prQueue)
 			prQueue.SetState(s2hv1.PullRequestQueueTesting)
 			return nil
 		}
@@ -249,3 +253,66 @@ func (c *controller) ensurePullRequestComponents(
 
 	return deployedQueue, nil
 }
+
+// setPreviewURLs stores endpoints of the pull request environment into pull request queue,
+// the deployment flow will not be interrupted if the endpoints cannot be discovered
+func (c *controller) setPreviewURLs(ctx context.Context, prQueue *s2hv1.PullRequestQueue) {
+	prNamespace := prQueue.Status.PullRequestNamespace
+	runtimeClient, err := c.getRuntimeClient()
+	if err != nil {
+		logger.Error(err, "cannot get runtime client to discover preview urls of pull request environment",
+			"team", c.teamName, "namespace", prNamespace)
+		return
+	}
+
+	urls, err := discoverPreviewURLs(ctx, runtimeClient, prNamespace)
+	if err != nil {
+		logger.Error(err, "cannot discover preview urls of pull request environment",
+			"team", c.teamName, "namespace", prNamespace)
+		return
+	}
+
+	prQueue.Status.SetPreviewURLs(urls)
+}
+
+// retestPullRequestQueue re-runs the testing on the pull request environment which has not been destroyed yet
+func (c *controller) retestPullRequestQueue(prQueue *s2hv1.PullRequestQueue) error {
+	retestReq := prQueue.Spec.RetestRequest
+	prQueue.Spec.RetestRequest = nil
+
+	prNamespace := prQueue.Status.PullRequestNamespace
+	if prNamespace == "" || prQueue.Status.IsConditionTrue(s2hv1.PullRequestQueueCondEnvDestroyed) {
+		logger.Warn("cannot retest pull request queue, environment has been destroyed",
+			"team", c.teamName, "name", prQueue.Name)
+		return nil
+	}
+
+	runtimeClient, err := c.getRuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	if err := queue.RetestPullRequestComponents(runtimeClient, prNamespace, prQueue.Name); err != nil {
+		return err
+	}
+
+	logger.Info("retesting pull request queue without redeploying", "team", c.teamName,
+		"name", prQueue.Name, "by", retestReq.RequestedBy)
+
+	conditions := make([]s2hv1.PullRequestQueueCondition, 0)
+	for _, cond := range prQueue.Status.Conditions {
+		switch cond.Type {
+		case s2hv1.PullRequestQueueCondTested, s2hv1.PullRequestQueueCondResultCollected:
+		default:
+			conditions = append(conditions, cond)
+		}
+	}
+
+	prQueue.Status.Conditions = conditions
+	prQueue.Status.SetResult("")
+	prQueue.Status.SetPullRequestQueueHistoryName("")
+	prQueue.Status.DestroyedTime = nil
+	prQueue.SetState(s2hv1.PullRequestQueueTesting)
+
+	return nil
+}
@@ -165,6 +165,7 @@ func (c *controller) add(ctx context.Context, queue *s2hv1.Queue, atTop bool, pr
 		if updatingList[i].Name != "" {
 			updatingList[i].Spec.Components.Sort()
 			updatingList[i].Spec.NoOfRetry = 0
+			updatingList[i].Spec.NoOfFailureRetry = nil
 			updatingList[i].Spec.NextProcessAt = nil
 			updatingList[i].Status.State = s2hv1.Waiting
 			updatingList[i].Spec.Components.Sort()
@@ -179,7 +180,9 @@ func (c *controller) add(ctx context.Context, queue *s2hv1.Queue, atTop bool, pr
 	for i := range updatingList {
 		if updatingList[i].Name != "" {
 			isAlreadyInBundle = true
+			updatingList[i].Spec.Actions = append(updatingList[i].Spec.Actions, queue.Spec.Actions...)
 			updatingList[i].Spec.NoOfRetry = 0
+			updatingList[i].Spec.NoOfFailureRetry = nil
 			updatingList[i].Spec.NextProcessAt = nil
 			updatingList[i].Status.State = s2hv1.Waiting
 			updatingList[i].Spec.Components.Sort()
@@ -201,6 +204,8 @@ func (c *controller) add(ctx context.Context, queue *s2hv1.Queue, atTop bool, pr
 			pQueue.Spec.NoOfOrder = queueList.TopQueueOrder()
 		}
 
+		pQueue.Spec.Actions = append(pQueue.Spec.Actions, queue.Spec.Actions...)
+
 		pQueue.Spec.Components.Sort()
 		if err = c.client.Update(ctx, pQueue); err != nil {
 			return err
@@ -594,6 +599,40 @@ func EnsurePullRequestComponents(c client.Client, q *s2hv1.Queue) error {
 	return ensureQueue(context.TODO(), c, q)
 }
 
+// RetestPullRequestComponents re-runs the testing of pull request components which have been deployed
+func RetestPullRequestComponents(c client.Client, ns, queueName string) error {
+	ctx := context.TODO()
+	q := &s2hv1.Queue{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ns, Name: queueName}, q); err != nil {
+		return errors.Wrapf(err, "cannot get queue %s", queueName)
+	}
+
+	ResetTestingStatus(q)
+	q.SetState(s2hv1.Testing)
+
+	return errors.Wrapf(c.Update(ctx, q), "cannot update queue %s", queueName)
+}
+
+// ResetTestingStatus resets status of the queue to run the testing again on the deployed environment
+func ResetTestingStatus(q *s2hv1.Queue) {
+	conditions := make([]s2hv1.QueueCondition, 0)
+	for _, cond := range q.Status.Conditions {
+		switch cond.Type {
+		case s2hv1.QueueCleaningBeforeStarted, s2hv1.QueueCleanedBefore,
+			s2hv1.QueueDeployStarted, s2hv1.QueueDeployed:
+			conditions = append(conditions, cond)
+		}
+	}
+
+	q.Status.Conditions = conditions
+	q.Status.NoOfProcessed++
+	q.Status.StartTestingTime = nil
+	q.Status.TestRunner = s2hv1.TestRunner{}
+	q.Status.TestStages = nil
+	q.Status.TestReport = nil
+	q.Status.KubeZipLog = ""
+}
+
 func DeletePreActiveQueue(c client.Client, ns string) error {
 	return deleteQueue(c, ns, string(s2hv1.EnvPreActive))
 }
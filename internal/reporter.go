@@ -30,6 +30,20 @@ func WithTestRunner(tr s2hv1.TestRunner) ComponentUpgradeOption {
 	}
 }
 
+// WithTestStages specifies results of test stages to override when creating component upgrade reporter object
+func WithTestStages(stages []s2hv1.TestStage) ComponentUpgradeOption {
+	return func(c *ComponentUpgradeReporter) {
+		c.TestStages = stages
+	}
+}
+
+// WithTestReport specifies summary of test results to override when creating component upgrade reporter object
+func WithTestReport(report *s2hv1.TestReport) ComponentUpgradeOption {
+	return func(c *ComponentUpgradeReporter) {
+		c.TestReport = report
+	}
+}
+
 // WithQueueHistoryName specifies queuehistory name to override when creating component upgrade reporter object
 // QueueHistoryName will be the latest failure of component upgrade
 // if reverification is success, QueueHistoryName will be the history of queue before running reverification
@@ -55,13 +69,24 @@ func WithComponentUpgradeOptCredential(creds s2hv1.Credential) ComponentUpgradeO
 	}
 }
 
+// WithPreviewURLs specifies preview urls of pull request environment to override
+// when creating component upgrade reporter object
+func WithPreviewURLs(urls []s2hv1.PullRequestPreviewURL) ComponentUpgradeOption {
+	return func(c *ComponentUpgradeReporter) {
+		c.PreviewURLs = urls
+	}
+}
+
 // ComponentUpgradeReporter manages component upgrade report
 type ComponentUpgradeReporter struct {
-	IssueTypeStr IssueType        `json:"issueTypeStr,omitempty"`
-	StatusStr    StatusType       `json:"statusStr,omitempty"`
-	StatusInt    int32            `json:"statusInt,omitempty"`
-	TestRunner   s2hv1.TestRunner `json:"testRunner,omitempty"`
-	Credential   s2hv1.Credential `json:"credential,omitempty"`
+	IssueTypeStr IssueType                     `json:"issueTypeStr,omitempty"`
+	StatusStr    StatusType                    `json:"statusStr,omitempty"`
+	StatusInt    int32                         `json:"statusInt,omitempty"`
+	TestRunner   s2hv1.TestRunner              `json:"testRunner,omitempty"`
+	TestStages   []s2hv1.TestStage             `json:"testStages,omitempty"`
+	TestReport   *s2hv1.TestReport             `json:"testReport,omitempty"`
+	Credential   s2hv1.Credential              `json:"credential,omitempty"`
+	PreviewURLs  []s2hv1.PullRequestPreviewURL `json:"previewURLs,omitempty"`
 	Envs         map[string]string
 
 	*rpc.ComponentUpgrade
@@ -0,0 +1,266 @@
+package bitbucket
+
+import (
+	"fmt"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/bitbucket"
+	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
+)
+
+var logger = s2hlog.Log.WithName(ReporterName)
+
+const (
+	ReporterName = "bitbucket"
+
+	LabelNameLogs    = "Samsahai Deployment - Logs"
+	LabelNameHistory = "Samsahai Deployment - History"
+	LabelNamePreview = "Samsahai Preview - %s"
+)
+
+type reporter struct {
+	bitbucket      bitbucket.Bitbucket
+	bitbucketURL   string
+	bitbucketToken string
+}
+
+// NewOption allows specifying various configuration
+type NewOption func(*reporter)
+
+// WithBitbucketClient specifies a bitbucket client to override when creating Bitbucket reporter
+func WithBitbucketClient(bb bitbucket.Bitbucket) NewOption {
+	if bb == nil {
+		panic("Bitbucket client should not be nil")
+	}
+
+	return func(r *reporter) {
+		r.bitbucket = bb
+	}
+}
+
+// WithBitbucketURL specifies a bitbucket server url to override when creating Bitbucket reporter
+func WithBitbucketURL(url string) NewOption {
+	return func(r *reporter) {
+		r.bitbucketURL = url
+	}
+}
+
+// WithBitbucketToken specifies a bitbucket http access token to override when creating Bitbucket reporter
+func WithBitbucketToken(token string) NewOption {
+	return func(r *reporter) {
+		r.bitbucketToken = token
+	}
+}
+
+// New creates a new Bitbucket reporter
+func New(opts ...NewOption) internal.Reporter {
+	r := &reporter{}
+
+	// apply the new options
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// NewBitbucketClient returns a bitbucket client for publishing build status to Bitbucket Server
+func NewBitbucketClient(baseURL, token string) bitbucket.Bitbucket {
+	return bitbucket.NewClient(baseURL, token)
+}
+
+// GetName returns a reporter type
+func (r *reporter) GetName() string {
+	return ReporterName
+}
+
+// SendComponentUpgrade implements the reporter SendComponentUpgrade function
+func (r *reporter) SendComponentUpgrade(configCtrl internal.ConfigController,
+	comp *internal.ComponentUpgradeReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendPullRequestQueue implements the reporter SendPullRequestQueue function
+func (r *reporter) SendPullRequestQueue(configCtrl internal.ConfigController,
+	comp *internal.ComponentUpgradeReporter) error {
+
+	bitbucketConfig, err := r.getBitbucketConfig(comp.TeamName, configCtrl)
+	if err != nil || comp.PullRequestComponent == nil {
+		return nil
+	}
+
+	baseURL, token := r.getBitbucketCredential(comp.Credential, bitbucketConfig)
+
+	commitSHA := comp.PullRequestComponent.CommitSHA
+	buildState := r.convertBuildState(comp.Status)
+
+	// send pull request history URL
+	prHistURL := r.getPRHistoryURL(comp)
+	prHistDesc := "Samsahai pull request deployment history"
+	err = r.post(bitbucketConfig, baseURL, token, commitSHA, LabelNameHistory, prHistURL, prHistDesc, buildState,
+		internal.PullRequestQueueType)
+	if err != nil {
+		return err
+	}
+
+	// send pull request logs URL
+	prLogsURL := r.getPRLogsURL(comp)
+	prLogsDesc := "Samsahai pull request deployment logs"
+	err = r.post(bitbucketConfig, baseURL, token, commitSHA, LabelNameLogs, prLogsURL, prLogsDesc, buildState,
+		internal.PullRequestQueueType)
+	if err != nil {
+		return err
+	}
+
+	// send pull request environment preview URLs, only the first url of each object is published
+	published := make(map[string]bool)
+	for _, preview := range comp.PreviewURLs {
+		if published[preview.Name] {
+			continue
+		}
+		published[preview.Name] = true
+
+		previewLabel := fmt.Sprintf(LabelNamePreview, preview.Name)
+		previewDesc := fmt.Sprintf("Samsahai pull request environment preview (%s)", preview.Kind)
+		err = r.post(bitbucketConfig, baseURL, token, commitSHA, previewLabel, preview.URL, previewDesc,
+			buildState, internal.PullRequestQueueType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendActiveEnvironmentDeleted implements the reporter SendActiveEnvironmentDeleted function
+func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigController,
+	activeNsDeletedRpt *internal.ActiveEnvironmentDeletedReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendActivePromotionStatus implements the reporter SendActivePromotionStatus function
+func (r *reporter) SendActivePromotionStatus(configCtrl internal.ConfigController,
+	atpRpt *internal.ActivePromotionReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendImageMissing implements the reporter SendImageMissing function
+func (r *reporter) SendImageMissing(configCtrl internal.ConfigController,
+	imageMissingRpt *internal.ImageMissingReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendPullRequestTriggerResult implements the reporter SendPullRequestTriggerResult function
+func (r *reporter) SendPullRequestTriggerResult(configCtrl internal.ConfigController,
+	prTriggerRpt *internal.PullRequestTriggerReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendPullRequestTestRunnerPendingResult implements the reporter SendPullRequestTestRunnerPendingResult function
+func (r *reporter) SendPullRequestTestRunnerPendingResult(configCtrl internal.ConfigController,
+	prTestRunnerRpt *internal.PullRequestTestRunnerPendingReporter) error {
+
+	bitbucketConfig, err := r.getBitbucketConfig(prTestRunnerRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	baseURL, token := r.getBitbucketCredential(prTestRunnerRpt.Credential, bitbucketConfig)
+
+	// bitbucket requires url of build status, the team page is used while testrunner pipeline is running
+	teamURL := fmt.Sprintf("%s/teams/%s", prTestRunnerRpt.SamsahaiExternalURL, prTestRunnerRpt.TeamName)
+
+	// send pull request log status in progress while testrunner pipeline is running
+	return r.post(bitbucketConfig, baseURL, token, prTestRunnerRpt.CommitSHA, LabelNameLogs, teamURL, "",
+		bitbucket.BuildStateInProgress, internal.PullRequestQueueType)
+}
+
+func (r *reporter) convertBuildState(rpcStatus rpc.ComponentUpgrade_UpgradeStatus) bitbucket.BuildState {
+	switch rpcStatus {
+	case rpc.ComponentUpgrade_UpgradeStatus_SUCCESS:
+		return bitbucket.BuildStateSuccessful
+	default:
+		return bitbucket.BuildStateFailed
+	}
+}
+
+func (r *reporter) getPRHistoryURL(comp *internal.ComponentUpgradeReporter) string {
+	return fmt.Sprintf("%s/teams/%s/pullrequest/queue/histories/%s",
+		comp.SamsahaiExternalURL, comp.TeamName, comp.QueueHistoryName)
+}
+
+func (r *reporter) getPRLogsURL(comp *internal.ComponentUpgradeReporter) string {
+	return fmt.Sprintf("%s/teams/%s/pullrequest/queue/histories/%s/log",
+		comp.SamsahaiExternalURL, comp.TeamName, comp.QueueHistoryName)
+}
+
+func (r *reporter) post(bitbucketConfig *s2hv1.ReporterBitbucket, baseURL, token string,
+	commitSHA, key, targetURL, description string,
+	buildState bitbucket.BuildState, event internal.EventType) error {
+
+	if !bitbucketConfig.Enabled || commitSHA == "" {
+		return nil
+	}
+
+	logger.Debug("start publishing build status to Bitbucket",
+		"event", event, "commitSHA", commitSHA, "state", buildState)
+
+	bitbucketCli := r.bitbucket
+	if r.bitbucket == nil {
+		bitbucketCli = NewBitbucketClient(baseURL, token)
+	}
+
+	err := bitbucketCli.PublishBuildStatus(commitSHA, key, targetURL, description, buildState)
+	if err != nil {
+		logger.Error(err, "cannot publish build status into bitbucket",
+			"commitSHA", commitSHA, "key", key, "targetURL", targetURL, "state", buildState)
+		return err
+	}
+
+	return nil
+}
+
+func (r *reporter) getBitbucketConfig(teamName string, configCtrl internal.ConfigController) (
+	*s2hv1.ReporterBitbucket, error) {
+
+	config, err := configCtrl.Get(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// no Bitbucket configuration
+	if config.Status.Used.Reporter == nil || config.Status.Used.Reporter.Bitbucket == nil {
+		return nil, s2herrors.New("bitbucket configuration not found")
+	}
+
+	return config.Status.Used.Reporter.Bitbucket, nil
+}
+
+// getBitbucketCredential returns the base url and token of the team, the default ones are used if not defined
+func (r *reporter) getBitbucketCredential(credential s2hv1.Credential, bitbucketConfig *s2hv1.ReporterBitbucket) (
+	baseURL, token string) {
+
+	baseURL, token = r.bitbucketURL, r.bitbucketToken
+	if credential.Bitbucket != nil && credential.Bitbucket.Token != "" {
+		token = credential.Bitbucket.Token
+	}
+
+	if bitbucketConfig.BaseURL != "" {
+		baseURL = bitbucketConfig.BaseURL
+	}
+
+	return baseURL, token
+}
@@ -0,0 +1,590 @@
+package email
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/reporter/util"
+	emailutil "github.com/agoda-com/samsahai/internal/util/email"
+	"github.com/agoda-com/samsahai/internal/util/template"
+	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
+)
+
+var logger = s2hlog.Log.WithName(ReporterName)
+
+const (
+	ReporterName = "email"
+
+	subjectPrefix = "[Samsahai]"
+
+	styleDanger  = `style="color:#EE2828"`
+	styleWarning = `style="color:#EEA328"`
+	styleInfo    = `style="color:#2EB44E"`
+)
+
+type reporter struct {
+	email emailutil.Email
+}
+
+// NewOption allows specifying various configuration
+type NewOption func(*reporter)
+
+// WithEmailClient specifies email client to override when creating email reporter
+func WithEmailClient(email emailutil.Email) NewOption {
+	if email == nil {
+		panic("Email client should not be nil")
+	}
+
+	return func(r *reporter) {
+		r.email = email
+	}
+}
+
+// New creates a new email reporter
+func New(opts ...NewOption) internal.Reporter {
+	r := &reporter{}
+
+	// apply the new options
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// NewEmail returns an email client for sending report via smtp server
+func NewEmail(server string, port int) emailutil.Email {
+	return emailutil.NewClient(server, port)
+}
+
+// GetName returns email type
+func (r *reporter) GetName() string {
+	return ReporterName
+}
+
+// SendComponentUpgrade implements the reporter SendComponentUpgrade function
+func (r *reporter) SendComponentUpgrade(configCtrl internal.ConfigController, comp *internal.ComponentUpgradeReporter) error {
+	emailConfig, err := r.getEmailConfig(comp.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	var extraMessage string
+	var eventRecipients *s2hv1.EmailReport
+	if emailConfig.ComponentUpgrade != nil {
+		if err := util.CheckMatchingInterval(emailConfig.ComponentUpgrade.Interval, comp.IsReverify); err != nil {
+			return nil
+		}
+
+		if err := util.CheckMatchingCriteria(emailConfig.ComponentUpgrade.Criteria, string(comp.StatusStr)); err != nil {
+			return nil
+		}
+
+		extraMessage = emailConfig.ComponentUpgrade.ExtraMessage
+		eventRecipients = &emailConfig.ComponentUpgrade.EmailReport
+	}
+
+	subject := r.makeSubject("Component Upgrade {{ .StatusStr }}: {{ .Name }} ({{ .TeamName }})", comp)
+	message := r.makeComponentUpgradeReport(comp, extraMessage)
+	if len(comp.ImageMissingList) > 0 {
+		message += "<hr/>"
+		message += r.makeImageMissingListReport(convertRPCImageListToK8SImageList(comp.ImageMissingList), "")
+	}
+
+	to := getRecipients(emailConfig.To, eventRecipients)
+	return r.send(emailConfig, to, subject, message, internal.ComponentUpgradeType)
+}
+
+// SendPullRequestQueue implements the reporter SendPullRequestQueue function
+func (r *reporter) SendPullRequestQueue(configCtrl internal.ConfigController, comp *internal.ComponentUpgradeReporter) error {
+	emailConfig, err := r.getEmailConfig(comp.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	var extraMessage string
+	var eventRecipients *s2hv1.EmailReport
+	if emailConfig.PullRequestQueue != nil {
+		if err := util.CheckMatchingInterval(emailConfig.PullRequestQueue.Interval, comp.IsReverify); err != nil {
+			return nil
+		}
+
+		if err := util.CheckMatchingCriteria(emailConfig.PullRequestQueue.Criteria, string(comp.StatusStr)); err != nil {
+			return nil
+		}
+
+		extraMessage = emailConfig.PullRequestQueue.ExtraMessage
+		eventRecipients = &emailConfig.PullRequestQueue.EmailReport
+	}
+
+	subject := r.makeSubject("Pull Request Queue {{ .StatusStr }}: {{ .Name }} ({{ .TeamName }})", comp)
+	message := r.makePullRequestQueueReport(comp, extraMessage)
+	if len(comp.ImageMissingList) > 0 {
+		message += "<hr/>"
+		message += r.makeImageMissingListReport(convertRPCImageListToK8SImageList(comp.ImageMissingList), "")
+	}
+
+	to := getRecipients(emailConfig.To, eventRecipients)
+	return r.send(emailConfig, to, subject, message, internal.PullRequestQueueType)
+}
+
+// SendActivePromotionStatus implements the reporter SendActivePromotionStatus function
+func (r *reporter) SendActivePromotionStatus(configCtrl internal.ConfigController, atpRpt *internal.ActivePromotionReporter) error {
+	emailConfig, err := r.getEmailConfig(atpRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	subject := r.makeSubject("Active Promotion {{ .Result }} ({{ .TeamName }})", atpRpt)
+	message := r.makeActivePromotionStatusReport(atpRpt)
+
+	imageMissingList := atpRpt.ActivePromotionStatus.PreActiveQueue.ImageMissingList
+	if len(imageMissingList) > 0 {
+		message += "<hr/>"
+		message += r.makeImageMissingListReport(imageMissingList, "")
+	}
+
+	if atpRpt.HasOutdatedComponent {
+		message += "<hr/>"
+		message += r.makeOutdatedComponentsReport(atpRpt.OutdatedComponents)
+	} else {
+		message += "<br/>"
+		message += r.makeNoOutdatedComponentsReport()
+	}
+
+	isDemotionFailed := atpRpt.DemotionStatus == s2hv1.ActivePromotionDemotionFailure
+	if isDemotionFailed {
+		message += "<br/>"
+		message += r.makeActiveDemotingFailureReport()
+	}
+
+	if atpRpt.RollbackStatus == s2hv1.ActivePromotionRollbackFailure {
+		message += "<br/>"
+		message += r.makeActivePromotionRollbackFailureReport()
+	}
+
+	hasPreviousActiveNamespace := atpRpt.PreviousActiveNamespace != ""
+	if atpRpt.Result == s2hv1.ActivePromotionSuccess && hasPreviousActiveNamespace && !isDemotionFailed {
+		message += "<br/>"
+		message += r.makeDestroyedPreviousActiveTimeReport(&atpRpt.ActivePromotionStatus)
+	}
+
+	to := getRecipients(emailConfig.To, emailConfig.ActivePromotion)
+	return r.send(emailConfig, to, subject, message, internal.ActivePromotionType)
+}
+
+// SendImageMissing implements the reporter SendImageMissing function
+func (r *reporter) SendImageMissing(configCtrl internal.ConfigController, imageMissingRpt *internal.ImageMissingReporter) error {
+	emailConfig, err := r.getEmailConfig(imageMissingRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	subject := r.makeSubject("Image Missing: {{ .Repository }}:{{ .Tag }} ({{ .TeamName }})", imageMissingRpt)
+	message := r.makeImageMissingListReport([]s2hv1.Image{imageMissingRpt.Image}, imageMissingRpt.Reason)
+
+	to := getRecipients(emailConfig.To, emailConfig.ImageMissing)
+	return r.send(emailConfig, to, subject, message, internal.ImageMissingType)
+}
+
+// SendPullRequestTriggerResult implements the reporter SendPullRequestTriggerResult function
+func (r *reporter) SendPullRequestTriggerResult(configCtrl internal.ConfigController,
+	prTriggerRpt *internal.PullRequestTriggerReporter) error {
+
+	emailConfig, err := r.getEmailConfig(prTriggerRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	var extraMessage string
+	var eventRecipients *s2hv1.EmailReport
+	if emailConfig.PullRequestTrigger != nil {
+		err := util.CheckMatchingCriteria(emailConfig.PullRequestTrigger.Criteria, prTriggerRpt.Result)
+		if err != nil {
+			return nil
+		}
+
+		extraMessage = emailConfig.PullRequestTrigger.ExtraMessage
+		eventRecipients = &emailConfig.PullRequestTrigger.EmailReport
+	}
+
+	subject := r.makeSubject("Pull Request Trigger {{ .Result }}: {{ .BundleName }} #{{ .PRNumber }} ({{ .TeamName }})",
+		prTriggerRpt)
+	message := r.makePullRequestTriggerResultReport(prTriggerRpt, extraMessage)
+	if len(prTriggerRpt.ImageMissingList) > 0 {
+		message += "<hr/>"
+		message += r.makeImageMissingListReport(prTriggerRpt.ImageMissingList, "")
+	}
+
+	to := getRecipients(emailConfig.To, eventRecipients)
+	return r.send(emailConfig, to, subject, message, internal.PullRequestTriggerType)
+}
+
+// SendPullRequestTestRunnerPendingResult implements the reporter SendPullRequestTestRunnerPendingResult function
+func (r *reporter) SendPullRequestTestRunnerPendingResult(configCtrl internal.ConfigController,
+	prTestRunnerRpt *internal.PullRequestTestRunnerPendingReporter) error {
+
+	emailConfig, err := r.getEmailConfig(prTestRunnerRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	// only send to explicit recipients, this event is too noisy for the default recipients
+	if emailConfig.PullRequestTestRunnerPending == nil {
+		return nil
+	}
+
+	subject := r.makeSubject("Pull Request Test Running: {{ .BundleName }} #{{ .PRNumber }} ({{ .TeamName }})",
+		prTestRunnerRpt)
+	message := r.makePullRequestTestRunnerPendingReport(prTestRunnerRpt)
+
+	to := getRecipients(emailConfig.To, emailConfig.PullRequestTestRunnerPending)
+	return r.send(emailConfig, to, subject, message, internal.PullRequestQueueType)
+}
+
+// SendActiveEnvironmentDeleted implements the reporter SendActiveEnvironmentDeleted function
+func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigController,
+	activeNsDeletedRpt *internal.ActiveEnvironmentDeletedReporter) error {
+
+	emailConfig, err := r.getEmailConfig(activeNsDeletedRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	subject := r.makeSubject("Active Environment Deleted: {{ .ActiveNamespace }} ({{ .TeamName }})", activeNsDeletedRpt)
+	message := r.makeActiveEnvironmentDeletedReport(activeNsDeletedRpt)
+
+	to := getRecipients(emailConfig.To, emailConfig.ActiveEnvironmentDeleted)
+	return r.send(emailConfig, to, subject, message, internal.ActiveEnvironmentDeletedType)
+}
+
+func convertRPCImageListToK8SImageList(images []*rpc.Image) []s2hv1.Image {
+	k8sImages := make([]s2hv1.Image, 0)
+	for _, img := range images {
+		k8sImages = append(k8sImages, s2hv1.Image{
+			Repository: img.Repository,
+			Tag:        img.Tag,
+		})
+	}
+
+	return k8sImages
+}
+
+// getRecipients returns recipients of the event if defined, otherwise returns default recipients
+func getRecipients(defaultTo []string, eventRecipients *s2hv1.EmailReport) []string {
+	if eventRecipients != nil && len(eventRecipients.To) > 0 {
+		return eventRecipients.To
+	}
+
+	return defaultTo
+}
+
+func (r *reporter) makeSubject(subject string, data interface{}) string {
+	return fmt.Sprintf("%s %s", subjectPrefix, strings.TrimSpace(template.TextRender("EmailSubject", subject, data)))
+}
+
+func (r *reporter) makeExtraMessageReport(extraMessage string) string {
+	if extraMessage == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("<br/><b>Message:</b> %s", extraMessage)
+}
+
+func (r *reporter) makeComponentUpgradeReport(comp *internal.ComponentUpgradeReporter, extraMessage string) string {
+	queueHistURL := `{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/queue/histories/{{ .QueueHistoryName }}`
+	queueLogURL := `{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/queue/histories/{{ .QueueHistoryName }}/log`
+
+	message := `
+<b>Component Upgrade:</b><span {{ if eq .Status 1 }}` + styleInfo + `> Success {{ else }}` + styleDanger + `> Failure{{ end }}</span>
+` + r.makeDeploymentQueueReport(comp, queueHistURL, queueLogURL) + "\n" + r.makeExtraMessageReport(extraMessage)
+	return strings.TrimSpace(template.HTMLRender("EmailComponentUpgrade", message, comp))
+}
+
+func (r *reporter) makePullRequestQueueReport(comp *internal.ComponentUpgradeReporter, extraMessage string) string {
+	queueHistURL := `{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/pullrequest/queue/histories/{{ .QueueHistoryName }}`
+	queueLogURL := `{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/pullrequest/queue/histories/{{ .QueueHistoryName }}/log`
+
+	message := `
+<b>Pull Request Queue:</b><span {{ if eq .Status 1 }}` + styleInfo + `> Success {{ else }}` + styleDanger + `> Failure{{ end }}</span>
+{{- if .PullRequestComponent }}
+<br/><b>Bundle:</b> {{ .PullRequestComponent.BundleName }}
+<br/><b>PR Number:</b> {{ .PullRequestComponent.PRNumber }}
+{{- end }}
+` + r.makeDeploymentQueueReport(comp, queueHistURL, queueLogURL) + "\n" + r.makeExtraMessageReport(extraMessage)
+	return strings.TrimSpace(template.HTMLRender("EmailPullRequestQueue", message, comp))
+}
+
+func (r *reporter) makeDeploymentQueueReport(comp *internal.ComponentUpgradeReporter, queueHistURL, queueLogURL string) string {
+	message := `
+{{- if eq .Status 0 }}
+<br/><b>Issue type:</b> {{ .IssueTypeStr }}
+{{- end }}
+<br/><b>Run:</b>{{ if .PullRequestComponent }} #{{ .Runs }}{{ else if .IsReverify }} Reverify {{ else }} #{{ .Runs }}{{ end }}
+<br/><b>Queue:</b> {{ .Name }}
+{{- if .Components }}
+<br/><b>Components:</b>
+<ul>
+{{- range .Components }}
+<li><b>Name:</b> {{ .Name }}
+<br/><b>Version:</b> {{ if .Image.Tag }}{{ .Image.Tag }}{{ else }}<code>no stable/active image tag found, using from values file</code>{{ end }}
+<br/><b>Repository:</b> {{ if .Image.Repository }}{{ .Image.Repository }}{{ else }}<code>no stable/active image repository found, using from values file</code>{{ end }}</li>
+{{- end }}
+</ul>
+{{- end }}
+<br/><b>Owner:</b> {{ .TeamName }}
+<br/><b>Namespace:</b> {{ .Namespace }}
+{{- if eq .Status 0 }}
+{{- if .ComponentUpgrade.DeploymentIssues }}
+<br/><b>Deployment Issues:</b>
+<ul>
+{{- range .ComponentUpgrade.DeploymentIssues }}
+<li><b>Issue type:</b> {{ .IssueType }}
+<br/><b>Components:</b> {{ range .FailureComponents }}{{ .ComponentName }},{{ end }}
+    {{- if eq .IssueType "WaitForInitContainer" }}
+<br/><b>Wait for:</b> {{ range .FailureComponents }}{{ .FirstFailureContainerName }},{{ end }}
+    {{- end }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- if .TestRunner.Teamcity.BuildURL }}
+<br/><b>Teamcity URL:</b> <a href="{{ .TestRunner.Teamcity.BuildURL }}">#{{ .TestRunner.Teamcity.BuildNumber }}</a>
+{{- end }}
+{{- if .TestRunner.Gitlab.PipelineURL }}
+<br/><b>GitLab URL:</b> <a href="{{ .TestRunner.Gitlab.PipelineURL }}">#{{ .TestRunner.Gitlab.PipelineNumber }}</a>
+{{- end }}
+{{- if .TestRunner.Rest.BuildURL }}
+<br/><b>Test URL:</b> <a href="{{ .TestRunner.Rest.BuildURL }}">#{{ .TestRunner.Rest.BuildNumber }}</a>
+{{- end }}
+{{- if .TestRunner.Jenkins.BuildURL }}
+<br/><b>Jenkins URL:</b> <a href="{{ .TestRunner.Jenkins.BuildURL }}">{{ .TestRunner.Jenkins.BuildNumber }}</a>
+{{- end }}
+{{- if .TestStages }}
+<br/><b>Test Stages:</b>
+<ul>
+{{- range .TestStages }}
+<li><b>{{ .Name }}:</b> {{ .Result }}{{ if not .Required }} (optional){{ end }}{{ if .Message }} - {{ .Message }}{{ end }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- if .TestReport }}
+<br/><b>Test Results:</b> {{ .TestReport.Total }} total, {{ .TestReport.Failed }} failed, {{ .TestReport.Skipped }} skipped
+{{- if .TestReport.FailedTests }}
+<ul>
+{{- range .TestReport.FailedTests }}
+<li>{{ . }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- end }}
+<br/><b>Deployment Logs:</b> <a href="` + queueLogURL + `">Download here</a>
+<br/><b>Deployment History:</b> <a href="` + queueHistURL + `">Click here</a>
+{{- end}}
+`
+	return strings.TrimSpace(template.HTMLRender("EmailDeploymentQueue", message, comp))
+}
+
+func (r *reporter) makeActivePromotionStatusReport(atpRpt *internal.ActivePromotionReporter) string {
+	var message = `
+<b>Active Promotion:</b> <span {{ if eq .Result "Success" }}` + styleInfo + `{{ else if eq .Result "Failure" }}` + styleDanger + `{{ end }}>{{ .Result }}</span>
+{{- if ne .Result "Success" }}
+{{- range .Conditions }}
+ {{- if eq .Type "` + string(s2hv1.ActivePromotionCondActivePromoted) + `" }}
+<br/><b>Reason:</b> {{ .Message }}
+ {{- end }}
+{{- end }}
+{{- end }}
+<br/><b>Run:</b> #{{ .Runs }}
+<br/><b>Current Active Namespace:</b> {{ .CurrentActiveNamespace }}
+<br/><b>Owner:</b> {{ .TeamName }}
+{{- if eq .Result "Failure" }}
+  {{- if .PreActiveQueue.DeploymentIssues }}
+<br/><b>Deployment Issues:</b>
+<ul>
+  {{- range .PreActiveQueue.DeploymentIssues }}
+<li><b>Issue type:</b> {{ .IssueType }}
+<br/><b>Components:</b> {{ range .FailureComponents }}{{ .ComponentName }},{{ end }}
+    {{- if eq .IssueType "WaitForInitContainer" }}
+<br/><b>Wait for:</b> {{ range .FailureComponents }}{{ .FirstFailureContainerName }},{{ end }}
+    {{- end }}</li>
+  {{- end }}
+</ul>
+  {{- end }}
+{{- end }}
+{{- if .PreActiveQueue.TestRunner }}
+{{- if and .PreActiveQueue.TestRunner.Teamcity .PreActiveQueue.TestRunner.Teamcity.BuildURL }}
+<br/><b>Teamcity URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Teamcity.BuildURL }}">#{{ .PreActiveQueue.TestRunner.Teamcity.BuildNumber }}</a>
+{{- end }}
+{{- if and .PreActiveQueue.TestRunner.Gitlab .PreActiveQueue.TestRunner.Gitlab.PipelineURL }}
+<br/><b>GitLab URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Gitlab.PipelineURL }}">#{{ .PreActiveQueue.TestRunner.Gitlab.PipelineNumber }}</a>
+{{- end }}
+{{- if and .PreActiveQueue.TestRunner.Rest .PreActiveQueue.TestRunner.Rest.BuildURL }}
+<br/><b>Test URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Rest.BuildURL }}">#{{ .PreActiveQueue.TestRunner.Rest.BuildNumber }}</a>
+{{- end }}
+{{- if and .PreActiveQueue.TestRunner.Jenkins .PreActiveQueue.TestRunner.Jenkins.BuildURL }}
+<br/><b>Jenkins URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Jenkins.BuildURL }}">{{ .PreActiveQueue.TestRunner.Jenkins.BuildNumber }}</a>
+{{- end }}
+{{- end }}
+{{- if eq .Result "Failure" }}
+<br/><b>Deployment Logs:</b> <a href="{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/activepromotions/histories/{{ .ActivePromotionHistoryName }}/log">Download here</a>
+{{- end }}
+<br/><b>Active Promotion History:</b> <a href="{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/activepromotions/histories/{{ .ActivePromotionHistoryName }}">Click here</a>
+`
+
+	return strings.TrimSpace(template.HTMLRender("EmailActivePromotionStatus", message, atpRpt))
+}
+
+func (r *reporter) makeOutdatedComponentsReport(comps map[string]s2hv1.OutdatedComponent) string {
+	var message = `
+<b>Outdated Components:</b>
+<ul>
+{{- range $name, $component := .Components }}
+{{- if gt .OutdatedDuration 0 }}
+<li><b>{{ $name }}</b>
+<br/>Not update for {{ .OutdatedDuration | FmtDurationToStr }}
+<br/>Current Version: <a href="{{ .CurrentImage.Repository | ConcatHTTPStr }}">{{ .CurrentImage.Tag }}</a>
+<br/>Latest Version: <a href="{{ .DesiredImage.Repository | ConcatHTTPStr }}">{{ .DesiredImage.Tag }}</a></li>
+{{- end }}
+{{- end }}
+</ul>
+`
+
+	ocObj := struct {
+		Components map[string]s2hv1.OutdatedComponent
+	}{Components: comps}
+	return strings.TrimSpace(template.HTMLRender("EmailOutdatedComponents", message, ocObj))
+}
+
+func (r *reporter) makeNoOutdatedComponentsReport() string {
+	var message = `
+<b>All components are up to date!</b>
+`
+
+	return strings.TrimSpace(template.HTMLRender("EmailNoOutdatedComponents", message, ""))
+}
+
+func (r *reporter) makeActivePromotionRollbackFailureReport() string {
+	var message = "<b " + styleDanger + ">ERROR:</b> cannot rollback an active promotion process due to timeout"
+
+	return strings.TrimSpace(template.HTMLRender("RollbackFailure", message, ""))
+}
+
+func (r *reporter) makeActiveDemotingFailureReport() string {
+	var message = "<b " + styleWarning + ">WARNING:</b> cannot demote a previous active environment, previous active namespace has been destroyed immediately"
+
+	return strings.TrimSpace(template.HTMLRender("DemotionFailure", message, ""))
+}
+
+func (r *reporter) makeDestroyedPreviousActiveTimeReport(status *s2hv1.ActivePromotionStatus) string {
+	var message = "<b " + styleWarning + ">NOTES:</b> previous active namespace <code>{{ .PreviousActiveNamespace }}</code> will be destroyed at <code>{{ .DestroyedTime | TimeFormat }}</code>"
+
+	return strings.TrimSpace(template.HTMLRender("DestroyedTime", message, status))
+}
+
+func (r *reporter) makeImageMissingListReport(images []s2hv1.Image, reason string) string {
+	var reasonMsg string
+	if reason != "" {
+		reasonMsg = fmt.Sprintf("<br/><code>%s</code>", html.EscapeString(reason))
+	}
+
+	var message = `
+<b>Image Missing List:</b>
+<ul>
+{{- range .Images }}
+<li>{{ .Repository }}:{{ .Tag }}` + reasonMsg + `</li>
+{{- end }}
+</ul>
+`
+
+	imagesObj := struct{ Images []s2hv1.Image }{Images: images}
+	return strings.TrimSpace(template.HTMLRender("EmailImageMissingList", message, imagesObj))
+}
+
+func (r *reporter) makePullRequestTriggerResultReport(prTriggerRpt *internal.PullRequestTriggerReporter, extraMessage string) string {
+	var message = `
+<b>Pull Request Trigger:</b> <span {{ if eq .Result "Success" }}` + styleInfo + `{{ else if eq .Result "Failure" }}` + styleDanger + `{{ end }}>{{ .Result }}</span>
+<br/><b>Bundle:</b> {{ .BundleName }}
+<br/><b>PR Number:</b> {{ .PRNumber }}
+<br/><b>Components:</b>
+{{- if .Components }}
+<ul>
+{{- range .Components }}
+<li><b>Name:</b> {{ .ComponentName }}
+<br/><b>Image:</b> {{ if .Image }}{{ .Image.Repository }}:{{ .Image.Tag }}{{ else }}no image defined{{ end }}</li>
+{{- end }}
+</ul>
+{{- else }}
+<code>no components defined</code>
+{{- end }}
+<br/><b>NO of Retry:</b> {{ .NoOfRetry }}
+<br/><b>Owner:</b> {{ .TeamName }}
+<br/><b>Start at:</b> {{ .CreatedAt | TimeFormat }}
+` + r.makeExtraMessageReport(extraMessage)
+
+	return strings.TrimSpace(template.HTMLRender("EmailPullRequestTriggerResult", message, prTriggerRpt))
+}
+
+func (r *reporter) makePullRequestTestRunnerPendingReport(prTestRunnerRpt *internal.PullRequestTestRunnerPendingReporter) string {
+	var message = `
+<b>Pull Request Test Runner:</b> <span ` + styleWarning + `>Running</span>
+<br/><b>Bundle:</b> {{ .BundleName }}
+<br/><b>PR Number:</b> {{ .PRNumber }}
+{{- if .CommitSHA }}
+<br/><b>Commit SHA:</b> {{ .CommitSHA }}
+{{- end }}
+<br/><b>Owner:</b> {{ .TeamName }}
+`
+
+	return strings.TrimSpace(template.HTMLRender("EmailPullRequestTestRunnerPending", message, prTestRunnerRpt))
+}
+
+func (r *reporter) makeActiveEnvironmentDeletedReport(activeNsDeletedRpt *internal.ActiveEnvironmentDeletedReporter) string {
+	var message = `
+<b>Active Environment Deleted</b>
+<br/><b>Active Namespace:</b> {{ .ActiveNamespace }}
+<br/><b>Owner:</b> {{ .TeamName }}
+<br/><b>Deleted by:</b> {{ .DeletedBy }}
+<br/><b>Deleted at:</b> {{ .DeletedAt }}
+`
+
+	return strings.TrimSpace(template.HTMLRender("EmailActiveEnvironmentDeleted", message, activeNsDeletedRpt))
+}
+
+func (r *reporter) send(emailConfig *s2hv1.ReporterEmail, to []string, subject, message string, event internal.EventType) error {
+	if len(to) == 0 {
+		logger.Debug("no email recipients defined, skip sending email", "event", event)
+		return nil
+	}
+
+	emailCli := r.email
+	if emailCli == nil {
+		emailCli = NewEmail(emailConfig.Server, emailConfig.Port)
+	}
+
+	logger.Debug("start sending email", "event", event, "to", to)
+	if err := emailCli.SendMessage(emailConfig.From, to, subject, message); err != nil {
+		logger.Error(err, "cannot send email", "event", event, "to", to)
+		return err
+	}
+
+	return nil
+}
+
+func (r *reporter) getEmailConfig(teamName string, configCtrl internal.ConfigController) (*s2hv1.ReporterEmail, error) {
+	config, err := configCtrl.Get(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// no email configuration
+	if config.Status.Used.Reporter == nil || config.Status.Used.Reporter.Email == nil {
+		return nil, s2herrors.New("email configuration not found")
+	}
+
+	return config.Status.Used.Reporter.Email, nil
+}
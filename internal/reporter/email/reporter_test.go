@@ -0,0 +1,488 @@
+package email_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2hemail "github.com/agoda-com/samsahai/internal/reporter/email"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
+)
+
+func TestUnit(t *testing.T) {
+	unittest.InitGinkgo(t, "Email Reporter")
+}
+
+var _ = Describe("send email message", func() {
+	g := NewGomegaWithT(GinkgoT())
+
+	Describe("send component upgrade", func() {
+		It("should correctly send component upgrade failure to default recipients", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:   "comp1",
+				Status: rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				Components: []*rpc.Component{
+					{
+						Name:  "comp1",
+						Image: &rpc.Image{Repository: "image-1", Tag: "1.1.0"},
+					},
+				},
+				TeamName:         "owner",
+				IssueType:        rpc.ComponentUpgrade_IssueType_DESIRED_VERSION_FAILED,
+				Namespace:        "owner-staging",
+				QueueHistoryName: "comp1-1234",
+				Runs:             2,
+				DeploymentIssues: []*rpc.DeploymentIssue{
+					{
+						IssueType: string(s2hv1.DeploymentIssueCrashLoopBackOff),
+						FailureComponents: []*rpc.FailureComponent{
+							{ComponentName: "comp1"},
+						},
+					},
+				},
+			}
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			testRunner := s2hv1.TestRunner{Teamcity: s2hv1.Teamcity{BuildURL: "teamcity-url", BuildNumber: "teamcity-build-number"}}
+			comp := internal.NewComponentUpgradeReporter(
+				rpcComp,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+				internal.WithTestRunner(testRunner),
+				internal.WithQueueHistoryName("comp1-5678"),
+			)
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.from).Should(Equal("samsahai@example.com"))
+			g.Expect(mockEmailCli.to).Should(Equal([]string{"default@example.com"}))
+			g.Expect(mockEmailCli.subject).Should(Equal("[Samsahai] Component Upgrade Failure: comp1 (owner)"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("Component Upgrade"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("Failure"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("#2"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Name:</b> comp1"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("1.1.0"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("image-1"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("Desired component failed"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring(`<a href="teamcity-url">#teamcity-build-number</a>`))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring(
+				`<a href="http://localhost:8080/teams/owner/queue/histories/comp1-5678/log">Download here</a>`))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring(
+				`<a href="http://localhost:8080/teams/owner/queue/histories/comp1-5678">Click here</a>`))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Issue type:</b> CrashLoopBackOff"))
+			g.Expect(mockEmailCli.body).ShouldNot(ContainSubstring("Image Missing List"))
+		})
+
+		It("should send component upgrade to event recipients with extra message", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, "",
+				[]string{"upgrade1@example.com", "upgrade2@example.com"})
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "comp1",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				TeamName: "owner",
+				ImageMissingList: []*rpc.Image{
+					{Repository: "image-2", Tag: "1.1.0"},
+				},
+			}
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.to).Should(Equal([]string{"upgrade1@example.com", "upgrade2@example.com"}))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Message:</b> upgrade message"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("Image Missing List"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("image-2:1.1.0"))
+		})
+
+		It("should escape html in component upgrade", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "comp1",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				TeamName: "owner",
+				Components: []*rpc.Component{
+					{
+						Name:  "<script>alert(1)</script>",
+						Image: &rpc.Image{Repository: "image-1", Tag: "<b>1.1.0</b>"},
+					},
+				},
+			}
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.body).ShouldNot(ContainSubstring("<script>"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("&lt;script&gt;alert(1)&lt;/script&gt;"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("&lt;b&gt;1.1.0&lt;/b&gt;"))
+		})
+
+		It("should not send component upgrade failure with retry interval", func() {
+			configCtrl := newMockConfigCtrl("", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:       "comp1",
+				Status:     rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				IsReverify: false,
+			}
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(0))
+		})
+
+		It("should not send component upgrade success with failure criteria", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, s2hv1.CriteriaFailure, nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:   "comp1",
+				Status: rpc.ComponentUpgrade_UpgradeStatus_SUCCESS,
+			}
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(0))
+		})
+	})
+
+	Describe("send pull request queue", func() {
+		It("should correctly send pull request queue success", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, s2hv1.CriteriaBoth, nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "bundle1-5",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_SUCCESS,
+				TeamName: "owner",
+				PullRequestComponent: &rpc.TeamWithPullRequest{
+					BundleName: "bundle1",
+					PRNumber:   "5",
+				},
+			}
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.subject).Should(Equal("[Samsahai] Pull Request Queue Success: bundle1-5 (owner)"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Bundle:</b> bundle1"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>PR Number:</b> 5"))
+		})
+	})
+
+	Describe("send active promotion status", func() {
+		It("should correctly send active promotion success with outdated components", func() {
+			configCtrl := newMockConfigCtrl("", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			var comp1, repoComp1 = "comp1", "repo/comp1"
+			var v110, v112 = "1.1.0", "1.1.2"
+			timeNow := metav1.Now()
+			status := &s2hv1.ActivePromotionStatus{
+				Result:                     s2hv1.ActivePromotionSuccess,
+				HasOutdatedComponent:       true,
+				ActivePromotionHistoryName: "owner-12345",
+				PreviousActiveNamespace:    "owner-prevns",
+				DestroyedTime:              &timeNow,
+				OutdatedComponents: map[string]s2hv1.OutdatedComponent{
+					comp1: {
+						CurrentImage:     &s2hv1.Image{Repository: repoComp1, Tag: v110},
+						DesiredImage:     &s2hv1.Image{Repository: repoComp1, Tag: v112},
+						OutdatedDuration: 86400000000000, // 1d0h0m
+					},
+				},
+			}
+			atpRpt := internal.NewActivePromotionReporter(*status,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"}, "owner", "owner-123456", 1)
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			err := r.SendActivePromotionStatus(configCtrl, atpRpt)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.to).Should(Equal([]string{"promotion@example.com"}))
+			g.Expect(mockEmailCli.subject).Should(Equal("[Samsahai] Active Promotion Success (owner)"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Current Active Namespace:</b> owner-123456"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("Not update for 1d 0h 0m"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<code>owner-prevns</code> will be destroyed at"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring(
+				`<a href="http://localhost:8080/teams/owner/activepromotions/histories/owner-12345">Click here</a>`))
+		})
+	})
+
+	Describe("send image missing", func() {
+		It("should correctly send image missing with reason", func() {
+			configCtrl := newMockConfigCtrl("", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			img := s2hv1.Image{Repository: "registry/comp-1", Tag: "1.0.0"}
+			imageMissingRpt := internal.NewImageMissingReporter(img, internal.SamsahaiConfig{},
+				"owner", "comp1", "image not found")
+			err := r.SendImageMissing(configCtrl, imageMissingRpt)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.subject).Should(Equal("[Samsahai] Image Missing: registry/comp-1:1.0.0 (owner)"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("registry/comp-1:1.0.0"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<code>image not found</code>"))
+		})
+	})
+
+	Describe("send pull request trigger result", func() {
+		It("should correctly send pull request trigger failure", func() {
+			configCtrl := newMockConfigCtrl("", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			timeNow := metav1.Now()
+			status := s2hv1.PullRequestTriggerStatus{
+				CreatedAt: &timeNow,
+			}
+			prComps := []*s2hv1.PullRequestTriggerComponent{
+				{
+					ComponentName: "comp1",
+					Image:         &s2hv1.Image{Repository: "registry/comp-1", Tag: "pr1234"},
+				},
+			}
+			prTriggerRpt := internal.NewPullRequestTriggerResultReporter(status, internal.SamsahaiConfig{},
+				"owner", "bundle1", "1234", "Failure", 2, prComps)
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			err := r.SendPullRequestTriggerResult(configCtrl, prTriggerRpt)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.subject).Should(Equal("[Samsahai] Pull Request Trigger Failure: bundle1 #1234 (owner)"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Image:</b> registry/comp-1:pr1234"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>NO of Retry:</b> 2"))
+		})
+	})
+
+	Describe("send pull request test runner pending", func() {
+		It("should not send if the event is not configured", func() {
+			configCtrl := newMockConfigCtrl("", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			prTestRunnerRpt := internal.NewPullRequestTestRunnerPendingReporter(internal.SamsahaiConfig{},
+				"owner", "bundle1", "1234", "abcdef", s2hv1.Credential{})
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			err := r.SendPullRequestTestRunnerPendingResult(configCtrl, prTestRunnerRpt)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(0))
+		})
+	})
+
+	Describe("send active environment deleted", func() {
+		It("should correctly send active environment deleted", func() {
+			configCtrl := newMockConfigCtrl("", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			deletedAt := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC).Format(time.RFC3339)
+			activeNsDeletedRpt := internal.NewActiveEnvironmentDeletedReporter("owner", "owner-abcd",
+				"user1", deletedAt)
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			err := r.SendActiveEnvironmentDeleted(configCtrl, activeNsDeletedRpt)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(1))
+			g.Expect(mockEmailCli.subject).Should(Equal("[Samsahai] Active Environment Deleted: owner-abcd (owner)"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Deleted by:</b> user1"))
+			g.Expect(mockEmailCli.body).Should(ContainSubstring("<b>Deleted at:</b> 2021-01-02T03:04:05Z"))
+		})
+	})
+
+	Describe("failure path", func() {
+		It("should not send message if not define email reporter configuration", func() {
+			configCtrl := newMockConfigCtrl("empty", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			img := s2hv1.Image{Repository: "registry/comp-1", Tag: "1.0.0"}
+			imageMissingRpt := internal.NewImageMissingReporter(img, internal.SamsahaiConfig{},
+				"owner", "comp1", "")
+			err := r.SendImageMissing(configCtrl, imageMissingRpt)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockEmailCli.sendMessageCalls).Should(Equal(0))
+		})
+
+		It("should fail to send message", func() {
+			configCtrl := newMockConfigCtrl("failure", "", "", nil)
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockEmailCli := &mockEmail{}
+			r := s2hemail.New(s2hemail.WithEmailClient(mockEmailCli))
+			img := s2hv1.Image{Repository: "registry/comp-1", Tag: "1.0.0"}
+			imageMissingRpt := internal.NewImageMissingReporter(img, internal.SamsahaiConfig{},
+				"owner", "comp1", "")
+			err := r.SendImageMissing(configCtrl, imageMissingRpt)
+			g.Expect(err).ShouldNot(BeNil())
+		})
+	})
+})
+
+// mockEmail mocks Email interface
+type mockEmail struct {
+	sendMessageCalls int
+	from             string
+	to               []string
+	subject          string
+	body             string
+}
+
+// SendMessage mocks SendMessage function
+func (e *mockEmail) SendMessage(from string, to []string, subject, body string) error {
+	if len(to) > 0 && to[0] == "error" {
+		return errors.New("error")
+	}
+
+	e.sendMessageCalls++
+	e.from = from
+	e.to = to
+	e.subject = subject
+	e.body = body
+
+	return nil
+}
+
+type mockConfigCtrl struct {
+	configType string
+	interval   s2hv1.ReporterInterval
+	criteria   s2hv1.ReporterCriteria
+	upgradeTo  []string
+}
+
+func newMockConfigCtrl(configType string, interval s2hv1.ReporterInterval, criteria s2hv1.ReporterCriteria,
+	upgradeTo []string) internal.ConfigController {
+
+	return &mockConfigCtrl{
+		configType: configType,
+		interval:   interval,
+		criteria:   criteria,
+		upgradeTo:  upgradeTo,
+	}
+}
+
+func (c *mockConfigCtrl) Get(configName string) (*s2hv1.Config, error) {
+	switch c.configType {
+	case "empty":
+		return &s2hv1.Config{}, nil
+	case "failure":
+		return &s2hv1.Config{
+			Status: s2hv1.ConfigStatus{
+				Used: s2hv1.ConfigSpec{
+					Reporter: &s2hv1.ConfigReporter{
+						Email: &s2hv1.ReporterEmail{
+							Server: "smtp.example.com",
+							Port:   25,
+							From:   "samsahai@example.com",
+							To:     []string{"error"},
+						},
+					},
+				},
+			},
+		}, nil
+	default:
+		upgradeExtraMessage := ""
+		if len(c.upgradeTo) > 0 {
+			upgradeExtraMessage = "upgrade message"
+		}
+
+		return &s2hv1.Config{
+			Status: s2hv1.ConfigStatus{
+				Used: s2hv1.ConfigSpec{
+					Reporter: &s2hv1.ConfigReporter{
+						Email: &s2hv1.ReporterEmail{
+							Server: "smtp.example.com",
+							Port:   25,
+							From:   "samsahai@example.com",
+							To:     []string{"default@example.com"},
+							ComponentUpgrade: &s2hv1.EmailComponentUpgradeReport{
+								ConfigComponentUpgradeReport: s2hv1.ConfigComponentUpgradeReport{
+									Interval:     c.interval,
+									Criteria:     c.criteria,
+									ExtraMessage: upgradeExtraMessage,
+								},
+								EmailReport: s2hv1.EmailReport{To: c.upgradeTo},
+							},
+							PullRequestQueue: &s2hv1.EmailPullRequestQueueReport{
+								ConfigPullRequestQueueReport: s2hv1.ConfigPullRequestQueueReport{
+									Interval: c.interval,
+									Criteria: c.criteria,
+								},
+							},
+							ActivePromotion: &s2hv1.EmailReport{
+								To: []string{"promotion@example.com"},
+							},
+						},
+					},
+				},
+			},
+		}, nil
+	}
+}
+
+func (c *mockConfigCtrl) GetComponents(configName string) (map[string]*s2hv1.Component, error) {
+	return map[string]*s2hv1.Component{}, nil
+}
+
+func (c *mockConfigCtrl) GetParentComponents(configName string) (map[string]*s2hv1.Component, error) {
+	return map[string]*s2hv1.Component{}, nil
+}
+
+func (c *mockConfigCtrl) GetPullRequestComponents(configName, prBundleName string, depIncluded bool) (map[string]*s2hv1.Component, error) {
+	return map[string]*s2hv1.Component{}, nil
+}
+
+func (c *mockConfigCtrl) GetBundles(configName string) (s2hv1.ConfigBundles, error) {
+	return s2hv1.ConfigBundles{}, nil
+}
+
+func (c *mockConfigCtrl) GetPriorityQueues(configName string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) GetStagingConfig(configName string) (*s2hv1.ConfigStaging, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) GetPullRequestConfig(configName string) (*s2hv1.ConfigPullRequest, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) GetPullRequestBundleDependencies(configName, prBundlesName string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) Update(config *s2hv1.Config) error {
+	return nil
+}
+
+func (c *mockConfigCtrl) Delete(configName string) error {
+	return nil
+}
+
+func (c *mockConfigCtrl) EnsureConfigTemplateChanged(config *s2hv1.Config) error {
+	return nil
+}
@@ -0,0 +1,281 @@
+package gitea
+
+import (
+	"fmt"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/gitea"
+	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
+)
+
+var logger = s2hlog.Log.WithName(ReporterName)
+
+const (
+	ReporterName = "gitea"
+
+	LabelNameLogs    = "Samsahai Deployment - Logs"
+	LabelNameHistory = "Samsahai Deployment - History"
+	LabelNamePreview = "Samsahai Preview - %s"
+)
+
+type reporter struct {
+	gitea      gitea.Gitea
+	giteaURL   string
+	giteaToken string
+}
+
+// NewOption allows specifying various configuration
+type NewOption func(*reporter)
+
+// WithGiteaClient specifies a gitea client to override when creating Gitea reporter
+func WithGiteaClient(gt gitea.Gitea) NewOption {
+	if gt == nil {
+		panic("Gitea client should not be nil")
+	}
+
+	return func(r *reporter) {
+		r.gitea = gt
+	}
+}
+
+// WithGiteaURL specifies a gitea url to override when creating Gitea reporter
+func WithGiteaURL(url string) NewOption {
+	return func(r *reporter) {
+		r.giteaURL = url
+	}
+}
+
+// WithGiteaToken specifies a gitea access token to override when creating Gitea reporter
+func WithGiteaToken(token string) NewOption {
+	return func(r *reporter) {
+		r.giteaToken = token
+	}
+}
+
+// New creates a new Gitea reporter
+func New(opts ...NewOption) internal.Reporter {
+	r := &reporter{}
+
+	// apply the new options
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// NewGiteaClient returns a gitea client for publishing commit status to Gitea
+func NewGiteaClient(baseURL, token string) gitea.Gitea {
+	return gitea.NewClient(baseURL, token)
+}
+
+// GetName returns a reporter type
+func (r *reporter) GetName() string {
+	return ReporterName
+}
+
+// SendComponentUpgrade implements the reporter SendComponentUpgrade function
+func (r *reporter) SendComponentUpgrade(configCtrl internal.ConfigController,
+	comp *internal.ComponentUpgradeReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendPullRequestQueue implements the reporter SendPullRequestQueue function
+func (r *reporter) SendPullRequestQueue(configCtrl internal.ConfigController,
+	comp *internal.ComponentUpgradeReporter) error {
+
+	giteaConfig, err := r.getGiteaConfig(comp.TeamName, configCtrl)
+	if err != nil || comp.PullRequestComponent == nil {
+		return nil
+	}
+
+	repository := r.getGiteaRepository(configCtrl, comp.TeamName, comp.PullRequestComponent.BundleName)
+	baseURL, token := r.getGiteaCredential(comp.Credential, giteaConfig)
+
+	commitSHA := comp.PullRequestComponent.CommitSHA
+	commitStatus := r.convertCommitStatus(comp.Status)
+
+	// send pull request history URL
+	prHistURL := r.getPRHistoryURL(comp)
+	prHistDesc := "Samsahai pull request deployment history"
+	err = r.post(giteaConfig, baseURL, token, repository, commitSHA, LabelNameHistory, prHistURL, prHistDesc, commitStatus,
+		internal.PullRequestQueueType)
+	if err != nil {
+		return err
+	}
+
+	// send pull request logs URL
+	prLogsURL := r.getPRLogsURL(comp)
+	prLogsDesc := "Samsahai pull request deployment logs"
+	err = r.post(giteaConfig, baseURL, token, repository, commitSHA, LabelNameLogs, prLogsURL, prLogsDesc, commitStatus,
+		internal.PullRequestQueueType)
+	if err != nil {
+		return err
+	}
+
+	// send pull request environment preview URLs, only the first url of each object is published
+	published := make(map[string]bool)
+	for _, preview := range comp.PreviewURLs {
+		if published[preview.Name] {
+			continue
+		}
+		published[preview.Name] = true
+
+		previewLabel := fmt.Sprintf(LabelNamePreview, preview.Name)
+		previewDesc := fmt.Sprintf("Samsahai pull request environment preview (%s)", preview.Kind)
+		err = r.post(giteaConfig, baseURL, token, repository, commitSHA, previewLabel, preview.URL, previewDesc,
+			commitStatus, internal.PullRequestQueueType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendActiveEnvironmentDeleted implements the reporter SendActiveEnvironmentDeleted function
+func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigController,
+	activeNsDeletedRpt *internal.ActiveEnvironmentDeletedReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendActivePromotionStatus implements the reporter SendActivePromotionStatus function
+func (r *reporter) SendActivePromotionStatus(configCtrl internal.ConfigController,
+	atpRpt *internal.ActivePromotionReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendImageMissing implements the reporter SendImageMissing function
+func (r *reporter) SendImageMissing(configCtrl internal.ConfigController,
+	imageMissingRpt *internal.ImageMissingReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendPullRequestTriggerResult implements the reporter SendPullRequestTriggerResult function
+func (r *reporter) SendPullRequestTriggerResult(configCtrl internal.ConfigController,
+	prTriggerRpt *internal.PullRequestTriggerReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendPullRequestTestRunnerPendingResult implements the reporter SendPullRequestTestRunnerPendingResult function
+func (r *reporter) SendPullRequestTestRunnerPendingResult(configCtrl internal.ConfigController,
+	prTestRunnerRpt *internal.PullRequestTestRunnerPendingReporter) error {
+
+	teamName := prTestRunnerRpt.TeamName
+	giteaConfig, err := r.getGiteaConfig(teamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	repository := r.getGiteaRepository(configCtrl, teamName, prTestRunnerRpt.BundleName)
+	baseURL, token := r.getGiteaCredential(prTestRunnerRpt.Credential, giteaConfig)
+
+	// send pull request log status pending while testrunner pipeline is running
+	return r.post(giteaConfig, baseURL, token, repository, prTestRunnerRpt.CommitSHA, LabelNameLogs, "", "",
+		gitea.CommitStatusPending, internal.PullRequestQueueType)
+}
+
+func (r *reporter) convertCommitStatus(rpcStatus rpc.ComponentUpgrade_UpgradeStatus) gitea.CommitStatus {
+	switch rpcStatus {
+	case rpc.ComponentUpgrade_UpgradeStatus_SUCCESS:
+		return gitea.CommitStatusSuccess
+	default:
+		return gitea.CommitStatusFailure
+	}
+}
+
+func (r *reporter) getPRHistoryURL(comp *internal.ComponentUpgradeReporter) string {
+	return fmt.Sprintf("%s/teams/%s/pullrequest/queue/histories/%s",
+		comp.SamsahaiExternalURL, comp.TeamName, comp.QueueHistoryName)
+}
+
+func (r *reporter) getPRLogsURL(comp *internal.ComponentUpgradeReporter) string {
+	return fmt.Sprintf("%s/teams/%s/pullrequest/queue/histories/%s/log",
+		comp.SamsahaiExternalURL, comp.TeamName, comp.QueueHistoryName)
+}
+
+func (r *reporter) post(giteaConfig *s2hv1.ReporterGitea, baseURL, token string,
+	repository, commitSHA, labelName, targetURL, description string,
+	commitStatus gitea.CommitStatus, event internal.EventType) error {
+
+	if !giteaConfig.Enabled || repository == "" {
+		return nil
+	}
+
+	logger.Debug("start publishing commit status to Gitea",
+		"event", event, "repository", repository, "commitSHA", commitSHA, "status", commitStatus)
+
+	giteaCli := r.gitea
+	if r.gitea == nil {
+		giteaCli = NewGiteaClient(baseURL, token)
+	}
+
+	err := giteaCli.PublishCommitStatus(repository, commitSHA, labelName, targetURL, description, commitStatus)
+	if err != nil {
+		logger.Error(err, "cannot publish commit status into gitea", "repository", repository,
+			"commitSHA", commitSHA, "labelName", labelName, "targetURL", targetURL, "status", commitStatus)
+		return err
+	}
+
+	return nil
+}
+
+func (r *reporter) getGiteaConfig(teamName string, configCtrl internal.ConfigController) (
+	*s2hv1.ReporterGitea, error) {
+
+	config, err := configCtrl.Get(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// no Gitea configuration
+	if config.Status.Used.Reporter == nil || config.Status.Used.Reporter.Gitea == nil {
+		return nil, s2herrors.New("gitea configuration not found")
+	}
+
+	return config.Status.Used.Reporter.Gitea, nil
+}
+
+func (r *reporter) getGiteaRepository(configCtrl internal.ConfigController, teamName, bundleName string) string {
+	config, err := configCtrl.Get(teamName)
+	if err != nil || config.Status.Used.PullRequest == nil {
+		return ""
+	}
+
+	for _, bundle := range config.Status.Used.PullRequest.Bundles {
+		if bundle.Name == bundleName {
+			return bundle.GitRepository
+		}
+	}
+
+	return ""
+}
+
+// getGiteaCredential returns the base url and token of the team, the default ones are used if not defined
+func (r *reporter) getGiteaCredential(credential s2hv1.Credential, giteaConfig *s2hv1.ReporterGitea) (
+	baseURL, token string) {
+
+	baseURL, token = r.giteaURL, r.giteaToken
+	if credential.Gitea != nil && credential.Gitea.Token != "" {
+		token = credential.Gitea.Token
+	}
+
+	if giteaConfig.BaseURL != "" {
+		baseURL = giteaConfig.BaseURL
+	}
+
+	return baseURL, token
+}
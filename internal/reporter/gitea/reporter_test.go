@@ -0,0 +1,257 @@
+package gitea_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2hgitea "github.com/agoda-com/samsahai/internal/reporter/gitea"
+	"github.com/agoda-com/samsahai/internal/util/gitea"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
+)
+
+func TestUnit(t *testing.T) {
+	unittest.InitGinkgo(t, "Gitea Reporter")
+}
+
+var _ = Describe("publish commit status to gitea", func() {
+	g := NewGomegaWithT(GinkgoT())
+
+	Describe("send pull request queue", func() {
+		It("should correctly send pull request queue success", func() {
+			configCtrl := newMockConfigCtrl("")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "bundle-1",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_SUCCESS,
+				TeamName: "owner",
+				PullRequestComponent: &rpc.TeamWithPullRequest{
+					BundleName: "bundle-1",
+					PRNumber:   "pr1234",
+					CommitSHA:  "commit-sha-xxx",
+				},
+			}
+			mockGiteaCli := &mockGitea{}
+			r := s2hgitea.New(s2hgitea.WithGiteaClient(mockGiteaCli))
+			comp := internal.NewComponentUpgradeReporter(
+				rpcComp,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+				internal.WithQueueHistoryName("bundle1-comp1-5678"),
+				internal.WithPreviewURLs([]s2hv1.PullRequestPreviewURL{
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://wordpress.example.com"},
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://blog.example.com"},
+				}),
+			)
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockGiteaCli.publishCalls).Should(Equal(3))
+			g.Expect(mockGiteaCli.repository).Should(Equal("agoda-com/samsahai"))
+			g.Expect(mockGiteaCli.commitSHA).Should(Equal("commit-sha-xxx"))
+			g.Expect(mockGiteaCli.status).Should(Equal(gitea.CommitStatusSuccess))
+			g.Expect(mockGiteaCli.targetURLs).Should(Equal([]string{
+				"http://localhost:8080/teams/owner/pullrequest/queue/histories/bundle1-comp1-5678",
+				"http://localhost:8080/teams/owner/pullrequest/queue/histories/bundle1-comp1-5678/log",
+				"https://wordpress.example.com",
+			}))
+		})
+
+		It("should correctly send pull request queue failure", func() {
+			configCtrl := newMockConfigCtrl("")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "bundle-1",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				TeamName: "owner",
+				PullRequestComponent: &rpc.TeamWithPullRequest{
+					BundleName: "bundle-1",
+					CommitSHA:  "commit-sha-xxx",
+				},
+			}
+			mockGiteaCli := &mockGitea{}
+			r := s2hgitea.New(s2hgitea.WithGiteaClient(mockGiteaCli))
+			comp := internal.NewComponentUpgradeReporter(
+				rpcComp,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+				internal.WithPreviewURLs([]s2hv1.PullRequestPreviewURL{
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://wordpress.example.com"},
+				}),
+			)
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockGiteaCli.publishCalls).Should(Equal(3))
+			g.Expect(mockGiteaCli.status).Should(Equal(gitea.CommitStatusFailure))
+		})
+	})
+
+	It("should correctly send pull request test runner pending result", func() {
+		configCtrl := newMockConfigCtrl("")
+		g.Expect(configCtrl).ShouldNot(BeNil())
+
+		mockGiteaCli := &mockGitea{}
+		r := s2hgitea.New(s2hgitea.WithGiteaClient(mockGiteaCli))
+		rpt := internal.NewPullRequestTestRunnerPendingReporter(
+			internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+			"owner", "bundle-1", "pr1234", "commit-sha-xxx", s2hv1.Credential{})
+		err := r.SendPullRequestTestRunnerPendingResult(configCtrl, rpt)
+		g.Expect(err).Should(BeNil())
+		g.Expect(mockGiteaCli.publishCalls).Should(Equal(1))
+		g.Expect(mockGiteaCli.status).Should(Equal(gitea.CommitStatusPending))
+	})
+
+	Describe("failure path", func() {
+		It("should not send message if not define gitea reporter configuration", func() {
+			configCtrl := newMockConfigCtrl("empty")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				PullRequestComponent: &rpc.TeamWithPullRequest{BundleName: "bundle-1"},
+			}
+			mockGiteaCli := &mockGitea{}
+			r := s2hgitea.New(s2hgitea.WithGiteaClient(mockGiteaCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockGiteaCli.publishCalls).Should(Equal(0))
+		})
+
+		It("should fail to publish commit status", func() {
+			configCtrl := newMockConfigCtrl("failure")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				PullRequestComponent: &rpc.TeamWithPullRequest{
+					BundleName: "bundle-1",
+					CommitSHA:  "error",
+				},
+			}
+			mockGiteaCli := &mockGitea{}
+			r := s2hgitea.New(s2hgitea.WithGiteaClient(mockGiteaCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{})
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(mockGiteaCli.publishCalls).Should(Equal(0))
+		})
+	})
+})
+
+// mockGitea mocks Gitea interface
+type mockGitea struct {
+	publishCalls int
+	repository   string
+	commitSHA    string
+	status       gitea.CommitStatus
+	targetURLs   []string
+}
+
+func (s *mockGitea) PublishCommitStatus(repository, commitSHA, labelName, targetURL, description string,
+	status gitea.CommitStatus) error {
+
+	if repository == "error" {
+		return errors.New("error")
+	}
+
+	s.publishCalls++
+	s.repository = repository
+	s.commitSHA = commitSHA
+	s.status = status
+	s.targetURLs = append(s.targetURLs, targetURL)
+
+	return nil
+}
+
+func (s *mockGitea) GetPullRequestState(repository, prNumber string) (gitea.PullRequestState, error) {
+	panic("expect not to call GetPullRequestState method")
+}
+
+type mockConfigCtrl struct {
+	configType string
+}
+
+func newMockConfigCtrl(configType string) internal.ConfigController {
+	return &mockConfigCtrl{
+		configType: configType,
+	}
+}
+
+func (c *mockConfigCtrl) Get(configName string) (*s2hv1.Config, error) {
+	if c.configType == "empty" {
+		return &s2hv1.Config{}, nil
+	}
+
+	gitRepository := "agoda-com/samsahai"
+	if c.configType == "failure" {
+		gitRepository = "error"
+	}
+
+	return &s2hv1.Config{
+		Status: s2hv1.ConfigStatus{
+			Used: s2hv1.ConfigSpec{
+				Reporter: &s2hv1.ConfigReporter{
+					Gitea: &s2hv1.ReporterGitea{
+						Enabled: true,
+						BaseURL: "https://gitea.com",
+					},
+				},
+				PullRequest: &s2hv1.ConfigPullRequest{
+					Bundles: []*s2hv1.PullRequestBundle{
+						{
+							Name:          "bundle-1",
+							GitRepository: gitRepository,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (c *mockConfigCtrl) GetComponents(configName string) (map[string]*s2hv1.Component, error) {
+	return map[string]*s2hv1.Component{}, nil
+}
+
+func (c *mockConfigCtrl) GetParentComponents(configName string) (map[string]*s2hv1.Component, error) {
+	return map[string]*s2hv1.Component{}, nil
+}
+
+func (c *mockConfigCtrl) GetPullRequestComponents(configName, prBundleName string, depIncluded bool) (map[string]*s2hv1.Component, error) {
+	return map[string]*s2hv1.Component{}, nil
+}
+
+func (c *mockConfigCtrl) GetBundles(configName string) (s2hv1.ConfigBundles, error) {
+	return s2hv1.ConfigBundles{}, nil
+}
+
+func (c *mockConfigCtrl) GetPriorityQueues(configName string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) GetStagingConfig(configName string) (*s2hv1.ConfigStaging, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) GetPullRequestConfig(configName string) (*s2hv1.ConfigPullRequest, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) GetPullRequestBundleDependencies(configName, prBundleName string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *mockConfigCtrl) Update(config *s2hv1.Config) error {
+	return nil
+}
+
+func (c *mockConfigCtrl) Delete(configName string) error {
+	return nil
+}
+
+func (c *mockConfigCtrl) EnsureConfigTemplateChanged(config *s2hv1.Config) error {
+	return nil
+}
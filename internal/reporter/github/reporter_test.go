@@ -58,6 +58,42 @@ var _ = Describe("publish commit status to github", func() {
 			}))
 		})
 
+		It("should correctly send pull request environment preview urls", func() {
+			configCtrl := newMockConfigCtrl("")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "bundle-1",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				TeamName: "owner",
+				PullRequestComponent: &rpc.TeamWithPullRequest{
+					BundleName: "bundle-1",
+					PRNumber:   "pr1234",
+					CommitSHA:  "commit-sha-xxx",
+				},
+			}
+			mockGithubCli := &mockGithub{}
+			r := s2hgithub.New(s2hgithub.WithGithubClient(mockGithubCli))
+			comp := internal.NewComponentUpgradeReporter(
+				rpcComp,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+				internal.WithQueueHistoryName("bundle1-comp1-5678"),
+				internal.WithPreviewURLs([]s2hv1.PullRequestPreviewURL{
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://wordpress.example.com"},
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://blog.example.com"},
+					{Name: "mariadb", Kind: s2hv1.PullRequestPreviewService, URL: "http://mariadb.pr.svc.cluster.local:3306"},
+				}),
+			)
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockGithubCli.publishCalls).Should(Equal(4))
+			g.Expect(mockGithubCli.status).Should(Equal(github.CommitStatusFailure))
+			g.Expect(mockGithubCli.targetURLs[2:]).Should(Equal([]string{
+				"https://wordpress.example.com",
+				"http://mariadb.pr.svc.cluster.local:3306",
+			}))
+		})
+
 		It("should correctly send pull request queue failure", func() {
 			configCtrl := newMockConfigCtrl("")
 			g.Expect(configCtrl).ShouldNot(BeNil())
@@ -142,6 +178,14 @@ func (s *mockGithub) PublishCommitStatus(repository, commitSHA, labelName, targe
 	return nil
 }
 
+func (s *mockGithub) GetPullRequestState(repository, prNumber string) (github.PullRequestState, error) {
+	panic("expect not to call GetPullRequestState method")
+}
+
+func (s *mockGithub) CreatePullRequestComment(repository, prNumber, body string) error {
+	panic("expect not to call CreatePullRequestComment method")
+}
+
 type mockConfigCtrl struct {
 	configType string
 }
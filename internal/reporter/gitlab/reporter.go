@@ -18,6 +18,7 @@ const (
 
 	LabelNameLogs    = "Samsahai Deployment - Logs"
 	LabelNameHistory = "Samsahai Deployment - History"
+	LabelNamePreview = "Samsahai Preview - %s"
 )
 
 type reporter struct {
@@ -125,6 +126,23 @@ func (r *reporter) SendPullRequestQueue(configCtrl internal.ConfigController,
 		return err
 	}
 
+	// send pull request environment preview URLs, only the first url of each object is published
+	published := make(map[string]bool)
+	for _, preview := range comp.PreviewURLs {
+		if published[preview.Name] {
+			continue
+		}
+		published[preview.Name] = true
+
+		previewLabel := fmt.Sprintf(LabelNamePreview, preview.Name)
+		previewDesc := fmt.Sprintf("Samsahai pull request environment preview (%s)", preview.Kind)
+		err = r.post(gitlabConfig, projectID, commitSHA, previewLabel, preview.URL, previewDesc, commitStatus,
+			internal.PullRequestQueueType)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -58,6 +58,42 @@ var _ = Describe("publish commit status to gitlab", func() {
 			}))
 		})
 
+		It("should correctly send pull request environment preview urls", func() {
+			configCtrl := newMockConfigCtrl("")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:     "bundle-1",
+				Status:   rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				TeamName: "owner",
+				PullRequestComponent: &rpc.TeamWithPullRequest{
+					BundleName: "bundle-1",
+					PRNumber:   "pr1234",
+					CommitSHA:  "commit-sha-xxx",
+				},
+			}
+			mockGitlabCli := &mockGitlab{}
+			r := s2hgitlab.New(s2hgitlab.WithGitlabClient(mockGitlabCli))
+			comp := internal.NewComponentUpgradeReporter(
+				rpcComp,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+				internal.WithQueueHistoryName("bundle1-comp1-5678"),
+				internal.WithPreviewURLs([]s2hv1.PullRequestPreviewURL{
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://wordpress.example.com"},
+					{Name: "wordpress", Kind: s2hv1.PullRequestPreviewIngress, URL: "https://blog.example.com"},
+					{Name: "mariadb", Kind: s2hv1.PullRequestPreviewService, URL: "http://mariadb.pr.svc.cluster.local:3306"},
+				}),
+			)
+			err := r.SendPullRequestQueue(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockGitlabCli.publishCalls).Should(Equal(4))
+			g.Expect(mockGitlabCli.status).Should(Equal(gitlab.CommitStatusFailure))
+			g.Expect(mockGitlabCli.targetURLs[2:]).Should(Equal([]string{
+				"https://wordpress.example.com",
+				"http://mariadb.pr.svc.cluster.local:3306",
+			}))
+		})
+
 		It("should correctly send pull request queue failure", func() {
 			configCtrl := newMockConfigCtrl("")
 			g.Expect(configCtrl).ShouldNot(BeNil())
@@ -146,6 +182,14 @@ func (s *mockGitlab) GetMRSourceBranch(repository, MRiid string) (string, error)
 	panic("expect not to call GetMRSourceBranch method")
 }
 
+func (s *mockGitlab) GetMRState(repository, MRiid string) (gitlab.MRState, error) {
+	panic("expect not to call GetMRState method")
+}
+
+func (s *mockGitlab) CreateMRNote(repository, MRiid, body string) error {
+	panic("expect not to call CreateMRNote method")
+}
+
 type mockConfigCtrl struct {
 	configType string
 }
@@ -283,6 +283,30 @@ func (r *reporter) makeDeploymentQueueReport(comp *internal.ComponentUpgradeRepo
 {{- if .TestRunner.Gitlab.PipelineURL }}
 <br/><b>GitLab URL:</b> <a href="{{ .TestRunner.Gitlab.PipelineURL }}">#{{ .TestRunner.Gitlab.PipelineNumber }}</a>
 {{- end }}
+{{- if .TestRunner.Rest.BuildURL }}
+<br/><b>Test URL:</b> <a href="{{ .TestRunner.Rest.BuildURL }}">#{{ .TestRunner.Rest.BuildNumber }}</a>
+{{- end }}
+{{- if .TestRunner.Jenkins.BuildURL }}
+<br/><b>Jenkins URL:</b> <a href="{{ .TestRunner.Jenkins.BuildURL }}">{{ .TestRunner.Jenkins.BuildNumber }}</a>
+{{- end }}
+{{- if .TestStages }}
+<br/><b>Test Stages:</b>
+<ul>
+{{- range .TestStages }}
+<li><b>{{ .Name }}:</b> {{ .Result }}{{ if not .Required }} (optional){{ end }}{{ if .Message }} - {{ .Message }}{{ end }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- if .TestReport }}
+<br/><b>Test Results:</b> {{ .TestReport.Total }} total, {{ .TestReport.Failed }} failed, {{ .TestReport.Skipped }} skipped
+{{- if .TestReport.FailedTests }}
+<ul>
+{{- range .TestReport.FailedTests }}
+<li>{{ . }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- end }}
 <br/><b>Deployment Logs:</b> <a href="` + queueLogURL + `">Download here</a>
 <br/><b>Deployment History:</b> <a href="` + queueHistURL + `">Click here</a>
 {{- end}}
@@ -322,6 +346,12 @@ func (r *reporter) makeActivePromotionStatusReport(comp *internal.ActivePromotio
 {{- if and .PreActiveQueue.TestRunner.Gitlab .PreActiveQueue.TestRunner.Gitlab.PipelineURL }}
 <br/><b>GitLab URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Gitlab.PipelineURL }}">#{{ .PreActiveQueue.TestRunner.Gitlab.PipelineNumber }}</a>
 {{- end }}
+{{- if and .PreActiveQueue.TestRunner.Rest .PreActiveQueue.TestRunner.Rest.BuildURL }}
+<br/><b>Test URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Rest.BuildURL }}">#{{ .PreActiveQueue.TestRunner.Rest.BuildNumber }}</a>
+{{- end }}
+{{- if and .PreActiveQueue.TestRunner.Jenkins .PreActiveQueue.TestRunner.Jenkins.BuildURL }}
+<br/><b>Jenkins URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Jenkins.BuildURL }}">{{ .PreActiveQueue.TestRunner.Jenkins.BuildNumber }}</a>
+{{- end }}
 {{- end }}
 {{- if eq .Result "Failure" }}
 <br/><b>Deployment Logs:</b> <a href="{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/activepromotions/histories/{{ .ActivePromotionHistoryName }}/log">Download here</a>
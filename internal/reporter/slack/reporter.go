@@ -313,6 +313,24 @@ func (r *reporter) makeDeploymentQueueReport(comp *internal.ComponentUpgradeRepo
   {{- if .TestRunner.Gitlab.PipelineURL }}
 *GitLab URL:* <{{ .TestRunner.Gitlab.PipelineURL }}|{{ .TestRunner.Gitlab.PipelineNumber }}>
   {{- end }}
+  {{- if .TestRunner.Rest.BuildURL }}
+*Test URL:* <{{ .TestRunner.Rest.BuildURL }}|{{ .TestRunner.Rest.BuildNumber }}>
+  {{- end }}
+  {{- if .TestRunner.Jenkins.BuildURL }}
+*Jenkins URL:* <{{ .TestRunner.Jenkins.BuildURL }}|{{ .TestRunner.Jenkins.BuildNumber }}>
+  {{- end }}
+  {{- if .TestStages }}
+*Test Stages:*
+  {{- range .TestStages }}
+>- *{{ .Name }}:* {{ .Result }}{{ if not .Required }} (optional){{ end }}{{ if .Message }} - {{ .Message }}{{ end }}
+  {{- end }}
+  {{- end }}
+  {{- if .TestReport }}
+*Test Results:* {{ .TestReport.Total }} total, {{ .TestReport.Failed }} failed, {{ .TestReport.Skipped }} skipped
+    {{- range .TestReport.FailedTests }}
+>- ` + "`{{ . }}`" + `
+    {{- end }}
+  {{- end }}
 *Deployment Logs:* <` + queueLogURL + `|Download here>
 *Deployment History:* <` + queueHistURL + `|Click here>
 {{- end}}
@@ -358,6 +376,12 @@ func (r *reporter) makeActivePromotionStatusReport(atpRpt *internal.ActivePromot
 {{- if and .PreActiveQueue.TestRunner.Gitlab .PreActiveQueue.TestRunner.Gitlab.PipelineURL }}
 *GitLab URL:* <{{ .PreActiveQueue.TestRunner.Gitlab.PipelineURL }}|{{ .PreActiveQueue.TestRunner.Gitlab.PipelineNumber }}>
 {{- end }}
+{{- if and .PreActiveQueue.TestRunner.Rest .PreActiveQueue.TestRunner.Rest.BuildURL }}
+*Test URL:* <{{ .PreActiveQueue.TestRunner.Rest.BuildURL }}|{{ .PreActiveQueue.TestRunner.Rest.BuildNumber }}>
+{{- end }}
+{{- if and .PreActiveQueue.TestRunner.Jenkins .PreActiveQueue.TestRunner.Jenkins.BuildURL }}
+*Jenkins URL:* <{{ .PreActiveQueue.TestRunner.Jenkins.BuildURL }}|{{ .PreActiveQueue.TestRunner.Jenkins.BuildNumber }}>
+{{- end }}
 {{- end }}
 {{- if eq .Result "Failure" }}
 *Deployment Logs:* <{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/activepromotions/histories/{{ .ActivePromotionHistoryName }}/log|Download here>
@@ -283,6 +283,47 @@ var _ = Describe("send slack message", func() {
 			g.Expect(mockSlackCli.message).Should(ContainSubstring("owner"))
 			g.Expect(mockSlackCli.message).Should(ContainSubstring(defaultExtraMessage))
 		})
+
+		It("should correctly send component upgrade failure with test stages and test report", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:   "comp1",
+				Status: rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				Components: []*rpc.Component{
+					{
+						Name:  "comp1",
+						Image: &rpc.Image{Repository: "image-1", Tag: "1.1.0"},
+					},
+				},
+				TeamName:   "owner",
+				IsReverify: false,
+			}
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			comp := internal.NewComponentUpgradeReporter(
+				rpcComp,
+				internal.SamsahaiConfig{SamsahaiExternalURL: "http://localhost:8080"},
+				internal.WithTestStages([]s2hv1.TestStage{
+					{Name: "smoke", Required: true, Result: s2hv1.TestStagePassed},
+					{Name: "regression", Required: false, Result: s2hv1.TestStageFailed},
+				}),
+				internal.WithTestReport(&s2hv1.TestReport{
+					Total:       20,
+					Failed:      1,
+					Skipped:     2,
+					FailedTests: []string{"checkout.should pay"},
+				}),
+				internal.WithQueueHistoryName("comp1-5678"),
+			)
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*smoke:* Passed"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*regression:* Failed (optional)"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Test Results:* 20 total, 1 failed, 2 skipped"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("`checkout.should pay`"))
+		})
 	})
 
 	Describe("send pull request queue", func() {
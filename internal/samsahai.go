@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +38,10 @@ type SamsahaiCredential struct {
 	TeamcityUsername  string
 	TeamcityPassword  string
 	GitlabToken       string
+	BitbucketToken    string
+	GiteaToken        string
+	// WebhookSecret is used for verifying webhooks which do not belong to any teams
+	WebhookSecret string
 }
 
 type MSTeamsCredential struct {
@@ -69,12 +74,21 @@ type SamsahaiConfig struct {
 	// GitlabURL defines a Gitlab url
 	GitlabURL string `json:"gitlabURL" yaml:"gitlabURL"`
 
+	// BitbucketURL defines a Bitbucket Server url
+	BitbucketURL string `json:"bitbucketURL" yaml:"bitbucketURL"`
+
+	// GiteaURL defines a Gitea url
+	GiteaURL string `json:"giteaURL" yaml:"giteaURL"`
+
 	// TeamcityURL defines a Teamcity url
 	TeamcityURL string `json:"teamcityURL" yaml:"teamcityURL"`
 
 	// ClusterDomain defines a cluster domain name
 	ClusterDomain string `json:"clusterDomain" yaml:"clusterDomain"`
 
+	// InsecureRegistries defines container registries which are connected via plain http by registryv2 checker
+	InsecureRegistries []string `json:"insecureRegistries,omitempty" yaml:"insecureRegistries,omitempty"`
+
 	// ActivePromotion defines an active promotion configuration
 	ActivePromotion ActivePromotionConfig `json:"activePromotion,omitempty" yaml:"activePromotion,omitempty"`
 
@@ -117,6 +131,9 @@ type PullRequestConfig struct {
 
 	// MaxHistoryDays defines maximum days of PullRequestQueueHistory stored
 	MaxHistoryDays int `json:"maxHistoryDays" yaml:"maxHistoryDays"`
+
+	// StateCheckInterval defines a duration time to re-check states of pull requests from the git providers
+	StateCheckInterval metav1.Duration `json:"stateCheckInterval" yaml:"stateCheckInterval"`
 }
 
 // ActivePromotionConfig represents configuration of active promotion
@@ -218,6 +235,28 @@ type SamsahaiController interface {
 	TriggerPullRequestDeployment(teamName, component, prNumber, commitSHA string, bundleCompTag map[string]string,
 		tearDownDuration *s2hv1.PullRequestTearDownDuration, testRunner *s2hv1.ConfigTestRunnerOverrider) error
 
+	// DeletePullRequestDeployment deletes PullRequestTrigger and PullRequestQueue crd objects
+	// which destroys the pull request environment
+	DeletePullRequestDeployment(teamName, bundleName, prNumber string) error
+
+	// GetPullRequestBundlesByRepository returns pull request bundle names of each team
+	// which are configured with the git repository or the gitlab project id
+	GetPullRequestBundlesByRepository(repository, projectID string) (map[string][]string, error)
+
+	// RedeployPullRequestDeployment re-triggers the pull request deployment with the overridden component versions
+	RedeployPullRequestDeployment(teamName, bundleName, prNumber, commitSHA string, bundleCompsTag map[string]string) error
+
+	// RetestPullRequestQueue re-runs the testing on the pull request environment without redeploying
+	RetestPullRequestQueue(teamName, bundleName, prNumber, requestedBy string) error
+
+	// ExtendPullRequestEnvironment postpones the destroyed time of the pull request environment,
+	// not further than the max duration from now, and returns the updated pull request queue
+	ExtendPullRequestEnvironment(teamName, bundleName, prNumber string,
+		duration, maxDuration time.Duration) (*s2hv1.PullRequestQueue, error)
+
+	// CommentPullRequest creates a comment on the pull request of the git provider
+	CommentPullRequest(teamName string, provider s2hv1.PullRequestGitProvider, repository, prNumber, body string) error
+
 	// API
 
 	// GetConnections returns Services in NodePort type and Ingresses that exist in the namespace
@@ -261,6 +300,31 @@ type SamsahaiController interface {
 
 	// DeleteTeamActiveEnvironment deletes all component in namespace and namespace object
 	DeleteTeamActiveEnvironment(teamName, namespace, deletedBy string) error
+
+	// EnqueueComponent manually adds the component version to the staging queue of the team
+	EnqueueComponent(teamName, namespace, compName, version, actionBy string, atTop bool) error
+
+	// MoveQueueToTop moves the waiting queue to the top of the staging queue
+	MoveQueueToTop(namespace, queueName, actionBy string) error
+
+	// CancelQueue cancels the running queue
+	CancelQueue(namespace, queueName, actionBy string) error
+
+	// RemoveQueue removes the waiting queue from the staging queue
+	RemoveQueue(namespace, queueName, actionBy string) error
+
+	// ReverifyQueue forces the waiting queue to be reverified at first
+	ReverifyQueue(namespace, queueName, actionBy string) error
+
+	// TriggerActivePromotion creates a new active promotion of the team
+	TriggerActivePromotion(teamName string, spec s2hv1.ActivePromotionSpec) error
+
+	// CancelActivePromotion cancels the waiting or running active promotion of the team
+	CancelActivePromotion(teamName, canceledBy string) error
+
+	// RollbackActivePromotion requests the promoted active promotion of the team
+	// to roll back to the previous active namespace which has not been destroyed yet
+	RollbackActivePromotion(teamName, requestedBy string) error
 }
 
 type Connection struct {
@@ -0,0 +1,108 @@
+package samsahai
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/samsahai/activepromotion"
+)
+
+// TriggerActivePromotion creates a new active promotion of the team
+func (c *controller) TriggerActivePromotion(teamName string, spec s2hv1.ActivePromotionSpec) error {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return err
+	}
+
+	atp := &s2hv1.ActivePromotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: teamName,
+		},
+		Spec: spec,
+	}
+
+	logger.Info("trigger active promotion", "team", teamName, "by", spec.PromotedBy)
+
+	return c.client.Create(context.TODO(), atp)
+}
+
+// CancelActivePromotion cancels the waiting or running active promotion of the team,
+// the active promotion which cannot be canceled in the current state is rejected
+func (c *controller) CancelActivePromotion(teamName, canceledBy string) error {
+	atp, err := c.getActivePromotion(teamName)
+	if err != nil {
+		return err
+	}
+
+	state := atp.Status.State
+	if state != s2hv1.ActivePromotionWaiting && activepromotion.StateCannotBeTimeoutOrCancel(state) {
+		return s2herrors.ErrActivePromotionCannotBeCanceled
+	}
+
+	logger.Info("cancel active promotion", "team", teamName, "state", state, "by", canceledBy)
+
+	if err := c.client.Delete(context.TODO(), atp); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot delete activepromotion %s", teamName)
+	}
+
+	return nil
+}
+
+// RollbackActivePromotion requests the promoted active promotion of the team
+// to roll back to the previous active namespace which has not been destroyed yet
+func (c *controller) RollbackActivePromotion(teamName, requestedBy string) error {
+	atp, err := c.getActivePromotion(teamName)
+	if err != nil {
+		return err
+	}
+
+	if !canRollbackToPreviousActive(atp) {
+		return s2herrors.ErrActivePromotionCannotBeRolledBack
+	}
+
+	logger.Info("rollback active promotion to previous active namespace", "team", teamName,
+		"namespace", atp.Status.PreviousActiveNamespace, "by", requestedBy)
+
+	atp.Spec.RollbackRequest = &s2hv1.ActivePromotionRollbackRequest{
+		RequestedBy: requestedBy,
+		RequestedAt: metav1.Now(),
+	}
+
+	return c.client.Update(context.TODO(), atp)
+}
+
+func (c *controller) getActivePromotion(teamName string) (*s2hv1.ActivePromotion, error) {
+	atp := &s2hv1.ActivePromotion{}
+	if err := c.client.Get(context.TODO(), types.NamespacedName{Name: teamName}, atp); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, s2herrors.ErrActivePromotionNotFound
+		}
+		return nil, errors.Wrapf(err, "cannot get activepromotion %s", teamName)
+	}
+
+	return atp, nil
+}
+
+// canRollbackToPreviousActive returns true if the active promotion has been promoted successfully
+// and the previous active namespace is still waiting to be destroyed
+func canRollbackToPreviousActive(atp *s2hv1.ActivePromotion) bool {
+	if !atp.ObjectMeta.DeletionTimestamp.IsZero() || atp.Spec.RollbackRequest != nil {
+		return false
+	}
+
+	if atp.Status.State != s2hv1.ActivePromotionDestroyingPreviousActive ||
+		atp.Status.Result != s2hv1.ActivePromotionSuccess ||
+		atp.Status.PreviousActiveNamespace == "" {
+		return false
+	}
+
+	now := metav1.Now()
+	destroyedTime := atp.Status.DestroyedTime
+	return destroyedTime.IsZero() || now.Before(destroyedTime)
+}
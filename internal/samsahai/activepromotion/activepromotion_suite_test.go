@@ -0,0 +1,11 @@
+package activepromotion
+
+import (
+	"testing"
+
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestActivePromotion(t *testing.T) {
+	unittest.InitGinkgo(t, "Active Promotion Controller")
+}
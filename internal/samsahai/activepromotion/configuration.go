@@ -68,8 +68,13 @@ func (c *controller) getActivePromotionTimeout(teamName string, configCtrl inter
 		return timeout
 	}
 
-	if config.Status.Used.ActivePromotion != nil && config.Status.Used.ActivePromotion.Timeout.Duration != 0 {
-		timeout = config.Status.Used.ActivePromotion.Timeout
+	if config.Status.Used.ActivePromotion != nil {
+		if config.Status.Used.ActivePromotion.Timeout.Duration != 0 {
+			timeout = config.Status.Used.ActivePromotion.Timeout
+		}
+
+		// traffic is shifted step by step during the active promotion, so it does not count against the timeout
+		timeout.Duration += getTrafficShiftDuration(config.Status.Used.ActivePromotion.TrafficShift)
 	}
 
 	return timeout
@@ -104,6 +104,12 @@ func (c *controller) setup(ctx context.Context, atpComp *s2hv1.ActivePromotion)
 		atpComp.Spec.NoDowntimeGuarantee = &config.Status.Used.ActivePromotion.NoDowntimeGuarantee
 	}
 
+	// traffic can be shifted only if the current active environment is demoted after promoting
+	if config.Status.Used.ActivePromotion != nil && config.Status.Used.ActivePromotion.TrafficShift != nil {
+		noDowntimeGuarantee := true
+		atpComp.Spec.NoDowntimeGuarantee = &noDowntimeGuarantee
+	}
+
 	if *atpComp.Spec.NoDowntimeGuarantee {
 		logger.Info("The active promotion will run promote before demote")
 	} else {
@@ -142,7 +148,7 @@ func (c *controller) checkActivePromotionTimeout(ctx context.Context, atpComp *s
 	}
 
 	// these states cannot be timeout
-	if c.stateCannotBeTimeoutOrCancel(atpComp.Status.State) {
+	if StateCannotBeTimeoutOrCancel(atpComp.Status.State) {
 		return nil
 	}
 
@@ -228,7 +234,7 @@ func (c *controller) deleteFinalizerWhenFinished(ctx context.Context, atpComp *s
 				return
 			}
 
-			if c.stateCannotBeTimeoutOrCancel(atpComp.Status.State) {
+			if StateCannotBeTimeoutOrCancel(atpComp.Status.State) {
 				return
 			}
 
@@ -268,7 +274,8 @@ func (c *controller) addFinalizer(atpComp *s2hv1.ActivePromotion) {
 	}
 }
 
-func (c *controller) stateCannotBeTimeoutOrCancel(state s2hv1.ActivePromotionState) bool {
+// StateCannotBeTimeoutOrCancel returns true if the active promotion in the state cannot be timeout or canceled
+func StateCannotBeTimeoutOrCancel(state s2hv1.ActivePromotionState) bool {
 	// waiting state doesn't have finalizer
 	return state == s2hv1.ActivePromotionWaiting ||
 		state == s2hv1.ActivePromotionDestroyingPreviousActive ||
@@ -311,6 +318,7 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
+	prevState := atpComp.Status.State
 	if skipReconcile, err := c.manageQueue(ctx, atpComp); err != nil || skipReconcile {
 		if err != nil {
 			return reconcile.Result{}, err
@@ -424,7 +432,47 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			return reconcile.Result{}, err
 		}
 
+	case s2hv1.ActivePromotionShiftingTraffic:
+		if err := c.shiftTraffic(ctx, atpComp); err != nil {
+			if s2herrors.IsEnsuringTrafficShifted(err) {
+				return reconcile.Result{
+					Requeue:      true,
+					RequeueAfter: 2 * time.Second,
+				}, nil
+			}
+			return reconcile.Result{}, err
+		}
+
 	case s2hv1.ActivePromotionDestroyingPreviousActive:
+		if isRollbackToPreviousActiveRequested(atpComp) {
+			if err := c.rollbackToPreviousActive(ctx, atpComp); err != nil {
+				if s2herrors.IsEnsuringActivePromoted(err) {
+					return reconcile.Result{
+						Requeue:      true,
+						RequeueAfter: 2 * time.Second,
+					}, nil
+				}
+				return reconcile.Result{}, errors.Wrapf(err, "cannot rollback to previous active of activepromotion %s",
+					atpComp.Name)
+			}
+
+			if err := c.reportHealthWatchRollback(ctx, atpComp); err != nil {
+				return reconcile.Result{}, err
+			}
+			break
+		}
+
+		if err := c.watchActiveHealth(ctx, atpComp); err != nil {
+			if s2herrors.IsEnsuringActiveHealthWatched(err) {
+				return reconcile.Result{
+					Requeue:      true,
+					RequeueAfter: 2 * time.Second,
+				}, nil
+			}
+			return reconcile.Result{}, errors.Wrapf(err, "cannot watch health of active environment of activepromotion %s",
+				atpComp.Name)
+		}
+
 		if err := c.destroyPreviousActiveEnvironment(ctx, atpComp); err != nil {
 			if s2herrors.IsEnsuringNamespaceDestroyed(err) {
 				return reconcile.Result{
@@ -462,5 +510,9 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
+	if prevState != s2hv1.ActivePromotionFinished && atpComp.Status.State == s2hv1.ActivePromotionFinished {
+		exporter.ObserveActivePromotionResult(atpComp)
+	}
+
 	return reconcile.Result{}, nil
 }
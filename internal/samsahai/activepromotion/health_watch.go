@@ -0,0 +1,260 @@
+package activepromotion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/staging"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+const (
+	defaultHealthWatchDuration         = 10 * time.Minute
+	defaultHealthWatchInterval         = 30 * time.Second
+	defaultHealthWatchFailureThreshold = 3
+	defaultHealthCheckHTTPTimeout      = 10 * time.Second
+
+	// healthWatchRollbackRequester is a requester of rolling back to the previous active namespace
+	// when the new active environment is unhealthy during the health watch
+	healthWatchRollbackRequester = "samsahai-health-watch"
+)
+
+// unhealthyDeploymentIssues are deployment issues of pods which are considered as unhealthy,
+// issues of pods which are being started are ignored
+var unhealthyDeploymentIssues = map[s2hv1.DeploymentIssueType]struct{}{
+	s2hv1.DeploymentIssueCrashLoopBackOff: {},
+	s2hv1.DeploymentIssueImagePullBackOff: {},
+	s2hv1.DeploymentIssueUndefined:        {},
+}
+
+func (c *controller) getHealthWatchConfig(teamName string) (*s2hv1.ConfigHealthWatch, error) {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Status.Used.ActivePromotion == nil {
+		return nil, nil
+	}
+
+	return config.Status.Used.ActivePromotion.HealthWatch, nil
+}
+
+// watchActiveHealth checks health of the new active environment periodically while the previous active namespace
+// is kept, rolling back to the previous active namespace is requested if the failure threshold is reached
+func (c *controller) watchActiveHealth(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	if !isHealthWatchRequired(atpComp) {
+		return nil
+	}
+
+	teamName := atpComp.Name
+	healthWatch, err := c.getHealthWatchConfig(teamName)
+	if err != nil {
+		return err
+	}
+
+	if healthWatch == nil {
+		return nil
+	}
+
+	promotedAt := atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondActivePromoted)
+	if promotedAt.IsZero() {
+		return nil
+	}
+
+	duration, interval, threshold := getHealthWatchParameters(healthWatch)
+	watchEnd := promotedAt.Add(duration)
+	if !time.Now().Before(watchEnd) {
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveHealthy, corev1.ConditionTrue,
+			"Active environment has been healthy during the health watch")
+		return nil
+	}
+
+	// keep the previous active namespace until the health watch ends
+	if atpComp.Status.DestroyedTime == nil || atpComp.Status.DestroyedTime.Time.Before(watchEnd) {
+		atpComp.Status.SetDestroyedTime(metav1.Time{Time: watchEnd})
+		if err := c.updateActivePromotion(ctx, atpComp); err != nil {
+			return err
+		}
+	}
+
+	if checkedAt := atpComp.Status.HealthCheckedAt; checkedAt != nil && time.Since(checkedAt.Time) < interval {
+		return s2herrors.ErrEnsureActiveHealthWatched
+	}
+
+	targetNs := c.getTargetNamespace(atpComp)
+	reason, err := c.checkActiveHealth(ctx, targetNs, healthWatch)
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	atpComp.Status.HealthCheckedAt = &now
+	if reason == "" {
+		atpComp.Status.HealthWatchFailures = 0
+	} else {
+		atpComp.Status.HealthWatchFailures++
+		logger.Warn("active environment is unhealthy",
+			"team", teamName, "namespace", targetNs, "reason", reason,
+			"failures", atpComp.Status.HealthWatchFailures)
+	}
+
+	if reason != "" && atpComp.Status.HealthWatchFailures >= threshold {
+		msg := fmt.Sprintf("Active environment has been unhealthy, rolling back to the previous active namespace: %s",
+			reason)
+		logger.Info("health watch failure threshold reached, start rolling back to previous active namespace",
+			"team", teamName, "namespace", targetNs, "previousActiveNamespace", atpComp.Status.PreviousActiveNamespace)
+
+		atpComp.Status.SetResult(s2hv1.ActivePromotionFailure)
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveHealthy, corev1.ConditionFalse, reason)
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActivePromoted, corev1.ConditionFalse, msg)
+		atpComp.Spec.RollbackRequest = &s2hv1.ActivePromotionRollbackRequest{
+			RequestedBy: healthWatchRollbackRequester,
+			RequestedAt: now,
+		}
+	}
+
+	if err := c.updateActivePromotion(ctx, atpComp); err != nil {
+		return err
+	}
+
+	return s2herrors.ErrEnsureActiveHealthWatched
+}
+
+// checkActiveHealth returns the reason if the namespace is unhealthy, otherwise returns empty string
+func (c *controller) checkActiveHealth(ctx context.Context, ns string, healthWatch *s2hv1.ConfigHealthWatch) (
+	string, error) {
+
+	pods := &corev1.PodList{}
+	if err := c.client.List(ctx, pods, &client.ListOptions{Namespace: ns}); err != nil {
+		return "", errors.Wrapf(err, "cannot list pods of namespace %s", ns)
+	}
+
+	if reasons := getUnhealthyPodReasons(pods.Items); len(reasons) > 0 {
+		return fmt.Sprintf("unhealthy pods [%s]", strings.Join(reasons, ", ")), nil
+	}
+
+	if healthWatch.HTTP != nil {
+		if reason := checkHTTPHealth(ctx, healthWatch.HTTP, ns); reason != "" {
+			return reason, nil
+		}
+	}
+
+	if healthWatch.Prometheus != nil {
+		errorRate, exceeded, err := checkErrorRate(ctx, healthWatch.Prometheus, ns)
+		if err != nil {
+			// prometheus being unavailable should not roll back the active environment
+			logger.Error(err, "cannot check error rate of active environment", "namespace", ns)
+		} else if exceeded {
+			return fmt.Sprintf("error rate %v exceeds threshold %s", errorRate, healthWatch.Prometheus.Threshold), nil
+		}
+	}
+
+	return "", nil
+}
+
+// checkHTTPHealth returns the reason if the health check url does not respond successfully
+func checkHTTPHealth(ctx context.Context, httpCheck *s2hv1.HealthWatchHTTP, ns string) string {
+	timeout := httpCheck.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultHealthCheckHTTPTimeout
+	}
+
+	url := template.TextRender("HealthCheckURL", httpCheck.URL, struct{ Namespace string }{ns})
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Sprintf("invalid health check url %s: %v", url, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Sprintf("health check %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Sprintf("health check %s returned status %d", url, resp.StatusCode)
+	}
+
+	return ""
+}
+
+// getUnhealthyPodReasons returns reasons of failed pods and containers which cannot be started,
+// pods of jobs are ignored
+func getUnhealthyPodReasons(pods []corev1.Pod) []string {
+	reasons := make([]string, 0)
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+
+		issueType, containerName, _ := staging.GetPodDeploymentIssue(pod)
+		if _, ok := unhealthyDeploymentIssues[issueType]; !ok {
+			continue
+		}
+
+		if containerName == "" {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", pod.Name, issueType))
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s/%s: %s", pod.Name, containerName, issueType))
+	}
+
+	sort.Strings(reasons)
+	return reasons
+}
+
+func isHealthWatchRequired(atpComp *s2hv1.ActivePromotion) bool {
+	return atpComp.Status.Result == s2hv1.ActivePromotionSuccess &&
+		atpComp.Status.PreviousActiveNamespace != "" &&
+		atpComp.Status.RollbackStatus == "" &&
+		atpComp.Spec.RollbackRequest == nil &&
+		atpComp.DeletionTimestamp.IsZero() &&
+		!atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondActiveHealthy)
+}
+
+func getHealthWatchParameters(healthWatch *s2hv1.ConfigHealthWatch) (time.Duration, time.Duration, int) {
+	duration := healthWatch.Duration.Duration
+	if duration <= 0 {
+		duration = defaultHealthWatchDuration
+	}
+
+	interval := healthWatch.Interval.Duration
+	if interval <= 0 {
+		interval = defaultHealthWatchInterval
+	}
+
+	threshold := healthWatch.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultHealthWatchFailureThreshold
+	}
+
+	return duration, interval, threshold
+}
+
+// reportHealthWatchRollback sends the active promotion report again
+// if the new active environment has been rolled back by the health watch
+func (c *controller) reportHealthWatchRollback(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	if atpComp.Spec.RollbackRequest == nil ||
+		atpComp.Spec.RollbackRequest.RequestedBy != healthWatchRollbackRequester ||
+		atpComp.Status.RollbackStatus != s2hv1.ActivePromotionRollbackSuccess {
+		return nil
+	}
+
+	return c.sendReport(ctx, atpComp)
+}
@@ -0,0 +1,242 @@
+package activepromotion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+var _ = Describe("Active health watch", func() {
+	g := NewWithT(GinkgoT())
+
+	teamName := "teamtest"
+	targetNs := "s2h-teamtest-abcdef"
+	ctx := context.TODO()
+
+	newPod := func(name string, phase corev1.PodPhase, statuses ...corev1.ContainerStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: targetNs},
+			Status:     corev1.PodStatus{Phase: phase, ContainerStatuses: statuses},
+		}
+	}
+
+	readyStatus := corev1.ContainerStatus{
+		Name:  "app",
+		Ready: true,
+		State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+	}
+
+	newWaitingStatus := func(reason string) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name:  "app",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}},
+		}
+	}
+
+	newHealthServer := func(statusCode int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			g.Expect(r.URL.Query().Get("namespace")).To(Equal(targetNs))
+			w.WriteHeader(statusCode)
+		}))
+	}
+
+	Describe("Watch active health", func() {
+		var ctrl *controller
+		var atpComp *s2hv1.ActivePromotion
+		var healthWatch *s2hv1.ConfigHealthWatch
+
+		setup := func(pods ...corev1.Pod) {
+			objs := []client.Object{atpComp}
+			for i := range pods {
+				objs = append(objs, &pods[i])
+			}
+
+			ctrl = &controller{
+				client: unittest.NewFakeClient(objs...),
+				s2hCtrl: &mockSamsahaiCtrl{configCtrl: &mockConfigCtrl{
+					config: &s2hv1.Config{
+						Status: s2hv1.ConfigStatus{
+							Used: s2hv1.ConfigSpec{
+								ActivePromotion: &s2hv1.ConfigActivePromotion{HealthWatch: healthWatch},
+							},
+						},
+					},
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			healthWatch = &s2hv1.ConfigHealthWatch{
+				Duration:         metav1.Duration{Duration: 10 * time.Minute},
+				Interval:         metav1.Duration{Duration: 30 * time.Second},
+				FailureThreshold: 2,
+			}
+
+			atpComp = &s2hv1.ActivePromotion{
+				ObjectMeta: metav1.ObjectMeta{Name: teamName},
+				Status: s2hv1.ActivePromotionStatus{
+					State:                   s2hv1.ActivePromotionDestroyingPreviousActive,
+					Result:                  s2hv1.ActivePromotionSuccess,
+					TargetNamespace:         targetNs,
+					PreviousActiveNamespace: "s2h-teamtest-ghijkl",
+				},
+			}
+			atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActivePromoted, corev1.ConditionTrue,
+				"Active environment has been promoted")
+		})
+
+		It("should not watch health if health watch is not configured", func() {
+			healthWatch = nil
+			setup()
+
+			g.Expect(ctrl.watchActiveHealth(ctx, atpComp)).To(Succeed())
+			g.Expect(atpComp.Status.HealthCheckedAt).To(BeNil())
+		})
+
+		It("should not watch health if there is no previous active namespace", func() {
+			atpComp.Status.PreviousActiveNamespace = ""
+			setup()
+
+			g.Expect(ctrl.watchActiveHealth(ctx, atpComp)).To(Succeed())
+			g.Expect(atpComp.Status.HealthCheckedAt).To(BeNil())
+		})
+
+		It("should keep the previous active namespace and check health during the health watch", func() {
+			setup(newPod("wordpress", corev1.PodRunning, readyStatus))
+
+			err := ctrl.watchActiveHealth(ctx, atpComp)
+			g.Expect(s2herrors.IsEnsuringActiveHealthWatched(err)).To(BeTrue())
+
+			fetched := &s2hv1.ActivePromotion{}
+			g.Expect(ctrl.client.Get(ctx, client.ObjectKey{Name: teamName}, fetched)).To(Succeed())
+			g.Expect(fetched.Status.HealthCheckedAt).NotTo(BeNil())
+			g.Expect(fetched.Status.HealthWatchFailures).To(Equal(0))
+
+			promotedAt := atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondActivePromoted)
+			g.Expect(fetched.Status.DestroyedTime.Time.Unix()).To(
+				Equal(promotedAt.Add(healthWatch.Duration.Duration).Unix()))
+		})
+
+		It("should not check health before the interval ends", func() {
+			setup(newPod("wordpress", corev1.PodRunning, newWaitingStatus("CrashLoopBackOff")))
+			checkedAt := metav1.Now()
+			atpComp.Status.HealthCheckedAt = &checkedAt
+
+			err := ctrl.watchActiveHealth(ctx, atpComp)
+			g.Expect(s2herrors.IsEnsuringActiveHealthWatched(err)).To(BeTrue())
+			g.Expect(atpComp.Status.HealthWatchFailures).To(Equal(0))
+		})
+
+		It("should request rolling back if the failure threshold is reached", func() {
+			setup(newPod("wordpress", corev1.PodRunning, newWaitingStatus("CrashLoopBackOff")))
+
+			err := ctrl.watchActiveHealth(ctx, atpComp)
+			g.Expect(s2herrors.IsEnsuringActiveHealthWatched(err)).To(BeTrue())
+			g.Expect(atpComp.Status.HealthWatchFailures).To(Equal(1))
+			g.Expect(atpComp.Spec.RollbackRequest).To(BeNil())
+
+			checkedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+			atpComp.Status.HealthCheckedAt = &checkedAt
+			err = ctrl.watchActiveHealth(ctx, atpComp)
+			g.Expect(s2herrors.IsEnsuringActiveHealthWatched(err)).To(BeTrue())
+			g.Expect(atpComp.Status.HealthWatchFailures).To(Equal(2))
+			g.Expect(atpComp.Status.Result).To(Equal(s2hv1.ActivePromotionFailure))
+			g.Expect(atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondActiveHealthy)).To(BeFalse())
+			g.Expect(atpComp.Spec.RollbackRequest).NotTo(BeNil())
+			g.Expect(atpComp.Spec.RollbackRequest.RequestedBy).To(Equal(healthWatchRollbackRequester))
+		})
+
+		It("should check http health of the active namespace", func() {
+			server := newHealthServer(http.StatusServiceUnavailable)
+			defer server.Close()
+
+			healthWatch.HTTP = &s2hv1.HealthWatchHTTP{URL: server.URL + "/healthz?namespace={{ .Namespace }}"}
+			setup(newPod("wordpress", corev1.PodRunning, readyStatus))
+
+			err := ctrl.watchActiveHealth(ctx, atpComp)
+			g.Expect(s2herrors.IsEnsuringActiveHealthWatched(err)).To(BeTrue())
+			g.Expect(atpComp.Status.HealthWatchFailures).To(Equal(1))
+		})
+
+		It("should mark the active environment as healthy after the health watch ends", func() {
+			setup(newPod("wordpress", corev1.PodRunning, newWaitingStatus("CrashLoopBackOff")))
+			atpComp.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+
+			g.Expect(ctrl.watchActiveHealth(ctx, atpComp)).To(Succeed())
+			g.Expect(atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondActiveHealthy)).To(BeTrue())
+			g.Expect(atpComp.Status.HealthWatchFailures).To(Equal(0))
+			g.Expect(isHealthWatchRequired(atpComp)).To(BeFalse())
+		})
+	})
+
+	Describe("Check http health", func() {
+		It("should return empty reason if the health check responds successfully", func() {
+			server := newHealthServer(http.StatusOK)
+			defer server.Close()
+
+			httpCheck := &s2hv1.HealthWatchHTTP{URL: server.URL + "/healthz?namespace={{ .Namespace }}"}
+			g.Expect(checkHTTPHealth(ctx, httpCheck, targetNs)).To(BeEmpty())
+		})
+
+		It("should return reason if the health check responds with error status", func() {
+			server := newHealthServer(http.StatusInternalServerError)
+			defer server.Close()
+
+			httpCheck := &s2hv1.HealthWatchHTTP{URL: server.URL + "/healthz?namespace={{ .Namespace }}"}
+			g.Expect(checkHTTPHealth(ctx, httpCheck, targetNs)).To(ContainSubstring("returned status 500"))
+		})
+
+		It("should return reason if the health check times out", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+			}))
+			defer server.Close()
+
+			httpCheck := &s2hv1.HealthWatchHTTP{
+				URL:     server.URL + "/healthz",
+				Timeout: metav1.Duration{Duration: 50 * time.Millisecond},
+			}
+			g.Expect(checkHTTPHealth(ctx, httpCheck, targetNs)).To(ContainSubstring("failed"))
+		})
+	})
+
+	Describe("Get unhealthy pod reasons", func() {
+		It("should return reasons of unhealthy pods", func() {
+			jobPod := newPod("migration", corev1.PodFailed)
+			jobPod.OwnerReferences = []metav1.OwnerReference{{Kind: "Job", Name: "migration"}}
+
+			pods := []corev1.Pod{
+				newPod("wordpress", corev1.PodRunning, readyStatus),
+				newPod("redis", corev1.PodRunning, newWaitingStatus("CrashLoopBackOff")),
+				newPod("mariadb", corev1.PodPending, newWaitingStatus("ErrImagePull")),
+				newPod("evicted", corev1.PodFailed),
+				newPod("starting", corev1.PodPending, newWaitingStatus("ContainerCreating")),
+				newPod("completed", corev1.PodSucceeded),
+				jobPod,
+			}
+
+			g.Expect(getUnhealthyPodReasons(pods)).To(Equal([]string{
+				"evicted: Undefined",
+				"mariadb/app: ImagePullBackOff",
+				"redis/app: CrashLoopBackOff",
+			}))
+		})
+
+		It("should return empty reasons if all pods are healthy", func() {
+			pods := []corev1.Pod{newPod("wordpress", corev1.PodRunning, readyStatus)}
+			g.Expect(getUnhealthyPodReasons(pods)).To(BeEmpty())
+		})
+	})
+})
@@ -60,6 +60,11 @@ func (c *controller) sendReport(ctx context.Context, atpComp *s2hv1.ActivePromot
 		return err
 	}
 
+	// the previous active namespace has been swapped with the promoted namespace after rolling back
+	if atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondPreviousActiveRestored) {
+		currentNs = teamComp.Status.Namespace.Active
+	}
+
 	if err := c.s2hCtrl.LoadTeamSecret(teamComp); err != nil {
 		logger.Error(err, "cannot load team secret", "team", teamComp.Name)
 		return err
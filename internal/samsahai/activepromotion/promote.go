@@ -34,6 +34,18 @@ func (c *controller) promoteActiveEnvironment(ctx context.Context, atpComp *s2hv
 		return err
 	}
 
+	isTrafficShiftRequired, err := c.isTrafficShiftRequired(atpComp)
+	if err != nil {
+		return err
+	}
+
+	if isTrafficShiftRequired {
+		logger.Info("active values have been applied, start shifting traffic to the pre-active environment",
+			"team", teamName, "namespace", targetNs)
+		atpComp.SetState(s2hv1.ActivePromotionShiftingTraffic, "Shifting traffic to the pre-active environment")
+		return nil
+	}
+
 	if err := c.ensureActiveEnvironmentPromoted(ctx, atpComp); err != nil {
 		return err
 	}
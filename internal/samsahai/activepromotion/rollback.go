@@ -2,12 +2,16 @@ package activepromotion
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	"github.com/agoda-com/samsahai/internal/queue"
+	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
+	"github.com/agoda-com/samsahai/internal/util/valuesutil"
 )
 
 func (c *controller) rollbackActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
@@ -166,9 +170,83 @@ func (c *controller) checkRollbackTimeout(ctx context.Context, atpComp *s2hv1.Ac
 		if err := c.updateActivePromotion(ctx, atpComp); err != nil {
 			return err
 		}
+		exporter.ObserveActivePromotionResult(atpComp)
 
 		return s2herrors.ErrRollbackActivePromotionTimeout
 	}
 
 	return nil
 }
+
+// isRollbackToPreviousActiveRequested returns true if rolling back to the previous active namespace
+// has been requested and has not been processed yet
+func isRollbackToPreviousActiveRequested(atpComp *s2hv1.ActivePromotion) bool {
+	return atpComp.Spec.RollbackRequest != nil &&
+		atpComp.Status.PreviousActiveNamespace != "" &&
+		atpComp.Status.RollbackStatus == ""
+}
+
+// rollbackToPreviousActive switches the active environment back to the previous active namespace
+// which has not been destroyed yet, the promoted namespace is going to be destroyed instead
+func (c *controller) rollbackToPreviousActive(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	targetNs := c.getTargetNamespace(atpComp)
+	prevNs := atpComp.Status.PreviousActiveNamespace
+
+	if err := queue.DeleteDemoteFromActiveQueue(c.client, prevNs); err != nil {
+		return err
+	}
+
+	if err := c.ensureQueuePromotedToActive(teamName, prevNs); err != nil {
+		if s2herrors.IsErrReleaseFailed(err) {
+			logger.Warn("cannot rollback to previous active namespace",
+				"team", teamName, "namespace", prevNs)
+			atpComp.Status.SetRollbackStatus(s2hv1.ActivePromotionRollbackFailure)
+			atpComp.Status.SetCondition(s2hv1.ActivePromotionCondPreviousActiveRestored, corev1.ConditionFalse,
+				"Previous active namespace cannot be restored due to cannot apply active values file")
+			return nil
+		}
+
+		return err
+	}
+
+	// stop serving traffic from the rolled back namespace before restoring the previous active namespace
+	if err := c.setIngressesCanaryWeight(ctx, targetNs, 0); err != nil {
+		return err
+	}
+
+	if err := queue.DeletePromoteToActiveQueue(c.client, prevNs); err != nil {
+		return err
+	}
+
+	teamComp, err := c.getTeam(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	stableComps, err := valuesutil.GetStableComponentsMap(c.client, prevNs)
+	if err != nil {
+		return err
+	}
+	teamComp.Status.SetActiveComponents(stableComps)
+
+	if err := c.s2hCtrl.SetActiveNamespace(teamComp, prevNs); err != nil {
+		return err
+	}
+
+	if err := c.s2hCtrl.SetPreviousActiveNamespace(teamComp, targetNs); err != nil {
+		return err
+	}
+
+	logger.Info("active environment has been rolled back to previous active namespace",
+		"team", teamName, "namespace", prevNs, "requestedBy", atpComp.Spec.RollbackRequest.RequestedBy)
+	atpComp.Status.PreviousActiveNamespace = targetNs
+	atpComp.Status.SetDestroyedTime(metav1.Now())
+	atpComp.Status.SetRollbackStatus(s2hv1.ActivePromotionRollbackSuccess)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondPreviousActiveRestored, corev1.ConditionTrue,
+		fmt.Sprintf("Previous active namespace %s has been restored", prevNs))
+	atpComp.SetState(s2hv1.ActivePromotionDestroyingPreviousActive,
+		"Destroying the promoted environment after rolling back")
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package activepromotion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/prometheus"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+const (
+	canaryAnnotation       = "nginx.ingress.kubernetes.io/canary"
+	canaryWeightAnnotation = "nginx.ingress.kubernetes.io/canary-weight"
+
+	defaultTrafficShiftStepDuration = 5 * time.Minute
+	fullTrafficWeight               = 100
+)
+
+func (c *controller) getTrafficShiftConfig(teamName string) (*s2hv1.ConfigTrafficShift, error) {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Status.Used.ActivePromotion == nil {
+		return nil, nil
+	}
+
+	return config.Status.Used.ActivePromotion.TrafficShift, nil
+}
+
+// shiftTraffic shifts traffic to the pre-active namespace step by step, the error rate is checked at the end of
+// each step and the active promotion is rolled back if the error rate exceeds the threshold
+func (c *controller) shiftTraffic(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	targetNs := c.getTargetNamespace(atpComp)
+
+	trafficShift, err := c.getTrafficShiftConfig(teamName)
+	if err != nil {
+		return err
+	}
+
+	if trafficShift == nil || len(trafficShift.Steps) == 0 {
+		logger.Warn("traffic shift configuration has been removed, shifting all traffic", "team", teamName)
+		return c.completeTrafficShift(ctx, atpComp, targetNs)
+	}
+
+	// start the first step
+	if atpComp.Status.TrafficShiftedAt == nil {
+		return c.setTrafficWeight(ctx, atpComp, targetNs, trafficShift.Steps[0])
+	}
+
+	if time.Since(atpComp.Status.TrafficShiftedAt.Time) < getTrafficShiftStepDuration(trafficShift) {
+		return s2herrors.ErrEnsureTrafficShifted
+	}
+
+	if trafficShift.Prometheus != nil {
+		errorRate, exceeded, err := checkErrorRate(ctx, trafficShift.Prometheus, targetNs)
+		if err != nil {
+			// prometheus being unavailable should not roll back the active promotion,
+			// the current step is held until the error rate can be checked or the active promotion is timeout
+			logger.Error(err, "cannot check error rate, holding the current traffic weight",
+				"team", teamName, "namespace", targetNs, "trafficWeight", atpComp.Status.TrafficWeight)
+			return s2herrors.ErrEnsureTrafficShifted
+		}
+
+		if exceeded {
+			msg := fmt.Sprintf("Error rate %v exceeds threshold %s at %d%% of traffic",
+				errorRate, trafficShift.Prometheus.Threshold, atpComp.Status.TrafficWeight)
+			logger.Info("error rate exceeds threshold, start rolling back active promotion",
+				"team", teamName, "namespace", targetNs, "errorRate", errorRate,
+				"trafficWeight", atpComp.Status.TrafficWeight)
+
+			atpComp.Status.SetResult(s2hv1.ActivePromotionFailure)
+			atpComp.Status.SetCondition(s2hv1.ActivePromotionCondTrafficShifted, corev1.ConditionFalse, msg)
+			atpComp.Status.SetCondition(s2hv1.ActivePromotionCondRollbackStarted, corev1.ConditionTrue,
+				"Rollback process has been started due to error rate exceeds threshold")
+			atpComp.SetState(s2hv1.ActivePromotionRollback, msg)
+			return nil
+		}
+	}
+
+	nextWeight := getNextTrafficWeight(trafficShift.Steps, atpComp.Status.TrafficWeight)
+	if nextWeight >= fullTrafficWeight {
+		return c.completeTrafficShift(ctx, atpComp, targetNs)
+	}
+
+	return c.setTrafficWeight(ctx, atpComp, targetNs, nextWeight)
+}
+
+// setTrafficWeight shifts the weight of traffic to the namespace and starts a new step,
+// the active promotion is updated here due to it will be requeued until the step ends
+func (c *controller) setTrafficWeight(ctx context.Context, atpComp *s2hv1.ActivePromotion, ns string,
+	weight int) error {
+
+	if err := c.setIngressesCanaryWeight(ctx, ns, weight); err != nil {
+		return err
+	}
+
+	logger.Info("traffic has been shifted", "team", atpComp.Name, "namespace", ns, "trafficWeight", weight)
+	atpComp.Status.SetTrafficWeight(weight)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondTrafficShifted, corev1.ConditionFalse,
+		fmt.Sprintf("%d%% of traffic has been shifted to %s", weight, ns))
+
+	if err := c.updateActivePromotion(ctx, atpComp); err != nil {
+		return err
+	}
+
+	return s2herrors.ErrEnsureTrafficShifted
+}
+
+// completeTrafficShift shifts all traffic to the namespace and continues promoting the active environment,
+// canary annotations are removed before the previous active environment is demoted
+// due to canary ingresses do not serve any traffic without their main ingresses
+func (c *controller) completeTrafficShift(ctx context.Context, atpComp *s2hv1.ActivePromotion, ns string) error {
+	if err := c.setIngressesCanaryWeight(ctx, ns, fullTrafficWeight); err != nil {
+		return err
+	}
+
+	if err := c.removeIngressesCanary(ctx, ns); err != nil {
+		return err
+	}
+
+	logger.Info("all traffic has been shifted, continue promoting active environment",
+		"team", atpComp.Name, "namespace", ns)
+	atpComp.Status.SetTrafficWeight(fullTrafficWeight)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondTrafficShifted, corev1.ConditionTrue,
+		fmt.Sprintf("All traffic has been shifted to %s", ns))
+	atpComp.SetState(s2hv1.ActivePromotionActiveEnvironment, "Promoting an active environment")
+
+	return nil
+}
+
+func (c *controller) isTrafficShiftRequired(atpComp *s2hv1.ActivePromotion) (bool, error) {
+	if atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondTrafficShifted) {
+		return false, nil
+	}
+
+	trafficShift, err := c.getTrafficShiftConfig(atpComp.Name)
+	if err != nil {
+		return false, err
+	}
+
+	return trafficShift != nil && len(trafficShift.Steps) > 0, nil
+}
+
+// setIngressesCanaryWeight marks ingresses of the namespace as canary ingresses with the weight
+func (c *controller) setIngressesCanaryWeight(ctx context.Context, ns string, weight int) error {
+	ingresses := &networkingv1.IngressList{}
+	if err := c.client.List(ctx, ingresses, &client.ListOptions{Namespace: ns}); err != nil {
+		return errors.Wrapf(err, "cannot list ingresses of namespace %s", ns)
+	}
+
+	for i := range ingresses.Items {
+		ing := &ingresses.Items[i]
+		if ing.Annotations == nil {
+			ing.Annotations = make(map[string]string)
+		}
+
+		weightStr := strconv.Itoa(weight)
+		if ing.Annotations[canaryAnnotation] == "true" && ing.Annotations[canaryWeightAnnotation] == weightStr {
+			continue
+		}
+
+		ing.Annotations[canaryAnnotation] = "true"
+		ing.Annotations[canaryWeightAnnotation] = weightStr
+		if err := c.client.Update(ctx, ing); err != nil {
+			return errors.Wrapf(err, "cannot update canary weight of ingress %s/%s", ns, ing.Name)
+		}
+	}
+
+	return nil
+}
+
+// removeIngressesCanary makes canary ingresses of the namespace serve all traffic
+func (c *controller) removeIngressesCanary(ctx context.Context, ns string) error {
+	ingresses := &networkingv1.IngressList{}
+	if err := c.client.List(ctx, ingresses, &client.ListOptions{Namespace: ns}); err != nil {
+		return errors.Wrapf(err, "cannot list ingresses of namespace %s", ns)
+	}
+
+	for i := range ingresses.Items {
+		ing := &ingresses.Items[i]
+		if _, ok := ing.Annotations[canaryAnnotation]; !ok {
+			continue
+		}
+
+		delete(ing.Annotations, canaryAnnotation)
+		delete(ing.Annotations, canaryWeightAnnotation)
+		if err := c.client.Update(ctx, ing); err != nil {
+			return errors.Wrapf(err, "cannot remove canary annotations of ingress %s/%s", ns, ing.Name)
+		}
+	}
+
+	return nil
+}
+
+// getTrafficShiftStepDuration returns the duration of each traffic shift step
+func getTrafficShiftStepDuration(trafficShift *s2hv1.ConfigTrafficShift) time.Duration {
+	if trafficShift.StepDuration.Duration <= 0 {
+		return defaultTrafficShiftStepDuration
+	}
+
+	return trafficShift.StepDuration.Duration
+}
+
+// getTrafficShiftDuration returns the total duration of all traffic shift steps, zero if traffic shift is disabled
+func getTrafficShiftDuration(trafficShift *s2hv1.ConfigTrafficShift) time.Duration {
+	if trafficShift == nil || len(trafficShift.Steps) == 0 {
+		return 0
+	}
+
+	return time.Duration(len(trafficShift.Steps)) * getTrafficShiftStepDuration(trafficShift)
+}
+
+// getNextTrafficWeight returns the first step which is greater than the current weight,
+// all traffic is shifted after the last step
+func getNextTrafficWeight(steps []int, current int) int {
+	for _, step := range steps {
+		if step > current {
+			return step
+		}
+	}
+
+	return fullTrafficWeight
+}
+
+// checkErrorRate returns true if the error rate of the namespace exceeds the threshold
+func checkErrorRate(ctx context.Context, promConfig *s2hv1.PrometheusQuery, ns string) (
+	float64, bool, error) {
+
+	threshold, err := strconv.ParseFloat(promConfig.Threshold, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "invalid error rate threshold %q", promConfig.Threshold)
+	}
+
+	query := template.TextRender("ErrorRateQuery", promConfig.Query, struct{ Namespace string }{ns})
+	errorRate, err := prometheus.QueryValue(ctx, promConfig.URL, query)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return errorRate, errorRate > threshold, nil
+}
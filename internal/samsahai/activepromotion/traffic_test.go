@@ -0,0 +1,232 @@
+package activepromotion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+var _ = Describe("Traffic shift", func() {
+	g := NewWithT(GinkgoT())
+
+	teamName := "teamtest"
+	targetNs := "s2h-teamtest-abcdef"
+	ctx := context.TODO()
+
+	var ctrl *controller
+	var atpComp *s2hv1.ActivePromotion
+	var trafficShift *s2hv1.ConfigTrafficShift
+	var atpConfig *s2hv1.ConfigActivePromotion
+
+	newPrometheusServer := func(errorRate string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`{"status":"success","data":{"resultType":"scalar","result":[1609459200,"%s"]}}`, errorRate)))
+		}))
+	}
+
+	getIngress := func() *networkingv1.Ingress {
+		ing := &networkingv1.Ingress{}
+		err := ctrl.client.Get(ctx, types.NamespacedName{Name: "wordpress", Namespace: targetNs}, ing)
+		g.Expect(err).NotTo(HaveOccurred())
+		return ing
+	}
+
+	BeforeEach(func() {
+		trafficShift = &s2hv1.ConfigTrafficShift{
+			Steps:        []int{10, 50},
+			StepDuration: metav1.Duration{Duration: time.Minute},
+		}
+		atpConfig = &s2hv1.ConfigActivePromotion{TrafficShift: trafficShift}
+
+		atpComp = &s2hv1.ActivePromotion{
+			ObjectMeta: metav1.ObjectMeta{Name: teamName},
+			Status: s2hv1.ActivePromotionStatus{
+				State:           s2hv1.ActivePromotionShiftingTraffic,
+				TargetNamespace: targetNs,
+			},
+		}
+		ing := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "wordpress", Namespace: targetNs},
+		}
+
+		ctrl = &controller{
+			client: unittest.NewFakeClient(atpComp, ing),
+			s2hCtrl: &mockSamsahaiCtrl{configCtrl: &mockConfigCtrl{
+				config: &s2hv1.Config{
+					Status: s2hv1.ConfigStatus{
+						Used: s2hv1.ConfigSpec{
+							ActivePromotion: atpConfig,
+						},
+					},
+				},
+			}},
+		}
+	})
+
+	It("should start the first step", func() {
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(s2herrors.IsEnsuringTrafficShifted(err)).To(BeTrue())
+
+		ing := getIngress()
+		g.Expect(ing.Annotations).To(HaveKeyWithValue(canaryAnnotation, "true"))
+		g.Expect(ing.Annotations).To(HaveKeyWithValue(canaryWeightAnnotation, "10"))
+
+		fetched := &s2hv1.ActivePromotion{}
+		g.Expect(ctrl.client.Get(ctx, client.ObjectKey{Name: teamName}, fetched)).To(Succeed())
+		g.Expect(fetched.Status.TrafficWeight).To(Equal(10))
+		g.Expect(fetched.Status.TrafficShiftedAt).NotTo(BeNil())
+		g.Expect(fetched.Status.IsConditionTrue(s2hv1.ActivePromotionCondTrafficShifted)).To(BeFalse())
+	})
+
+	It("should wait until the step ends", func() {
+		atpComp.Status.SetTrafficWeight(10)
+
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(s2herrors.IsEnsuringTrafficShifted(err)).To(BeTrue())
+		g.Expect(atpComp.Status.TrafficWeight).To(Equal(10))
+		g.Expect(getIngress().Annotations).NotTo(HaveKey(canaryWeightAnnotation))
+	})
+
+	It("should shift to the next step if the error rate does not exceed the threshold", func() {
+		server := newPrometheusServer("0.01")
+		defer server.Close()
+
+		trafficShift.Prometheus = &s2hv1.PrometheusQuery{URL: server.URL, Query: "error_rate", Threshold: "0.05"}
+		atpComp.Status.TrafficWeight = 10
+		atpComp.Status.TrafficShiftedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(s2herrors.IsEnsuringTrafficShifted(err)).To(BeTrue())
+		g.Expect(atpComp.Status.TrafficWeight).To(Equal(50))
+		g.Expect(getIngress().Annotations).To(HaveKeyWithValue(canaryWeightAnnotation, "50"))
+	})
+
+	It("should roll back if the error rate exceeds the threshold", func() {
+		server := newPrometheusServer("0.1")
+		defer server.Close()
+
+		trafficShift.Prometheus = &s2hv1.PrometheusQuery{URL: server.URL, Query: "error_rate", Threshold: "0.05"}
+		atpComp.Status.TrafficWeight = 10
+		atpComp.Status.TrafficShiftedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(atpComp.Status.Result).To(Equal(s2hv1.ActivePromotionFailure))
+		g.Expect(atpComp.Status.State).To(Equal(s2hv1.ActivePromotionRollback))
+		g.Expect(atpComp.Status.TrafficWeight).To(Equal(10))
+	})
+
+	It("should hold the current step if the error rate cannot be checked", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		trafficShift.Prometheus = &s2hv1.PrometheusQuery{URL: server.URL, Query: "error_rate", Threshold: "0.05"}
+		atpComp.Status.TrafficWeight = 10
+		atpComp.Status.TrafficShiftedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(s2herrors.IsEnsuringTrafficShifted(err)).To(BeTrue())
+		g.Expect(atpComp.Status.TrafficWeight).To(Equal(10))
+		g.Expect(atpComp.Status.State).To(Equal(s2hv1.ActivePromotionShiftingTraffic))
+	})
+
+	It("should not time out during a multi-step traffic shift", func() {
+		atpConfig.Timeout = metav1.Duration{Duration: 2 * time.Minute}
+		trafficShift.Steps = []int{10, 25, 50, 75}
+
+		// 4 steps of 1 minute are added to the timeout
+		atpComp.Status.Conditions = []s2hv1.ActivePromotionCondition{{
+			Type:               s2hv1.ActivePromotionCondStarted,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+		}}
+		g.Expect(ctrl.checkActivePromotionTimeout(ctx, atpComp)).To(Succeed())
+		g.Expect(atpComp.Status.IsTimeout).To(BeFalse())
+		g.Expect(atpComp.Status.State).To(Equal(s2hv1.ActivePromotionShiftingTraffic))
+
+		atpComp.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-7 * time.Minute))
+		err := ctrl.checkActivePromotionTimeout(ctx, atpComp)
+		g.Expect(err).To(Equal(s2herrors.ErrActivePromotionTimeout))
+		g.Expect(atpComp.Status.IsTimeout).To(BeTrue())
+	})
+
+	It("should remove canary annotations after shifting all traffic", func() {
+		atpComp.Status.TrafficWeight = 50
+		atpComp.Status.TrafficShiftedAt = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+		g.Expect(ctrl.setIngressesCanaryWeight(ctx, targetNs, 50)).To(Succeed())
+
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(atpComp.Status.TrafficWeight).To(Equal(fullTrafficWeight))
+		g.Expect(atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondTrafficShifted)).To(BeTrue())
+		g.Expect(atpComp.Status.State).To(Equal(s2hv1.ActivePromotionActiveEnvironment))
+
+		ing := getIngress()
+		g.Expect(ing.Annotations).NotTo(HaveKey(canaryAnnotation))
+		g.Expect(ing.Annotations).NotTo(HaveKey(canaryWeightAnnotation))
+	})
+
+	It("should shift all traffic if the traffic shift configuration has been removed", func() {
+		trafficShift.Steps = nil
+		g.Expect(ctrl.setIngressesCanaryWeight(ctx, targetNs, 10)).To(Succeed())
+
+		err := ctrl.shiftTraffic(ctx, atpComp)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(atpComp.Status.State).To(Equal(s2hv1.ActivePromotionActiveEnvironment))
+		g.Expect(getIngress().Annotations).NotTo(HaveKey(canaryAnnotation))
+	})
+
+	It("should check traffic shift is required correctly", func() {
+		isRequired, err := ctrl.isTrafficShiftRequired(atpComp)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isRequired).To(BeTrue())
+
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondTrafficShifted, corev1.ConditionTrue, "")
+		isRequired, err = ctrl.isTrafficShiftRequired(atpComp)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isRequired).To(BeFalse(), "traffic has been shifted")
+	})
+
+	It("should get next traffic weight correctly", func() {
+		g.Expect(getNextTrafficWeight([]int{10, 50}, 0)).To(Equal(10))
+		g.Expect(getNextTrafficWeight([]int{10, 50}, 10)).To(Equal(50))
+		g.Expect(getNextTrafficWeight([]int{10, 50}, 50)).To(Equal(fullTrafficWeight))
+	})
+})
+
+type mockSamsahaiCtrl struct {
+	internal.SamsahaiController
+	configCtrl internal.ConfigController
+}
+
+func (c *mockSamsahaiCtrl) GetConfigController() internal.ConfigController {
+	return c.configCtrl
+}
+
+type mockConfigCtrl struct {
+	internal.ConfigController
+	config *s2hv1.Config
+}
+
+func (c *mockConfigCtrl) Get(configName string) (*s2hv1.Config, error) {
+	return c.config, nil
+}
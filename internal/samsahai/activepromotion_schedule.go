@@ -0,0 +1,109 @@
+package samsahai
+
+import (
+	"time"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	configctrl "github.com/agoda-com/samsahai/internal/config"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+// activePromotionScheduleCheckInterval defines a duration time to check the active promotion schedules,
+// it is the smallest unit of cron expressions
+const activePromotionScheduleCheckInterval = time.Minute
+
+// checkActivePromotionSchedule creates active promotions of teams which are scheduled
+// after the last checked time until now
+func (c *controller) checkActivePromotionSchedule(v checkActivePromotionSchedule) error {
+	now := time.Now()
+	defer c.queue.AddAfter(checkActivePromotionSchedule{LastCheckedAt: now}, activePromotionScheduleCheckInterval)
+
+	// the first check only starts the schedule window
+	if v.LastCheckedAt.IsZero() {
+		return nil
+	}
+
+	// errors are not returned, the schedules will be checked again in the next interval
+	teamList, err := c.GetTeams()
+	if err != nil {
+		logger.Error(err, "cannot list teams for checking active promotion schedules")
+		return nil
+	}
+
+	for i := range teamList.Items {
+		teamComp := &teamList.Items[i]
+		if !teamComp.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if err := c.checkTeamActivePromotionSchedule(teamComp, v.LastCheckedAt, now); err != nil {
+			logger.Error(err, "cannot check active promotion schedule", "team", teamComp.Name)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (c *controller) checkTeamActivePromotionSchedule(teamComp *s2hv1.Team, from, to time.Time) error {
+	config, err := c.GetConfigController().Get(teamComp.Name)
+	if err != nil {
+		return err
+	}
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil || atpConfig.Schedule == nil {
+		return nil
+	}
+
+	schedule := atpConfig.Schedule
+	isScheduled, err := configctrl.IsActivePromotionScheduled(schedule, from, to)
+	if err != nil || !isScheduled {
+		return err
+	}
+
+	_, err = c.getActivePromotion(teamComp.Name)
+	switch {
+	case err == nil:
+		logger.Info("active promotion is already running, skipping scheduled active promotion",
+			"team", teamComp.Name)
+		return nil
+	case !s2herrors.Is(err, s2herrors.ErrActivePromotionNotFound):
+		return err
+	}
+
+	if schedule.OnlyIfStableComponentsChanged && !isStableComponentsChanged(teamComp) {
+		logger.Info("stable components have not been changed since the last promotion, "+
+			"skipping scheduled active promotion", "team", teamComp.Name)
+		return nil
+	}
+
+	logger.Info("start creating scheduled active promotion", "team", teamComp.Name)
+
+	return c.createActivePromotion(teamComp.Name)
+}
+
+// isStableComponentsChanged checks stable components of the team are different from components of
+// the active namespace, the stable components are always changed if there is no active namespace
+func isStableComponentsChanged(teamComp *s2hv1.Team) bool {
+	if teamComp.Status.Namespace.Active == "" {
+		return true
+	}
+
+	stableComps := teamComp.Status.StableComponents
+	activeComps := teamComp.Status.ActiveComponents
+	if len(stableComps) != len(activeComps) {
+		return true
+	}
+
+	for name, stableComp := range stableComps {
+		activeComp, ok := activeComps[name]
+		if !ok ||
+			activeComp.Spec.Repository != stableComp.Spec.Repository ||
+			activeComp.Spec.Version != stableComp.Spec.Version {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,40 @@
+package samsahai
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Active promotion schedule", func() {
+	g := NewWithT(GinkgoT())
+
+	newStableComp := func(name, version string) s2hv1.StableComponent {
+		return s2hv1.StableComponent{
+			Spec: s2hv1.StableComponentSpec{Name: name, Repository: "bitnami/" + name, Version: version},
+		}
+	}
+
+	It("should detect stable components changed since the last promotion correctly", func() {
+		teamComp := &s2hv1.Team{}
+		g.Expect(isStableComponentsChanged(teamComp)).To(BeTrue(), "no active namespace")
+
+		teamComp.Status.Namespace.Active = "s2h-teamtest-abcdef"
+		teamComp.Status.StableComponents = map[string]s2hv1.StableComponent{
+			"redis":   newStableComp("redis", "5.0.7"),
+			"mariadb": newStableComp("mariadb", "10.3.22"),
+		}
+		teamComp.Status.ActiveComponents = map[string]s2hv1.StableComponent{
+			"redis":   newStableComp("redis", "5.0.7"),
+			"mariadb": newStableComp("mariadb", "10.3.22"),
+		}
+		g.Expect(isStableComponentsChanged(teamComp)).To(BeFalse())
+
+		teamComp.Status.StableComponents["redis"] = newStableComp("redis", "5.0.8")
+		g.Expect(isStableComponentsChanged(teamComp)).To(BeTrue(), "version changed")
+
+		delete(teamComp.Status.StableComponents, "redis")
+		g.Expect(isStableComponentsChanged(teamComp)).To(BeTrue(), "component removed")
+	})
+})
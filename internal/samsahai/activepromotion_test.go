@@ -0,0 +1,114 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+var _ = Describe("Active promotion management", func() {
+	g := NewWithT(GinkgoT())
+
+	teamName := "teamtest"
+	var ctrl *controller
+
+	newActivePromotion := func(state s2hv1.ActivePromotionState) *s2hv1.ActivePromotion {
+		destroyedTime := metav1.NewTime(time.Now().Add(30 * time.Minute))
+		return &s2hv1.ActivePromotion{
+			ObjectMeta: metav1.ObjectMeta{Name: teamName},
+			Status: s2hv1.ActivePromotionStatus{
+				State:                   state,
+				Result:                  s2hv1.ActivePromotionSuccess,
+				TargetNamespace:         "s2h-teamtest-abcdef",
+				PreviousActiveNamespace: "s2h-teamtest-active",
+				DestroyedTime:           &destroyedTime,
+			},
+		}
+	}
+
+	newController := func(objs ...client.Object) *controller {
+		objs = append(objs, &s2hv1.Team{ObjectMeta: metav1.ObjectMeta{Name: teamName}})
+		return &controller{client: unittest.NewFakeClient(objs...)}
+	}
+
+	getActivePromotion := func() (*s2hv1.ActivePromotion, error) {
+		atp := &s2hv1.ActivePromotion{}
+		err := ctrl.client.Get(context.TODO(), client.ObjectKey{Name: teamName}, atp)
+		return atp, err
+	}
+
+	It("should trigger active promotion of the team", func() {
+		ctrl = newController()
+
+		tearDownDuration := metav1.Duration{Duration: 10 * time.Minute}
+		spec := s2hv1.ActivePromotionSpec{
+			SkipTestRunner:   true,
+			TearDownDuration: &tearDownDuration,
+			PromotedBy:       "john",
+		}
+		g.Expect(ctrl.TriggerActivePromotion(teamName, spec)).To(Succeed())
+
+		atp, err := getActivePromotion()
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(atp.Spec).To(Equal(spec))
+
+		err = ctrl.TriggerActivePromotion(teamName, spec)
+		g.Expect(k8serrors.IsAlreadyExists(err)).To(BeTrue())
+
+		err = ctrl.TriggerActivePromotion("unknown", spec)
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should cancel only waiting or running active promotion", func() {
+		ctrl = newController(newActivePromotion(s2hv1.ActivePromotionWaiting))
+		g.Expect(ctrl.CancelActivePromotion(teamName, "john")).To(Succeed())
+		_, err := getActivePromotion()
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+		ctrl = newController(newActivePromotion(s2hv1.ActivePromotionTestingPreActive))
+		g.Expect(ctrl.CancelActivePromotion(teamName, "john")).To(Succeed())
+
+		ctrl = newController(newActivePromotion(s2hv1.ActivePromotionDestroyingPreviousActive))
+		err = ctrl.CancelActivePromotion(teamName, "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeCanceled)).To(BeTrue())
+
+		ctrl = newController()
+		err = ctrl.CancelActivePromotion(teamName, "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrActivePromotionNotFound)).To(BeTrue())
+	})
+
+	It("should request rolling back while previous active namespace still exists", func() {
+		ctrl = newController(newActivePromotion(s2hv1.ActivePromotionDestroyingPreviousActive))
+		g.Expect(ctrl.RollbackActivePromotion(teamName, "john")).To(Succeed())
+
+		atp, err := getActivePromotion()
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(atp.Spec.RollbackRequest).NotTo(BeNil())
+		g.Expect(atp.Spec.RollbackRequest.RequestedBy).To(Equal("john"))
+
+		err = ctrl.RollbackActivePromotion(teamName, "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeRolledBack)).To(BeTrue())
+	})
+
+	It("should not request rolling back if previous active namespace is being destroyed", func() {
+		atp := newActivePromotion(s2hv1.ActivePromotionDestroyingPreviousActive)
+		destroyedTime := metav1.NewTime(time.Now().Add(-time.Minute))
+		atp.Status.DestroyedTime = &destroyedTime
+		ctrl = newController(atp)
+		err := ctrl.RollbackActivePromotion(teamName, "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeRolledBack)).To(BeTrue())
+
+		ctrl = newController(newActivePromotion(s2hv1.ActivePromotionTestingPreActive))
+		err = ctrl.RollbackActivePromotion(teamName, "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeRolledBack)).To(BeTrue())
+	})
+})
@@ -6,8 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
-	"regexp"
-	"sort"
 	"strings"
 	"time"
 
@@ -36,7 +34,12 @@ type checker struct {
 }
 
 type harborRes struct {
-	Tags []harborTag `json:"tags"`
+	Tags       []harborTag      `json:"tags"`
+	ExtraAttrs harborExtraAttrs `json:"extra_attrs"`
+}
+
+type harborExtraAttrs struct {
+	Created time.Time `json:"created"`
 }
 
 type harborTag struct {
@@ -53,7 +56,7 @@ func (c *checker) GetName() string {
 	return CheckerName
 }
 
-func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
+func (c *checker) GetVersion(repository, name, pattern string, opts ...internal.CheckOption) (string, error) {
 	if pattern == "" {
 		pattern = ".*"
 	}
@@ -63,9 +66,9 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 		return "", err
 	}
 
-	matcher, err := regexp.Compile(pattern)
+	selector, err := internal.NewVersionSelector(pattern, opts...)
 	if err != nil {
-		logger.Error(err, "invalid pattern", "pattern", pattern)
+		logger.Error(err, "invalid pattern or ordering", "pattern", pattern)
 		return "", err
 	}
 
@@ -77,7 +80,7 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 	ctx, cancelFunc := context.WithTimeout(context.Background(), MaxRequestsTimeout)
 	defer cancelFunc()
 
-	tagCh, errCh = c.check(ctx, domain, repo, matcher)
+	tagCh, errCh = c.check(ctx, domain, repo, selector)
 
 	select {
 	case <-ctx.Done():
@@ -95,8 +98,12 @@ func (c *checker) EnsureVersion(repository, name, version string) error {
 	return err
 }
 
-// check returns matched tag from harbor
-func (c *checker) check(ctx context.Context, domain, fullRepository string, matcher *regexp.Regexp) (<-chan string, <-chan error) {
+// check returns matched tag from harbor,
+// the latest tag of the first page which has matched tags is returned if the selector uses default ordering,
+// otherwise tags of all pages are ordered
+func (c *checker) check(ctx context.Context, domain, fullRepository string, selector *internal.VersionSelector) (
+	<-chan string, <-chan error) {
+
 	tagCh := make(chan string)
 	errCh := make(chan error)
 
@@ -111,7 +118,7 @@ func (c *checker) check(ctx context.Context, domain, fullRepository string, matc
 		}
 
 		currentPage := 0
-		var matchedTags []string
+		var versions []internal.ImageVersion
 		for {
 			currentPage++
 			reqURL := fmt.Sprintf("https://%s/api/v2.0/projects/%s/repositories/%s/artifacts?tags=*&page=%d&page_size=%d",
@@ -142,16 +149,18 @@ func (c *checker) check(ctx context.Context, domain, fullRepository string, matc
 
 			for _, artifact := range respJSON {
 				for _, tag := range artifact.Tags {
-					if matcher.MatchString(tag.Name) {
-						matchedTags = append(matchedTags, tag.Name)
-					}
+					versions = append(versions, internal.ImageVersion{
+						Tag:         tag.Name,
+						CreatedTime: artifact.ExtraAttrs.Created,
+					})
 				}
 			}
 
-			if len(matchedTags) > 0 {
-				sort.Sort(internal.SortableVersion(matchedTags))
-				tagCh <- matchedTags[len(matchedTags)-1]
-				return
+			if selector.IsDefaultOrdering() {
+				if tag, ok := selector.Latest(versions); ok {
+					tagCh <- tag
+					return
+				}
 			}
 
 			if len(respJSON) == 0 || currentPage >= maximumPages {
@@ -162,6 +171,11 @@ func (c *checker) check(ctx context.Context, domain, fullRepository string, matc
 				"url", reqURL, "image", fullRepository)
 		}
 
+		if tag, ok := selector.Latest(versions); ok {
+			tagCh <- tag
+			return
+		}
+
 		errCh <- s2herrors.ErrImageVersionNotFound
 	}()
 
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hhttp "github.com/agoda-com/samsahai/internal/util/http"
@@ -192,6 +193,35 @@ var _ = Describe("Harbor Checker", func() {
 		g.Expect(version).To(Equal("1.13.5-3.0.0-beta.2"))
 	})
 
+	It("should successfully get version by created time through all pages", func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			var err error
+			switch r.URL.Query().Get("page") {
+			case "1":
+				_, err = w.Write([]byte(`[
+  {"extra_attrs": {"created": "2021-03-08T05:07:20Z"}, "tags": [{"name": "1.13.5-r2"}]},
+  {"extra_attrs": {"created": "2021-03-01T05:07:20Z"}, "tags": [{"name": "1.13.5-r3"}]}
+]`))
+			case "2":
+				_, err = w.Write([]byte(`[
+  {"extra_attrs": {"created": "2021-03-10T05:07:20Z"}, "tags": [{"name": "1.13.5-r1"}]}
+]`))
+			default:
+				_, err = w.Write([]byte(`[]`))
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		}))
+		defer server.Close()
+
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingCreatedTime}
+		repo := strings.Replace(server.URL, "https://", "", 1) + "/aiab/kubectl"
+		version, err := checker.GetVersion(repo, "kubectl", "1\\.13\\..+", internal.WithVersionOrdering(ordering))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("1.13.5-r1"))
+	})
+
 	It("should correctly ensure version", func() {
 		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			defer GinkgoRecover()
@@ -3,11 +3,11 @@ package publicregistry
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"time"
 
 	"github.com/docker/distribution/reference"
 
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
@@ -32,7 +32,7 @@ func (c *checker) GetName() string {
 	return CheckerName
 }
 
-func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
+func (c *checker) GetVersion(repository, name, pattern string, opts ...internal.CheckOption) (string, error) {
 	if pattern == "" {
 		pattern = ".*"
 	}
@@ -42,9 +42,9 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 		return "", err
 	}
 
-	matcher, err := regexp.Compile(pattern)
+	selector, err := internal.NewVersionSelector(pattern, opts...)
 	if err != nil {
-		logger.Error(err, "invalid pattern", "pattern", pattern)
+		logger.Error(err, "invalid pattern or ordering", "pattern", pattern)
 		return "", err
 	}
 
@@ -58,9 +58,9 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 
 	switch domain {
 	case dockerioDomain:
-		tagCh, errCh = c.DockerHubFindTag(ctx, repo, matcher)
+		tagCh, errCh = c.DockerHubFindTag(ctx, repo, selector)
 	case quayioDomain:
-		tagCh, errCh = c.QuayIOFindTag(ctx, repo, matcher)
+		tagCh, errCh = c.QuayIOFindTag(ctx, repo, selector)
 	default:
 		return "", fmt.Errorf("repository not supported: %s", domain)
 	}
@@ -80,3 +80,9 @@ func (c *checker) EnsureVersion(repository, name, version string) error {
 	_, err := c.GetVersion(repository, name, version)
 	return err
 }
+
+// isFirstMatchLatest returns true if the first matched tag is the latest one,
+// tags of public registries are sorted by last updated time
+func isFirstMatchLatest(selector *internal.VersionSelector) bool {
+	return selector.IsDefaultOrdering() || selector.Strategy() == s2hv1.ImageOrderingCreatedTime
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
 	"time"
 
+	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
 	"github.com/agoda-com/samsahai/internal/util/http"
@@ -29,12 +29,15 @@ type dockerioJSONTag struct {
 }
 
 // DockerHubFindTag returns matched tag from docker.io (hub.docker.com)
-func (c *checker) DockerHubFindTag(ctx context.Context, repository string, matcher *regexp.Regexp) (<-chan string, <-chan error) {
+func (c *checker) DockerHubFindTag(ctx context.Context, repository string, selector *internal.VersionSelector) (
+	<-chan string, <-chan error) {
+
 	tagCh := make(chan string)
 	errCh := make(chan error)
 
 	go func() {
 		logger := s2hlog.Log.WithName(dockerioDomain)
+		var versions []internal.ImageVersion
 		reqURL := fmt.Sprintf("%s/%s/tags/?page=1", dockerioAPIURL, repository)
 		var data []byte
 		var err error
@@ -55,14 +58,22 @@ func (c *checker) DockerHubFindTag(ctx context.Context, repository string, match
 			}
 
 			for _, tag := range respJSON.Tags {
-				if matcher.MatchString(tag.Tag) {
+				if !selector.Match(tag.Tag) {
+					continue
+				}
+				if isFirstMatchLatest(selector) {
 					tagCh <- tag.Tag
 					return
 				}
+				versions = append(versions, internal.ImageVersion{Tag: tag.Tag, CreatedTime: tag.LastUpdated})
 			}
 
 			if respJSON.Next == "" {
-				logger.Error(fmt.Errorf("no pattern: '%s' match in '%s'", matcher, repository), "")
+				if tag, ok := selector.Latest(versions); ok {
+					tagCh <- tag
+					return
+				}
+				logger.Error(fmt.Errorf("no pattern: '%s' match in '%s'", selector, repository), "")
 				errCh <- s2herrors.ErrImageVersionNotFound
 				return
 			}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
 
+	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
 	"github.com/agoda-com/samsahai/internal/util/http"
@@ -28,12 +28,15 @@ type quayioJSONTag struct {
 }
 
 // QuayIOFindTag returns matched tag from quay.io
-func (c *checker) QuayIOFindTag(ctx context.Context, repo string, matcher *regexp.Regexp) (<-chan string, <-chan error) {
+func (c *checker) QuayIOFindTag(ctx context.Context, repo string, selector *internal.VersionSelector) (
+	<-chan string, <-chan error) {
+
 	tagCh := make(chan string)
 	errCh := make(chan error)
 
 	go func() {
 		logger := s2hlog.Log.WithName(quayioDomain)
+		var versions []internal.ImageVersion
 		page := 1
 
 		for {
@@ -54,14 +57,22 @@ func (c *checker) QuayIOFindTag(ctx context.Context, repo string, matcher *regex
 			}
 
 			for _, tag := range respJSON.Tags {
-				if matcher.MatchString(tag.Tag) {
+				if !selector.Match(tag.Tag) {
+					continue
+				}
+				if isFirstMatchLatest(selector) {
 					tagCh <- tag.Tag
 					return
 				}
+				versions = append(versions, internal.ImageVersion{Tag: tag.Tag})
 			}
 
 			if !respJSON.HasAdditional {
-				logger.Error(fmt.Errorf("no pattern: '%s' match in '%s'", matcher, repo), "")
+				if tag, ok := selector.Latest(versions); ok {
+					tagCh <- tag
+					return
+				}
+				logger.Error(fmt.Errorf("no pattern: '%s' match in '%s'", selector, repo), "")
 				errCh <- s2herrors.ErrImageVersionNotFound
 				return
 			}
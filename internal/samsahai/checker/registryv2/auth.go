@@ -0,0 +1,155 @@
+package registryv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+type tokenRes struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+func (c *checker) setCachedAuthorization(req *http.Request, host, scope string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if auth, ok := c.tokens[tokenKey(host, scope)]; ok {
+		req.Header.Set("Authorization", auth)
+	}
+}
+
+func (c *checker) cacheAuthorization(host, scope, auth string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens[tokenKey(host, scope)] = auth
+}
+
+// authorize sets authorization header of the request following the `WWW-Authenticate` challenge,
+// basic auth uses credential of the registry and bearer auth requests a token from the realm
+func (c *checker) authorize(ctx context.Context, req *http.Request, host, scope, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	cred, hasCred := c.credentials[host]
+
+	var auth string
+	switch scheme {
+	case "basic":
+		if !hasCred {
+			return fmt.Errorf("registry %s requires basic authentication but no credential found", host)
+		}
+		r := &http.Request{Header: http.Header{}}
+		r.SetBasicAuth(cred.username, cred.password)
+		auth = r.Header.Get("Authorization")
+	case "bearer":
+		if params["scope"] == "" {
+			params["scope"] = scope
+		}
+		token, err := c.fetchToken(ctx, params, cred, hasCred)
+		if err != nil {
+			return err
+		}
+		auth = "Bearer " + token
+	default:
+		return fmt.Errorf("unsupported authentication challenge from registry %s: %q", host, challenge)
+	}
+
+	c.cacheAuthorization(host, scope, auth)
+	req.Header.Set("Authorization", auth)
+
+	return nil
+}
+
+func (c *checker) fetchToken(ctx context.Context, params map[string]string, cred credential, hasCred bool) (
+	string, error) {
+
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("realm not found in authentication challenge")
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+
+	q := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	for _, s := range strings.Split(params["scope"], " ") {
+		if s != "" {
+			q.Add("scope", s)
+		}
+	}
+	tokenURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if hasCred {
+		req.SetBasicAuth(cred.username, cred.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		logger.Error(err, "GET token request failed", "realm", realm)
+		return "", err
+	}
+	defer closeBody(resp)
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot get token from %s, status code: %d", realm, resp.StatusCode)
+	}
+
+	var res tokenRes
+	if err := json.Unmarshal(data, &res); err != nil {
+		logger.Error(err, "cannot unmarshal token response")
+		return "", err
+	}
+
+	if res.Token != "" {
+		return res.Token, nil
+	}
+	if res.AccessToken != "" {
+		return res.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("empty token from %s", realm)
+}
+
+// parseChallenge returns lower-cased scheme and parameters of `WWW-Authenticate` header
+func parseChallenge(challenge string) (scheme string, params map[string]string) {
+	params = map[string]string{}
+
+	challenge = strings.TrimSpace(challenge)
+	parts := strings.SplitN(challenge, " ", 2)
+	scheme = strings.ToLower(parts[0])
+	if len(parts) < 2 {
+		return scheme, params
+	}
+
+	for _, m := range challengeParamRegex.FindAllStringSubmatch(parts[1], -1) {
+		params[strings.ToLower(m[1])] = m[2]
+	}
+
+	return scheme, params
+}
+
+func tokenKey(host, scope string) string {
+	return host + " " + scope
+}
@@ -0,0 +1,330 @@
+package registryv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/distribution/reference"
+
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+)
+
+var logger = s2hlog.Log.WithName(CheckerName)
+
+const (
+	CheckerName = "registryv2"
+
+	MaxRequestsTimeout   = 60 * time.Second
+	MaxOneRequestTimeout = 10 * time.Second
+
+	pageSize     = 100
+	maximumPages = 100
+
+	dockerHubDomain   = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+)
+
+// manifestMediaTypes are accepted media types when checking the existence of a tag
+var manifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v1+prettyjws",
+}
+
+var linkNextRegex = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+type credential struct {
+	username string
+	password string
+}
+
+type checker struct {
+	client             *http.Client
+	credentials        map[string]credential
+	insecureRegistries map[string]struct{}
+
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+type tagsRes struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// Option is a function to set checker options
+type Option func(*checker)
+
+// WithCredential sets username and password for the registry host, e.g. ghcr.io,
+// the registry is connected via plain http if the server starts with `http://`
+func WithCredential(server, username, password string) Option {
+	return func(c *checker) {
+		c.credentials[normalizeHost(server)] = credential{username: username, password: password}
+		if strings.HasPrefix(server, "http://") {
+			c.insecureRegistries[normalizeHost(server)] = struct{}{}
+		}
+	}
+}
+
+// WithInsecureRegistry connects to the registry host via plain http instead of https
+func WithInsecureRegistry(server string) Option {
+	return func(c *checker) {
+		c.insecureRegistries[normalizeHost(server)] = struct{}{}
+	}
+}
+
+// WithHTTPClient sets http client which is used to connect to registries
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *checker) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// New creates a new checker which gets versions from Docker Registry HTTP API V2,
+// e.g. registry:2, Nexus, GHCR or ECR
+func New(opts ...Option) internal.DesiredComponentChecker {
+	c := &checker{
+		client:             &http.Client{Timeout: MaxOneRequestTimeout},
+		credentials:        map[string]credential{},
+		insecureRegistries: map[string]struct{}{},
+		tokens:             map[string]string{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *checker) GetName() string {
+	return CheckerName
+}
+
+func (c *checker) GetVersion(repository, name, pattern string, opts ...internal.CheckOption) (string, error) {
+	if pattern == "" {
+		pattern = ".*"
+	}
+
+	host, repo, err := parseRepository(repository)
+	if err != nil {
+		return "", err
+	}
+
+	selector, err := internal.NewVersionSelector(pattern, opts...)
+	if err != nil {
+		logger.Error(err, "invalid pattern or ordering", "pattern", pattern)
+		return "", err
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), MaxRequestsTimeout)
+	defer cancelFunc()
+
+	tags, err := c.listTags(ctx, host, repo)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			logger.Error(s2herrors.ErrRequestTimeout, fmt.Sprintf("checking took more than %v", MaxRequestsTimeout))
+			return pattern, s2herrors.ErrRequestTimeout
+		}
+		return pattern, err
+	}
+
+	tag, ok := selector.LatestTag(tags)
+	if !ok {
+		return pattern, s2herrors.ErrImageVersionNotFound
+	}
+
+	return tag, nil
+}
+
+func (c *checker) EnsureVersion(repository, name, version string) error {
+	host, repo, err := parseRepository(repository)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), MaxRequestsTimeout)
+	defer cancelFunc()
+
+	reqURL := fmt.Sprintf("%s/v2/%s/manifests/%s", c.registryURL(host), repo, url.PathEscape(version))
+	resp, err := c.do(ctx, http.MethodHead, reqURL, repo, strings.Join(manifestMediaTypes, ", "))
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return s2herrors.ErrRequestTimeout
+		}
+		logger.Error(err, "HEAD request failed", "url", reqURL)
+		return err
+	}
+	defer closeBody(resp)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return s2herrors.ErrImageVersionNotFound
+	default:
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, reqURL)
+	}
+}
+
+// listTags returns all tags of the repository by following the pagination links
+func (c *checker) listTags(ctx context.Context, host, repo string) ([]string, error) {
+	reqURL := fmt.Sprintf("%s/v2/%s/tags/list?n=%d", c.registryURL(host), repo, pageSize)
+
+	var tags []string
+	for page := 1; reqURL != "" && page <= maximumPages; page++ {
+		resp, err := c.do(ctx, http.MethodGet, reqURL, repo, "application/json")
+		if err != nil {
+			logger.Error(err, "GET request failed", "url", reqURL)
+			return nil, err
+		}
+
+		data, err := ioutil.ReadAll(resp.Body)
+		closeBody(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			logger.Debug("repository not found", "url", reqURL)
+			return nil, s2herrors.ErrImageVersionNotFound
+		case resp.StatusCode != http.StatusOK:
+			return nil, fmt.Errorf("unexpected status code %d from %s: %s", resp.StatusCode, reqURL, string(data))
+		}
+
+		var respJSON tagsRes
+		if err := json.Unmarshal(data, &respJSON); err != nil {
+			logger.Error(err, "cannot unmarshal json response")
+			return nil, err
+		}
+		tags = append(tags, respJSON.Tags...)
+
+		reqURL, err = nextPageURL(reqURL, resp.Header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tags, nil
+}
+
+// do sends the request and retries once with the authorization from the registry challenge
+func (c *checker) do(ctx context.Context, method, reqURL, repo, accept string) (*http.Response, error) {
+	reqURI, err := url.Parse(reqURL)
+	if err != nil {
+		return nil, err
+	}
+	host := reqURI.Host
+	scope := fmt.Sprintf("repository:%s:pull", repo)
+
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", accept)
+		return req, nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	c.setCachedAuthorization(req, host, scope)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	closeBody(resp)
+
+	req, err = newRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.authorize(ctx, req, host, scope, challenge); err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(req)
+}
+
+// registryURL returns the base url of the registry host, insecure registries use plain http
+func (c *checker) registryURL(host string) string {
+	if _, ok := c.insecureRegistries[host]; ok {
+		return "http://" + host
+	}
+
+	return "https://" + host
+}
+
+// nextPageURL returns the absolute url of the next page from Link header
+func nextPageURL(currentURL, link string) (string, error) {
+	if link == "" {
+		return "", nil
+	}
+
+	matches := linkNextRegex.FindStringSubmatch(link)
+	if len(matches) < 2 {
+		return "", nil
+	}
+
+	base, err := url.Parse(currentURL)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := base.Parse(matches[1])
+	if err != nil {
+		return "", err
+	}
+
+	return next.String(), nil
+}
+
+// parseRepository returns registry host and repository path of the image repository
+func parseRepository(repository string) (host, repo string, err error) {
+	named, err := reference.ParseNormalizedNamed(repository)
+	if err != nil {
+		return "", "", err
+	}
+
+	return normalizeHost(reference.Domain(named)), reference.Path(named), nil
+}
+
+func normalizeHost(host string) string {
+	host = strings.TrimPrefix(strings.TrimPrefix(host, "https://"), "http://")
+	host = strings.TrimSuffix(host, "/")
+	if host == dockerHubDomain || host == "index.docker.io" {
+		return dockerHubRegistry
+	}
+	return host
+}
+
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
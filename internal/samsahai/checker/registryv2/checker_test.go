@@ -0,0 +1,236 @@
+package registryv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestRegistryV2Checker(t *testing.T) {
+	unittest.InitGinkgo(t, "Registry V2 Checker")
+}
+
+const (
+	mockRepo     = "samsahai/redis"
+	mockUsername = "samsahai"
+	mockPassword = "p@ssw0rd"
+	mockToken    = "abcdef"
+)
+
+// newMockRegistry creates a tls registry which returns tags 2 tags per page
+func newMockRegistry(tags []string, auth string) *httptest.Server {
+	return newMockRegistryServer(tags, auth, httptest.NewTLSServer)
+}
+
+func newMockRegistryServer(tags []string, auth string, newServer func(http.Handler) *httptest.Server) *httptest.Server {
+	var server *httptest.Server
+	server = newServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+
+		if r.URL.Path == "/token" {
+			username, password, ok := r.BasicAuth()
+			if !ok || username != mockUsername || password != mockPassword {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			Expect(r.URL.Query().Get("service")).To(Equal("mock-registry"))
+			Expect(r.URL.Query().Get("scope")).To(Equal("repository:" + mockRepo + ":pull"))
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": mockToken})
+			return
+		}
+
+		switch auth {
+		case "basic":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != mockUsername || password != mockPassword {
+				w.Header().Set("WWW-Authenticate", `Basic realm="mock-registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		case "bearer":
+			if r.Header.Get("Authorization") != "Bearer "+mockToken {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+					`Bearer realm="%s/token",service="mock-registry",scope="repository:%s:pull"`, server.URL, mockRepo))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+
+		switch {
+		case r.URL.Path == "/v2/"+mockRepo+"/tags/list":
+			last := r.URL.Query().Get("last")
+			start := 0
+			for i, tag := range tags {
+				if tag == last {
+					start = i + 1
+				}
+			}
+			end := start + 2
+			if end > len(tags) {
+				end = len(tags)
+			}
+			if end < len(tags) {
+				w.Header().Set("Link",
+					fmt.Sprintf(`</v2/%s/tags/list?n=2&last=%s>; rel="next"`, mockRepo, tags[end-1]))
+			}
+			_ = json.NewEncoder(w).Encode(tagsRes{Name: mockRepo, Tags: tags[start:end]})
+		case strings.HasPrefix(r.URL.Path, "/v2/"+mockRepo+"/manifests/"):
+			Expect(r.Method).To(Equal(http.MethodHead))
+			Expect(r.Header.Get("Accept")).To(ContainSubstring("application/vnd.oci.image.index.v1+json"))
+			version := strings.TrimPrefix(r.URL.Path, "/v2/"+mockRepo+"/manifests/")
+			for _, tag := range tags {
+				if tag == version {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server
+}
+
+var _ = Describe("Registry V2 Checker", func() {
+	g := NewWithT(GinkgoT())
+
+	var server *httptest.Server
+	tags := []string{"5.0.5", "5.0.10", "latest", "5.0.6", "5.0.7"}
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	newChecker := func(opts ...Option) internal.DesiredComponentChecker {
+		opts = append([]Option{WithHTTPClient(server.Client())}, opts...)
+		return New(opts...)
+	}
+
+	repository := func() string {
+		return strings.TrimPrefix(server.URL, "https://") + "/" + mockRepo
+	}
+
+	It("should returns 'registryv2' as name", func() {
+		g.Expect(New().GetName()).To(Equal("registryv2"))
+	})
+
+	It("should successfully get new version through all pages", func() {
+		server = newMockRegistry(tags, "")
+		checker := newChecker()
+
+		version, err := checker.GetVersion(repository(), "redis", `5\.0\.\d+`)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("5.0.10"))
+	})
+
+	It("should successfully get version with constraint", func() {
+		server = newMockRegistry([]string{"5.0.5", "5.1.0-rc.1", "6.0.0", "latest", "5.0.10"}, "")
+		checker := newChecker()
+
+		version, err := checker.GetVersion(repository(), "redis", "", internal.WithVersionConstraint(">=5 <6"))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("5.0.10"))
+
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingSemverWithPrerelease}
+		version, err = checker.GetVersion(repository(), "redis", "",
+			internal.WithVersionConstraint(">=5.0.0-0 <6.0.0-0"), internal.WithVersionOrdering(ordering))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("5.1.0-rc.1"))
+	})
+
+	It("should return image not found if no tags matched", func() {
+		server = newMockRegistry(tags, "")
+		checker := newChecker()
+
+		_, err := checker.GetVersion(repository(), "redis", `^6\.0`)
+		g.Expect(err).To(Equal(s2herrors.ErrImageVersionNotFound))
+	})
+
+	It("should return image not found if repository does not exist", func() {
+		server = newMockRegistry(tags, "")
+		checker := newChecker()
+
+		repo := strings.TrimPrefix(server.URL, "https://") + "/samsahai/unknown"
+		_, err := checker.GetVersion(repo, "unknown", "")
+		g.Expect(err).To(Equal(s2herrors.ErrImageVersionNotFound))
+	})
+
+	It("should successfully get version with bearer token challenge", func() {
+		server = newMockRegistry(tags, "bearer")
+		host := strings.TrimPrefix(server.URL, "https://")
+		checker := newChecker(WithCredential(host, mockUsername, mockPassword))
+
+		version, err := checker.GetVersion(repository(), "redis", `5\.0\.5.*`)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("5.0.5"))
+
+		g.Expect(checker.EnsureVersion(repository(), "redis", "latest")).To(Succeed())
+	})
+
+	It("should fail to get token without credential", func() {
+		server = newMockRegistry(tags, "bearer")
+		checker := newChecker()
+
+		_, err := checker.GetVersion(repository(), "redis", "")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should successfully get version with basic auth", func() {
+		server = newMockRegistry(tags, "basic")
+		host := strings.TrimPrefix(server.URL, "https://")
+		checker := newChecker(WithCredential("https://"+host+"/", mockUsername, mockPassword))
+
+		version, err := checker.GetVersion(repository(), "redis", "latest")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("latest"))
+	})
+
+	It("should successfully get version from insecure registry", func() {
+		server = newMockRegistryServer(tags, "basic", httptest.NewServer)
+		host := strings.TrimPrefix(server.URL, "http://")
+		repo := host + "/" + mockRepo
+
+		_, err := newChecker().GetVersion(repo, "redis", "latest")
+		g.Expect(err).To(HaveOccurred(), "https should be used by default")
+
+		checker := newChecker(WithCredential("http://"+host, mockUsername, mockPassword))
+		version, err := checker.GetVersion(repo, "redis", `5\.0\.\d+`)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("5.0.10"))
+
+		checker = newChecker(WithInsecureRegistry(host), WithCredential(host, mockUsername, mockPassword))
+		g.Expect(checker.EnsureVersion(repo, "redis", "5.0.6")).To(Succeed())
+	})
+
+	It("should successfully ensure version", func() {
+		server = newMockRegistry(tags, "")
+		checker := newChecker()
+
+		g.Expect(checker.EnsureVersion(repository(), "redis", "5.0.6")).To(Succeed())
+
+		err := checker.EnsureVersion(repository(), "redis", "5.0.8")
+		g.Expect(err).To(Equal(s2herrors.ErrImageVersionNotFound))
+	})
+
+	It("should correctly parse docker hub repository", func() {
+		host, repo, err := parseRepository("bitnami/redis")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(host).To(Equal(dockerHubRegistry))
+		g.Expect(repo).To(Equal("bitnami/redis"))
+	})
+})
@@ -135,6 +135,18 @@ var _ = Describe("", func() {
 		Expect(version).To(Equal("10.0.0-r0"))
 	})
 
+	It("should successfully get version with capture group ordering", func() {
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingCaptureGroup}
+		version, err := check.GetVersion("", "mariadb", "", s2h.WithVersionOrdering(ordering))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal("12.0.0-r0"))
+
+		ordering.CaptureGroups = []string{"team"}
+		version, err = check.GetVersion("bitnami/redis", "redis", "(?P<team>ex).*", s2h.WithVersionOrdering(ordering))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal("10.0.0-r0"))
+	})
+
 	Describe("Bad path", func() {
 		It("should error when repository not matched", func() {
 			_, err := check.GetVersion("mariadb", "mariadb", "")
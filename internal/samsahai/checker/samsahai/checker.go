@@ -2,7 +2,6 @@ package samsahai
 
 import (
 	"regexp"
-	"sort"
 
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,7 +30,7 @@ func (c *checker) GetName() string {
 	return CheckerName
 }
 
-func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
+func (c *checker) GetVersion(repository, name, pattern string, opts ...internal.CheckOption) (string, error) {
 	if pattern == "" {
 		pattern = ".*"
 	}
@@ -42,6 +41,17 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 		return "", err
 	}
 
+	// pattern is used to match team names, so the versions cannot be ordered by its capture groups
+	if o := internal.NewCheckOptions(opts...); o.Ordering != nil && o.Ordering.Strategy == s2hv1.ImageOrderingCaptureGroup {
+		opts = append(opts, internal.WithVersionOrdering(nil))
+	}
+
+	selector, err := internal.NewVersionSelector("", opts...)
+	if err != nil {
+		logger.Error(err, "invalid version ordering")
+		return "", err
+	}
+
 	configCtrl := c.samsahai.GetConfigController()
 
 	teamList, err := c.samsahai.GetTeams()
@@ -98,7 +108,8 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 		matchedTags = append(matchedTags, stableComp.Spec.Version)
 	}
 
-	if len(matchedTags) == 0 {
+	version, ok := selector.LatestTag(matchedTags)
+	if !ok {
 		kvs := []interface{}{
 			"name", name,
 			"pattern", pattern,
@@ -110,8 +121,7 @@ func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
 		return "", s2herrors.ErrImageVersionNotFound
 	}
 
-	sort.Sort(internal.SortableVersion(matchedTags))
-	return matchedTags[len(matchedTags)-1], nil
+	return version, nil
 }
 
 func (c *checker) EnsureVersion(repository, name, version string) error {
@@ -2,12 +2,15 @@ package samsahai
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/imdario/mergo"
@@ -36,6 +39,9 @@ import (
 	configctrl "github.com/agoda-com/samsahai/internal/config"
 	"github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	bitbucketReporter "github.com/agoda-com/samsahai/internal/reporter/bitbucket"
+	"github.com/agoda-com/samsahai/internal/reporter/email"
+	giteaReporter "github.com/agoda-com/samsahai/internal/reporter/gitea"
 	"github.com/agoda-com/samsahai/internal/reporter/github"
 	gitlabReporter "github.com/agoda-com/samsahai/internal/reporter/gitlab"
 	"github.com/agoda-com/samsahai/internal/reporter/msteams"
@@ -45,11 +51,14 @@ import (
 	"github.com/agoda-com/samsahai/internal/reporter/slack"
 	"github.com/agoda-com/samsahai/internal/samsahai/checker/harbor"
 	"github.com/agoda-com/samsahai/internal/samsahai/checker/publicregistry"
+	"github.com/agoda-com/samsahai/internal/samsahai/checker/registryv2"
 	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
 	"github.com/agoda-com/samsahai/internal/samsahai/k8sobject"
 	"github.com/agoda-com/samsahai/internal/samsahai/plugin"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/helm3"
+	"github.com/agoda-com/samsahai/internal/staging/deploy/manifest"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/mock"
+	restTestRunner "github.com/agoda-com/samsahai/internal/staging/testrunner/rest"
 	"github.com/agoda-com/samsahai/internal/util/cmd"
 	"github.com/agoda-com/samsahai/internal/util/random"
 	"github.com/agoda-com/samsahai/internal/util/stringutils"
@@ -91,6 +100,9 @@ type controller struct {
 	// checkersDisabled represents should controller load checkers or not.
 	checkersDisabled bool
 	checkers         map[string]internal.DesiredComponentChecker
+	// teamCheckers caches component checkers with credentials of teams
+	teamCheckers   map[string]teamComponentChecker
+	teamCheckersMu sync.Mutex
 	// pluginsDisabled represents should controller load plugins or not.
 	pluginsDisabled bool
 	plugins         map[string]internal.Plugin
@@ -101,6 +113,9 @@ type controller struct {
 
 	configs    internal.SamsahaiConfig
 	configCtrl internal.ConfigController
+
+	// pullRequestStateGetter overrides querying pull request states from the git providers
+	pullRequestStateGetter pullRequestStateGetter
 }
 
 // New returns Samsahai controller and assign itself to Manager for
@@ -127,6 +142,7 @@ func New(
 		internalStopper: stop,
 		queue:           queue,
 		checkers:        map[string]internal.DesiredComponentChecker{},
+		teamCheckers:    map[string]teamComponentChecker{},
 		plugins:         map[string]internal.Plugin{},
 		reporters:       map[string]internal.Reporter{},
 		configs:         configs,
@@ -208,7 +224,12 @@ func (c *controller) loadReporters() {
 		reportermock.New(),
 		rest.New(),
 		shell.New(),
+		email.New(),
 		github.New(github.WithGithubURL(c.configs.GithubURL), github.WithGithubToken(cred.GithubToken)),
+		bitbucketReporter.New(
+			bitbucketReporter.WithBitbucketURL(c.configs.BitbucketURL),
+			bitbucketReporter.WithBitbucketToken(cred.BitbucketToken)),
+		giteaReporter.New(giteaReporter.WithGiteaURL(c.configs.GiteaURL), giteaReporter.WithGiteaToken(cred.GiteaToken)),
 	}
 
 	if cred.SlackToken != "" {
@@ -243,6 +264,7 @@ func (c *controller) loadCheckers() {
 	checkers := []internal.DesiredComponentChecker{
 		publicregistry.New(),
 		harbor.New(),
+		registryv2.New(c.getRegistryV2Options()...),
 	}
 	for _, checker := range checkers {
 		if checker == nil {
@@ -652,6 +674,12 @@ func (c *controller) createEnvironmentObjects(teamComp *s2hv1.Team, namespace st
 		},
 	}
 
+	testRunnerKVs, err := c.getTestRunnerKeyValues(teamComp)
+	if err != nil {
+		return err
+	}
+	secretKVs = append(secretKVs, testRunnerKVs...)
+
 	k8sObjects := []client.Object{
 		k8sobject.GetService(c.scheme, teamComp, namespace),
 		k8sobject.GetServiceAccount(teamComp, namespace),
@@ -693,6 +721,46 @@ func (c *controller) createEnvironmentObjects(teamComp *s2hv1.Team, namespace st
 	return nil
 }
 
+// getTestRunnerKeyValues returns credentials of test runners from the team secret
+// which will be stored in the staging secret
+func (c *controller) getTestRunnerKeyValues(teamComp *s2hv1.Team) ([]k8sobject.KeyValue, error) {
+	cred := teamComp.Status.Used.Credential
+	if len(cred.RestHeaders) == 0 && cred.Jenkins == nil {
+		return nil, nil
+	}
+
+	team := teamComp.DeepCopy()
+	if err := c.LoadTeamSecret(team); err != nil {
+		return nil, errors.Wrapf(err, "cannot load secret of team %s", teamComp.Name)
+	}
+
+	kvs := make([]k8sobject.KeyValue, 0)
+	for _, header := range team.Status.Used.Credential.RestHeaders {
+		if header.Name == "" {
+			continue
+		}
+		kvs = append(kvs, k8sobject.KeyValue{
+			Key:   restTestRunner.HeaderSecretKeyPrefix + header.Name,
+			Value: intstr.FromString(header.Value),
+		})
+	}
+
+	if jenkinsCred := team.Status.Used.Credential.Jenkins; jenkinsCred != nil {
+		kvs = append(kvs,
+			k8sobject.KeyValue{
+				Key:   internal.VKJenkinsUsername,
+				Value: intstr.FromString(jenkinsCred.Username),
+			},
+			k8sobject.KeyValue{
+				Key:   internal.VKJenkinsAPIToken,
+				Value: intstr.FromString(jenkinsCred.Password),
+			},
+		)
+	}
+
+	return kvs, nil
+}
+
 func (c *controller) sendActiveEnvironmentDeleted(teamName, activeNs, deletedBy string) error {
 	configCtrl := c.GetConfigController()
 	deletedAt := metav1.Now().UTC().Format("2006-01-02T15:04:05")
@@ -953,12 +1021,57 @@ func (c *controller) LoadTeamSecret(teamComp *s2hv1.Team) error {
 		teamComp.Status.Used.Credential.Teamcity.Password = string(s2hSecret.Data[tcPassword.Key])
 	}
 
+	jenkinsCred := teamComp.Status.Used.Credential.Jenkins
+	if jenkinsCred != nil {
+		if jenkinsCred.UsernameRef != nil {
+			teamComp.Status.Used.Credential.Jenkins.Username = string(s2hSecret.Data[jenkinsCred.UsernameRef.Key])
+		}
+		if jenkinsCred.PasswordRef != nil {
+			teamComp.Status.Used.Credential.Jenkins.Password = string(s2hSecret.Data[jenkinsCred.PasswordRef.Key])
+		}
+	}
+
 	gitCred := teamComp.Status.Used.Credential.Github
 	if gitCred != nil {
 		gitToken := gitCred.TokenRef
 		teamComp.Status.Used.Credential.Github.Token = string(s2hSecret.Data[gitToken.Key])
 	}
 
+	bitbucketCred := teamComp.Status.Used.Credential.Bitbucket
+	if bitbucketCred != nil && bitbucketCred.TokenRef != nil {
+		teamComp.Status.Used.Credential.Bitbucket.Token = string(s2hSecret.Data[bitbucketCred.TokenRef.Key])
+	}
+
+	giteaCred := teamComp.Status.Used.Credential.Gitea
+	if giteaCred != nil && giteaCred.TokenRef != nil {
+		teamComp.Status.Used.Credential.Gitea.Token = string(s2hSecret.Data[giteaCred.TokenRef.Key])
+	}
+
+	for i, regCred := range teamComp.Status.Used.Credential.Registries {
+		if regCred.UsernameRef != nil {
+			teamComp.Status.Used.Credential.Registries[i].Username = string(s2hSecret.Data[regCred.UsernameRef.Key])
+		}
+		if regCred.PasswordRef != nil {
+			teamComp.Status.Used.Credential.Registries[i].Password = string(s2hSecret.Data[regCred.PasswordRef.Key])
+		}
+	}
+
+	for i, header := range teamComp.Status.Used.Credential.RestHeaders {
+		if header.ValueRef != nil {
+			teamComp.Status.Used.Credential.RestHeaders[i].Value = string(s2hSecret.Data[header.ValueRef.Key])
+		}
+	}
+
+	apiToken := teamComp.Status.Used.Credential.APIToken
+	if apiToken != nil && apiToken.TokenRef != nil {
+		teamComp.Status.Used.Credential.APIToken.Token = string(s2hSecret.Data[apiToken.TokenRef.Key])
+	}
+
+	webhookSecret := teamComp.Status.Used.Credential.WebhookSecret
+	if webhookSecret != nil && webhookSecret.TokenRef != nil {
+		teamComp.Status.Used.Credential.WebhookSecret.Token = string(s2hSecret.Data[webhookSecret.TokenRef.Key])
+	}
+
 	gitlabToken := teamComp.Status.Used.Credential.Gitlab
 	if gitlabToken != nil {
 		ref := gitlabToken.TokenRef
@@ -1174,6 +1287,8 @@ func (c *controller) GetActivePromotionDeployEngine(teamName, ns string) interna
 	switch e {
 	case helm3.EngineName:
 		engine = helm3.New(ns, false)
+	case manifest.EngineName:
+		engine = manifest.New(ns, c.client)
 	default:
 		engine = mock.New()
 	}
@@ -1189,6 +1304,80 @@ func (c *controller) getComponentChecker(source string) (internal.DesiredCompone
 	return checker, nil
 }
 
+// teamComponentChecker is a component checker which is created with credentials of the team
+type teamComponentChecker struct {
+	credentialHash string
+	checker        internal.DesiredComponentChecker
+}
+
+// getTeamComponentChecker returns the component checker with credentials of the team,
+// the checker is cached until the credentials of the team are changed
+func (c *controller) getTeamComponentChecker(teamName, source string) (internal.DesiredComponentChecker, error) {
+	checker, err := c.getComponentChecker(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if source != registryv2.CheckerName || teamName == "" {
+		return checker, nil
+	}
+
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, errors.Wrapf(err, "cannot get team %s", teamName)
+	}
+
+	if err := c.LoadTeamSecret(teamComp); err != nil {
+		return nil, err
+	}
+
+	regCreds := teamComp.Status.Used.Credential.Registries
+	if len(regCreds) == 0 {
+		return checker, nil
+	}
+
+	credHash := hashRegistryCredentials(regCreds)
+
+	c.teamCheckersMu.Lock()
+	defer c.teamCheckersMu.Unlock()
+
+	if teamChecker, ok := c.teamCheckers[teamName]; ok && teamChecker.credentialHash == credHash {
+		return teamChecker.checker, nil
+	}
+
+	opts := c.getRegistryV2Options()
+	for _, regCred := range regCreds {
+		opts = append(opts, registryv2.WithCredential(regCred.Server, regCred.Username, regCred.Password))
+		if regCred.Insecure {
+			opts = append(opts, registryv2.WithInsecureRegistry(regCred.Server))
+		}
+	}
+
+	checker = registryv2.New(opts...)
+	c.teamCheckers[teamName] = teamComponentChecker{credentialHash: credHash, checker: checker}
+
+	return checker, nil
+}
+
+func (c *controller) getRegistryV2Options() []registryv2.Option {
+	opts := make([]registryv2.Option, 0, len(c.configs.InsecureRegistries))
+	for _, server := range c.configs.InsecureRegistries {
+		opts = append(opts, registryv2.WithInsecureRegistry(server))
+	}
+
+	return opts
+}
+
+func hashRegistryCredentials(regCreds []s2hv1.RegistryCredential) string {
+	h := sha256.New()
+	for _, regCred := range regCreds {
+		_, _ = fmt.Fprintf(h, "%s\x00%t\x00%s\x00%s\x00", regCred.Server, regCred.Insecure, regCred.Username,
+			regCred.Password)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func (c *controller) notifyComponentChanged(teamName string) error {
 	configCtrl := c.GetConfigController()
 	comps, err := configCtrl.GetComponents(teamName)
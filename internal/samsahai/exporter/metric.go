@@ -2,6 +2,7 @@ package exporter
 
 import (
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,6 +28,15 @@ const (
 	queueStateFinished  QueueMetricState           = "finished"
 )
 
+type QueueMetricResult string
+
+const (
+	queueResultSuccess      QueueMetricResult = "success"
+	queueResultFailure      QueueMetricResult = "failure"
+	queueResultReverify     QueueMetricResult = "reverify"
+	queueResultImageMissing QueueMetricResult = "image_missing"
+)
+
 var logger = s2hlog.S2HLog.WithName("exporter")
 
 var TeamMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
@@ -49,11 +59,71 @@ var ActivePromotionMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Help: "Get values from samsahai active promotion",
 }, []string{"teamName", "state"})
 
+var QueueDeployDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "samsahai_queue_deploy_duration_seconds",
+	Help:    "Duration of deploying the queue environment",
+	Buckets: prometheus.ExponentialBuckets(30, 2, 10),
+}, []string{"teamName", "component"})
+
+var QueueTestDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "samsahai_queue_test_duration_seconds",
+	Help:    "Duration of testing the queue environment",
+	Buckets: prometheus.ExponentialBuckets(30, 2, 10),
+}, []string{"teamName", "component"})
+
+var QueueTotalDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "samsahai_queue_total_duration_seconds",
+	Help:    "Duration since the queue has been created until the result is collected",
+	Buckets: prometheus.ExponentialBuckets(30, 2, 12),
+}, []string{"teamName", "component"})
+
+var QueueResultMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "samsahai_queue_result_total",
+	Help: "Number of finished queues by the result",
+}, []string{"teamName", "component", "result"})
+
+var QueueDeploymentIssueMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "samsahai_queue_deployment_issue_total",
+	Help: "Number of deployment issues found in finished queues by the issue type",
+}, []string{"teamName", "component", "issueType"})
+
+var ActivePromotionDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "samsahai_active_promotion_duration_seconds",
+	Help:    "Duration of the active promotion by the result",
+	Buckets: prometheus.ExponentialBuckets(60, 2, 10),
+}, []string{"teamName", "result"})
+
+var ActivePromotionResultMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "samsahai_active_promotion_result_total",
+	Help: "Number of finished active promotions by the result",
+}, []string{"teamName", "result"})
+
+var PullRequestQueueWaitDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "samsahai_pull_request_queue_wait_duration_seconds",
+	Help:    "Duration of the pull request queue waiting before deploying",
+	Buckets: prometheus.ExponentialBuckets(10, 2, 12),
+}, []string{"teamName", "bundle"})
+
+// queueMetricLabels stores label values of the latest samsahai_queue series of each queue,
+// the previous series are deleted to avoid stale series of old versions and states
+var queueMetricLabels = struct {
+	sync.Mutex
+	labels map[string][][]string // map[namespace/queue name]label values
+}{labels: make(map[string][][]string)}
+
 func RegisterMetrics() {
 	metrics.Registry.MustRegister(TeamMetric)
 	metrics.Registry.MustRegister(QueueMetric)
 	metrics.Registry.MustRegister(ActivePromotionMetric)
 	metrics.Registry.MustRegister(HealthStatusMetric)
+	metrics.Registry.MustRegister(QueueDeployDurationMetric)
+	metrics.Registry.MustRegister(QueueTestDurationMetric)
+	metrics.Registry.MustRegister(QueueTotalDurationMetric)
+	metrics.Registry.MustRegister(QueueResultMetric)
+	metrics.Registry.MustRegister(QueueDeploymentIssueMetric)
+	metrics.Registry.MustRegister(ActivePromotionDurationMetric)
+	metrics.Registry.MustRegister(ActivePromotionResultMetric)
+	metrics.Registry.MustRegister(PullRequestQueueWaitDurationMetric)
 }
 
 func SetTeamNameMetric(teamList *s2hv1.TeamList) {
@@ -88,16 +158,108 @@ func SetQueueMetric(queue *s2hv1.Queue) {
 		queueState = queueStateFinished
 	}
 
+	queueMetricLabels.Lock()
+	defer queueMetricLabels.Unlock()
+
+	key := queue.Namespace + "/" + queue.Name
+	deleteQueueMetricSeries(key)
+
+	labelsList := make([][]string, 0, len(queue.Spec.Components))
 	for _, qComp := range queue.Spec.Components {
-		QueueMetric.WithLabelValues(
+		labelValues := []string{
 			queue.Spec.TeamName,
 			queue.Name,
 			qComp.Name,
 			qComp.Version,
 			string(queueState),
 			strconv.Itoa(queue.Spec.NoOfOrder),
-			strconv.Itoa(queue.Status.NoOfProcessed)).Set(float64(time.Now().Unix()))
+			strconv.Itoa(queue.Status.NoOfProcessed),
+		}
+		QueueMetric.WithLabelValues(labelValues...).Set(float64(time.Now().Unix()))
+		labelsList = append(labelsList, labelValues)
+	}
+	queueMetricLabels.labels[key] = labelsList
+}
+
+// DeleteQueueMetric deletes samsahai_queue series of the finished queue
+func DeleteQueueMetric(namespace, queueName string) {
+	queueMetricLabels.Lock()
+	defer queueMetricLabels.Unlock()
+
+	deleteQueueMetricSeries(namespace + "/" + queueName)
+}
+
+func deleteQueueMetricSeries(key string) {
+	for _, labelValues := range queueMetricLabels.labels[key] {
+		QueueMetric.DeleteLabelValues(labelValues...)
 	}
+	delete(queueMetricLabels.labels, key)
+}
+
+// ObserveQueueResult observes durations, result and deployment issues of the collected queue
+func ObserveQueueResult(queue *s2hv1.Queue) {
+	teamName, compName := queue.Spec.TeamName, queue.Name
+	status := queue.Status
+
+	if startDeployTime := status.StartDeployTime; startDeployTime != nil {
+		if cond := status.GetCondition(s2hv1.QueueDeployed); cond != nil {
+			QueueDeployDurationMetric.WithLabelValues(teamName, compName).
+				Observe(cond.LastTransitionTime.Sub(startDeployTime.Time).Seconds())
+		}
+	}
+
+	if startTestingTime := status.StartTestingTime; startTestingTime != nil {
+		if cond := status.GetCondition(s2hv1.QueueTested); cond != nil {
+			QueueTestDurationMetric.WithLabelValues(teamName, compName).
+				Observe(cond.LastTransitionTime.Sub(startTestingTime.Time).Seconds())
+		}
+	}
+
+	if status.CreatedAt != nil {
+		QueueTotalDurationMetric.WithLabelValues(teamName, compName).
+			Observe(time.Since(status.CreatedAt.Time).Seconds())
+	}
+
+	QueueResultMetric.WithLabelValues(teamName, compName, string(getQueueMetricResult(queue))).Inc()
+
+	for _, issue := range status.DeploymentIssues {
+		QueueDeploymentIssueMetric.WithLabelValues(teamName, compName, string(issue.IssueType)).Inc()
+	}
+}
+
+func getQueueMetricResult(queue *s2hv1.Queue) QueueMetricResult {
+	switch {
+	case len(queue.Status.ImageMissingList) > 0:
+		return queueResultImageMissing
+	case queue.IsReverify():
+		return queueResultReverify
+	case queue.IsDeploySuccess() && queue.IsTestSuccess():
+		return queueResultSuccess
+	default:
+		return queueResultFailure
+	}
+}
+
+// ObservePullRequestQueueWaitDuration observes duration of the pull request queue waiting before deploying
+func ObservePullRequestQueueWaitDuration(teamName string, prQueue *s2hv1.PullRequestQueue) {
+	deploymentQueue := prQueue.Status.DeploymentQueue
+	if prQueue.Status.CreatedAt == nil || deploymentQueue == nil || deploymentQueue.Status.CreatedAt == nil {
+		return
+	}
+
+	PullRequestQueueWaitDurationMetric.WithLabelValues(teamName, prQueue.Spec.BundleName).
+		Observe(deploymentQueue.Status.CreatedAt.Sub(prQueue.Status.CreatedAt.Time).Seconds())
+}
+
+// ObserveActivePromotionResult observes duration and result of the finished active promotion
+func ObserveActivePromotionResult(atpComp *s2hv1.ActivePromotion) {
+	result := string(atpComp.Status.Result)
+	if atpComp.Status.StartedAt != nil {
+		ActivePromotionDurationMetric.WithLabelValues(atpComp.Name, result).
+			Observe(time.Since(atpComp.Status.StartedAt.Time).Seconds())
+	}
+
+	ActivePromotionResultMetric.WithLabelValues(atpComp.Name, result).Inc()
 }
 
 func SetActivePromotionMetric(atpComp *s2hv1.ActivePromotion) {
@@ -126,7 +288,8 @@ func SetActivePromotionMetric(atpComp *s2hv1.ActivePromotion) {
 					atpComp.Name,
 					string(state)).Set(val)
 			}
-		case s2hv1.ActivePromotionActiveEnvironment, s2hv1.ActivePromotionDemoting:
+		case s2hv1.ActivePromotionActiveEnvironment, s2hv1.ActivePromotionShiftingTraffic,
+			s2hv1.ActivePromotionDemoting:
 			atpStateList[statePromoting] = float64(time.Now().Unix())
 			for state, val := range atpStateList {
 				ActivePromotionMetric.WithLabelValues(
@@ -12,6 +12,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -183,6 +184,63 @@ var _ = Describe("Samsahai Exporter", func() {
 	}, timeout)
 })
 
+var _ = Describe("Samsahai Exporter queue results", func() {
+	g := NewWithT(GinkgoT())
+	namespace := "default"
+
+	It("should delete stale queue series", func() {
+		queue := &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: namespace},
+			Spec: s2hv1.QueueSpec{
+				TeamName:   "testQTeamName2",
+				Components: s2hv1.QueueComponents{{Name: "stale", Version: "1.0.0"}},
+			},
+			Status: s2hv1.QueueStatus{State: s2hv1.Waiting},
+		}
+
+		SetQueueMetric(queue)
+		queue.Spec.Components[0].Version = "1.0.1"
+		queue.Status.State = s2hv1.Creating
+		SetQueueMetric(queue)
+
+		g.Expect(QueueMetric.DeleteLabelValues("testQTeamName2", "stale", "stale", "1.0.0", "waiting",
+			"0", "0")).To(BeFalse())
+		g.Expect(testutil.ToFloat64(QueueMetric.WithLabelValues("testQTeamName2", "stale", "stale", "1.0.1",
+			"deploying", "0", "0"))).NotTo(BeZero())
+
+		DeleteQueueMetric(namespace, "stale")
+		g.Expect(QueueMetric.DeleteLabelValues("testQTeamName2", "stale", "stale", "1.0.1", "deploying",
+			"0", "0")).To(BeFalse())
+	})
+
+	It("should observe queue result correctly", func() {
+		startDeployTime := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+		deployedTime := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+		queue := &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "result", Namespace: namespace},
+			Spec:       s2hv1.QueueSpec{TeamName: "testQTeamName3"},
+			Status: s2hv1.QueueStatus{
+				CreatedAt:       &startDeployTime,
+				StartDeployTime: &startDeployTime,
+				Conditions: []s2hv1.QueueCondition{
+					{Type: s2hv1.QueueDeployed, Status: "False", LastTransitionTime: deployedTime},
+				},
+				DeploymentIssues: []s2hv1.DeploymentIssue{
+					{IssueType: s2hv1.DeploymentIssueCrashLoopBackOff},
+				},
+			},
+		}
+
+		ObserveQueueResult(queue)
+
+		g.Expect(testutil.ToFloat64(QueueResultMetric.WithLabelValues("testQTeamName3", "result",
+			string(queueResultFailure)))).To(Equal(float64(1)))
+		g.Expect(testutil.ToFloat64(QueueDeploymentIssueMetric.WithLabelValues("testQTeamName3", "result",
+			string(s2hv1.DeploymentIssueCrashLoopBackOff)))).To(Equal(float64(1)))
+		g.Expect(testutil.CollectAndCount(QueueDeployDurationMetric)).To(BeNumerically(">=", 1))
+	})
+})
+
 type mockConfigCtrl struct{}
 
 func newMockConfigCtrl() internal.ConfigController {
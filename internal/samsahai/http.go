@@ -5,10 +5,12 @@ import (
 	"net/http"
 
 	s2h "github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/tracing"
 )
 
 func (c *controller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ctx := context.WithValue(r.Context(), s2h.HTTPHeader(s2h.SamsahaiAuthHeader), r.Header.Get(s2h.SamsahaiAuthHeader))
+	ctx := context.WithValue(tracing.ExtractContext(r), s2h.HTTPHeader(s2h.SamsahaiAuthHeader),
+		r.Header.Get(s2h.SamsahaiAuthHeader))
 	r = r.WithContext(ctx)
 	c.rpcHandler.ServeHTTP(w, r)
 }
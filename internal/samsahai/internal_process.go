@@ -31,6 +31,14 @@ type updateHealth struct {
 type exportMetric struct {
 }
 
+type checkPullRequestState struct {
+}
+
+// checkActivePromotionSchedule defines the last time active promotion schedules were checked
+type checkActivePromotionSchedule struct {
+	LastCheckedAt time.Time
+}
+
 // updateTeamDesiredComponent defines which component of which team to be checked and updated
 type updateTeamDesiredComponent struct {
 	TeamName        string
@@ -55,6 +63,8 @@ func (c *controller) Start(stop <-chan struct{}) {
 
 	c.queue.Add(updateHealth{})
 	c.queue.AddAfter(exportMetric{}, 30*time.Second)
+	c.queue.AddAfter(checkPullRequestState{}, c.getPullRequestStateCheckInterval())
+	c.queue.Add(checkActivePromotionSchedule{})
 
 	<-stop
 
@@ -93,6 +103,10 @@ func (c *controller) process() bool {
 		err = c.updateHealthMetric()
 	case exportMetric:
 		err = c.exportTeamMetric()
+	case checkPullRequestState:
+		err = c.checkPullRequestState()
+	case checkActivePromotionSchedule:
+		err = c.checkActivePromotionSchedule(v)
 	default:
 		c.queue.Forget(obj)
 		return true
@@ -184,7 +198,7 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	var err error
 
 	// run checker to get desired version
-	checker, err := c.getComponentChecker(updateInfo.ComponentSource)
+	checker, err := c.getTeamComponentChecker(updateInfo.TeamName, updateInfo.ComponentSource)
 	if err != nil {
 		logger.Error(err, "cannot get component checker",
 			"team", updateInfo.TeamName, "source", updateInfo.ComponentSource)
@@ -204,7 +218,8 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	compBundle := updateInfo.ComponentBundle
 
 	// TODO: do caching for better performance
-	version, vErr := checker.GetVersion(compRepository, compName, checkPattern)
+	version, vErr := checker.GetVersion(compRepository, compName, checkPattern,
+		internal.WithComponentImage(updateInfo.ComponentImage))
 	switch {
 	case vErr == nil:
 	case errors.IsImageNotFound(vErr) || errors.IsErrRequestTimeout(vErr):
@@ -214,7 +229,7 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	default:
 		logger.Error(vErr, "error while run checker.getversion",
 			"team", updateInfo.TeamName, "name", compName, "repository", compRepository,
-			"version pattern", checkPattern)
+			"version pattern", checkPattern, "version constraint", updateInfo.ComponentImage.Constraint)
 		return vErr
 	}
 
@@ -456,6 +456,25 @@ func GetClusterRole(teamComp *s2hv1.Team, namespace string) client.Object {
 				},
 				Verbs: []string{"*"},
 			},
+			// pull request preview urls
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"services",
+				},
+				Verbs: []string{"get", "list"},
+			},
+			{
+				APIGroups: []string{
+					"networking.k8s.io",
+				},
+				Resources: []string{
+					"ingresses",
+				},
+				Verbs: []string{"get", "list"},
+			},
 			{
 				APIGroups: []string{
 					"policy",
@@ -30,6 +30,8 @@ get_version() {
     repository=$1
     name=$2
     pattern=$3
+    # version constraint and ordering are passed as environment variables,
+    # S2H_VERSION_CONSTRAINT, S2H_VERSION_ORDERING and S2H_VERSION_ORDERING_GROUPS
 
     if [[ "$name" == "not-found" ]]; then
         echo ${errNoDesiredComponentVersion} >&2
@@ -23,6 +23,11 @@ const (
 	CmdGetVersionArg           = "get-version"
 	CmdEnsureVersionArg        = "ensure-version"
 	CmdGetComponentArg         = "get-component"
+
+	// environment variables which are passed to `get-version` command
+	EnvVersionConstraint     = "S2H_VERSION_CONSTRAINT"
+	EnvVersionOrdering       = "S2H_VERSION_ORDERING"
+	EnvVersionOrderingGroups = "S2H_VERSION_ORDERING_GROUPS"
 )
 
 var logger = s2hlog.Log.WithName("plugin")
@@ -67,11 +72,20 @@ func (p *plugin) GetName() string {
 	return p.name
 }
 
-func (p *plugin) GetVersion(repository, name, pattern string) (string, error) {
+// GetVersion returns version from the plugin,
+// the constraint and ordering are passed to the plugin as environment variables
+// and the returned version is verified against the constraint
+func (p *plugin) GetVersion(repository, name, pattern string, opts ...internal.CheckOption) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), p.getVersionTimeout)
 	defer cancel()
 
-	output, err := p.executeCmd(ctx, CmdGetVersionArg, repository, name, pattern)
+	// plugin handles the pattern and ordering by itself, the selector only verifies the constraint
+	selector, err := newConstraintSelector(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := p.executeCmdWithEnv(ctx, versionEnv(opts...), CmdGetVersionArg, repository, name, pattern)
 	if err != nil {
 		switch err.Error() {
 		case errors.ErrNoDesiredComponentVersion.Error():
@@ -82,6 +96,12 @@ func (p *plugin) GetVersion(repository, name, pattern string) (string, error) {
 			return output, err
 		}
 	}
+
+	if internal.NewCheckOptions(opts...).Constraint != "" && !selector.Match(output) {
+		p.logger.Warn("version does not satisfy the constraint", "version", output)
+		return output, errors.ErrImageVersionNotFound
+	}
+
 	return output, nil
 }
 
@@ -120,14 +140,18 @@ func (p *plugin) GetComponentName(name string) string {
 }
 
 func (p *plugin) executeCmd(ctx context.Context, commandAndArgs ...string) (string, error) {
+	return p.executeCmdWithEnv(ctx, nil, commandAndArgs...)
+}
+
+func (p *plugin) executeCmdWithEnv(ctx context.Context, env []string, commandAndArgs ...string) (string, error) {
 	outputCh := make(chan string)
 	errCh := make(chan error)
 
 	go func() {
-		data, err := cmd.ExecuteCommand(ctx, p.cwd, &s2hv1.CommandAndArgs{
+		data, err := cmd.ExecuteCommandWithEnv(ctx, p.cwd, &s2hv1.CommandAndArgs{
 			Command: []string{p.path},
 			Args:    commandAndArgs,
-		})
+		}, env)
 		if err != nil {
 			switch err := err.(type) {
 			case *exec.ExitError:
@@ -168,3 +192,37 @@ func (p *plugin) verify() (string, error) {
 
 	return strings.TrimRight(string(data), "\n"), nil
 }
+
+// newConstraintSelector creates a version selector which verifies only the constraint,
+// pre-release versions are rejected as same as the semver ordering if the ordering is default or semver
+func newConstraintSelector(opts ...internal.CheckOption) (*internal.VersionSelector, error) {
+	o := internal.NewCheckOptions(opts...)
+
+	ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingSemverWithPrerelease}
+	if o.Ordering == nil || o.Ordering.Strategy == s2hv1.ImageOrderingDefault ||
+		o.Ordering.Strategy == s2hv1.ImageOrderingSemver {
+		ordering.Strategy = s2hv1.ImageOrderingSemver
+	}
+
+	return internal.NewVersionSelector("",
+		internal.WithVersionConstraint(o.Constraint),
+		internal.WithVersionOrdering(ordering))
+}
+
+// versionEnv returns environment variables of version constraint and ordering
+func versionEnv(opts ...internal.CheckOption) []string {
+	o := internal.NewCheckOptions(opts...)
+
+	env := make([]string, 0)
+	if o.Constraint != "" {
+		env = append(env, EnvVersionConstraint+"="+o.Constraint)
+	}
+	if o.Ordering != nil {
+		env = append(env, EnvVersionOrdering+"="+string(o.Ordering.Strategy))
+		if len(o.Ordering.CaptureGroups) > 0 {
+			env = append(env, EnvVersionOrderingGroups+"="+strings.Join(o.Ordering.CaptureGroups, ","))
+		}
+	}
+
+	return env
+}
@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
 	"github.com/agoda-com/samsahai/internal/errors"
 	"github.com/agoda-com/samsahai/internal/util/unittest"
 )
@@ -57,6 +59,39 @@ var _ = Describe("Plugin", func() {
 			g.Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should verify version with constraint", func() {
+			plugin, err := New("./example-shell.sh")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(plugin).NotTo(BeNil())
+
+			version, err := plugin.GetVersion("repo", "example", "0\\.2\\..*",
+				internal.WithVersionConstraint(">=0.2 <0.3"))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(version).To(Equal("0.2.0"))
+
+			_, err = plugin.GetVersion("repo", "example", "", internal.WithVersionConstraint("<0.3"))
+			g.Expect(err).To(Equal(errors.ErrImageVersionNotFound))
+		})
+
+		It("should verify version with constraint and capture group ordering", func() {
+			plugin, err := New("./example-shell.sh")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(plugin).NotTo(BeNil())
+
+			ordering := &s2hv1.ImageOrdering{
+				Strategy:      s2hv1.ImageOrderingCaptureGroup,
+				CaptureGroups: []string{"1"},
+			}
+			version, err := plugin.GetVersion("repo", "example", `0\.(2)\..*`,
+				internal.WithVersionConstraint(">=0.2 <0.3"), internal.WithVersionOrdering(ordering))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(version).To(Equal("0.2.0"))
+
+			_, err = plugin.GetVersion("repo", "example", "",
+				internal.WithVersionConstraint("<0.3"), internal.WithVersionOrdering(ordering))
+			g.Expect(err).To(Equal(errors.ErrImageVersionNotFound))
+		})
+
 		Specify("Timeout component", func() {
 			plugin, err := NewWithTimeout("./example-shell.sh", 1*time.Second, 1*time.Second, 1*time.Second)
 			g.Expect(err).NotTo(HaveOccurred())
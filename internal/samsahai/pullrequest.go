@@ -2,6 +2,9 @@ package samsahai
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,6 +13,8 @@ import (
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
 	"github.com/agoda-com/samsahai/internal/errors"
+	githubutil "github.com/agoda-com/samsahai/internal/util/github"
+	gitlabutil "github.com/agoda-com/samsahai/internal/util/gitlab"
 )
 
 // TriggerPullRequestDeployment creates/updates PullRequestTrigger crd object
@@ -76,6 +81,209 @@ func (c *controller) TriggerPullRequestDeployment(teamName, bundleName, prNumber
 	return nil
 }
 
+// DeletePullRequestDeployment deletes PullRequestTrigger and PullRequestQueue of the pull request,
+// the pull request environment will be destroyed by pull request queue controller
+func (c *controller) DeletePullRequestDeployment(teamName, bundleName, prNumber string) error {
+	ctx := context.TODO()
+
+	teamComp := s2hv1.Team{}
+	if err := c.GetTeam(teamName, &teamComp); err != nil {
+		return err
+	}
+
+	namespace := teamComp.Status.Namespace.Staging
+	name := internal.GenPullRequestBundleName(bundleName, prNumber)
+
+	prTrigger := &s2hv1.PullRequestTrigger{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: namespace}}
+	if err := c.client.Delete(ctx, prTrigger); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	prQueue := &s2hv1.PullRequestQueue{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: namespace}}
+	if err := c.client.Delete(ctx, prQueue); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	logger.Info("pull request deployment has been deleted", "team", teamName,
+		"bundle", bundleName, "prNumber", prNumber)
+
+	return nil
+}
+
+// RedeployPullRequestDeployment re-triggers the pull request deployment with the overridden component versions,
+// the commit SHA of the latest pull request queue is used if not defined
+func (c *controller) RedeployPullRequestDeployment(teamName, bundleName, prNumber, commitSHA string,
+	bundleCompsTag map[string]string) error {
+
+	if commitSHA == "" {
+		prQueue, err := c.getPullRequestQueue(teamName, bundleName, prNumber)
+		if err != nil {
+			return err
+		}
+
+		commitSHA = prQueue.Spec.UpcomingCommitSHA
+		if commitSHA == "" {
+			commitSHA = prQueue.Spec.CommitSHA
+		}
+	}
+
+	return c.TriggerPullRequestDeployment(teamName, bundleName, prNumber, commitSHA, bundleCompsTag, nil, nil)
+}
+
+// RetestPullRequestQueue requests the pull request queue to re-run the testing
+// on the pull request environment which is waiting to be destroyed
+func (c *controller) RetestPullRequestQueue(teamName, bundleName, prNumber, requestedBy string) error {
+	prQueue, err := c.getPullRequestQueue(teamName, bundleName, prNumber)
+	if err != nil {
+		return err
+	}
+
+	if prQueue.Status.State != s2hv1.PullRequestQueueEnvDestroying || prQueue.Spec.RetestRequest != nil {
+		return errors.ErrPullRequestQueueCannotBeRetested
+	}
+
+	if prQueue.Status.IsConditionTrue(s2hv1.PullRequestQueueCondEnvDestroyed) {
+		return errors.ErrPullRequestEnvironmentDestroyed
+	}
+
+	logger.Info("retest pull request queue", "team", teamName, "bundle", bundleName,
+		"prNumber", prNumber, "by", requestedBy)
+
+	prQueue.Spec.RetestRequest = &s2hv1.PullRequestQueueRetestRequest{
+		RequestedBy: requestedBy,
+		RequestedAt: v1.Now(),
+	}
+
+	return c.client.Update(context.TODO(), prQueue)
+}
+
+// ExtendPullRequestEnvironment postpones the destroyed time of the pull request environment by the duration,
+// the teardown duration is raised to the duration instead if the pull request queue is still running.
+// The environment is never extended longer than the max duration from now.
+func (c *controller) ExtendPullRequestEnvironment(teamName, bundleName, prNumber string,
+	duration, maxDuration time.Duration) (*s2hv1.PullRequestQueue, error) {
+
+	prQueue, err := c.getPullRequestQueue(teamName, bundleName, prNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch {
+	case prQueue.Status.State == s2hv1.PullRequestQueueFinished ||
+		prQueue.Status.IsConditionTrue(s2hv1.PullRequestQueueCondEnvDestroyed):
+		return nil, errors.ErrPullRequestEnvironmentDestroyed
+	case prQueue.Status.DestroyedTime != nil:
+		from := now
+		if prQueue.Status.DestroyedTime.After(from) {
+			from = prQueue.Status.DestroyedTime.Time
+		}
+
+		destroyedTime := from.Add(duration)
+		if maxTime := now.Add(maxDuration); destroyedTime.After(maxTime) {
+			destroyedTime = maxTime
+		}
+		prQueue.Status.SetDestroyedTime(v1.NewTime(destroyedTime))
+	default:
+		if duration > maxDuration {
+			duration = maxDuration
+		}
+
+		// the configured teardown duration and criteria are kept if the duration is not longer
+		if duration <= prQueue.Spec.TearDownDuration.Duration.Duration {
+			return prQueue, nil
+		}
+		prQueue.Spec.TearDownDuration.Duration = v1.Duration{Duration: duration}
+	}
+
+	logger.Info("extend pull request environment", "team", teamName, "bundle", bundleName,
+		"prNumber", prNumber, "duration", duration.String())
+
+	if err := c.client.Update(context.TODO(), prQueue); err != nil {
+		return nil, err
+	}
+
+	return prQueue, nil
+}
+
+// CommentPullRequest creates a comment on the pull request of the git provider
+func (c *controller) CommentPullRequest(teamName string, provider s2hv1.PullRequestGitProvider,
+	repository, prNumber, body string) error {
+
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return err
+	}
+
+	if err := c.LoadTeamSecret(teamComp); err != nil {
+		return err
+	}
+
+	token := c.getTeamGitToken(teamComp, provider)
+	switch provider {
+	case s2hv1.PullRequestGitProviderGithub:
+		return githubutil.NewClient(c.configs.GithubURL, token).CreatePullRequestComment(repository, prNumber, body)
+	case s2hv1.PullRequestGitProviderGitlab:
+		return gitlabutil.NewClient(c.configs.GitlabURL, token).CreateMRNote(repository, prNumber, body)
+	}
+
+	return errors.New(fmt.Sprintf("unsupported git provider %q", provider))
+}
+
+func (c *controller) getPullRequestQueue(teamName, bundleName, prNumber string) (*s2hv1.PullRequestQueue, error) {
+	teamComp := s2hv1.Team{}
+	if err := c.GetTeam(teamName, &teamComp); err != nil {
+		return nil, err
+	}
+
+	prQueue := &s2hv1.PullRequestQueue{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{
+		Namespace: teamComp.Status.Namespace.Staging,
+		Name:      internal.GenPullRequestBundleName(bundleName, prNumber),
+	}, prQueue)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, errors.ErrPullRequestQueueNotFound
+		}
+		return nil, err
+	}
+
+	return prQueue, nil
+}
+
+// GetPullRequestBundlesByRepository returns pull request bundle names of each team
+// which are configured with the git repository or the gitlab project id
+func (c *controller) GetPullRequestBundlesByRepository(repository, projectID string) (map[string][]string, error) {
+	teams, err := c.GetTeams()
+	if err != nil {
+		return nil, err
+	}
+
+	teamBundles := make(map[string][]string)
+	for _, team := range teams.Items {
+		prConfig, err := c.GetConfigController().GetPullRequestConfig(team.Name)
+		if err != nil {
+			continue
+		}
+
+		for _, bundle := range prConfig.Bundles {
+			if isPullRequestBundleRepository(bundle, repository, projectID) {
+				teamBundles[team.Name] = append(teamBundles[team.Name], bundle.Name)
+			}
+		}
+	}
+
+	return teamBundles, nil
+}
+
+func isPullRequestBundleRepository(bundle *s2hv1.PullRequestBundle, repository, projectID string) bool {
+	if projectID != "" && bundle.GitProjectID == projectID {
+		return true
+	}
+
+	return repository != "" && strings.EqualFold(bundle.GitRepository, repository)
+}
+
 func (c *controller) validatePullRequestBundleName(teamName, prBundleName string) error {
 	configCtrl := c.GetConfigController()
 	prConfig, err := configCtrl.GetPullRequestConfig(teamName)
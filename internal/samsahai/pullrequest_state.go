@@ -0,0 +1,249 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	bitbucketutil "github.com/agoda-com/samsahai/internal/util/bitbucket"
+	giteautil "github.com/agoda-com/samsahai/internal/util/gitea"
+	githubutil "github.com/agoda-com/samsahai/internal/util/github"
+	gitlabutil "github.com/agoda-com/samsahai/internal/util/gitlab"
+)
+
+// DefaultPullRequestStateCheckInterval defines a default duration time to re-check states of pull requests
+const DefaultPullRequestStateCheckInterval = 5 * time.Minute
+
+// pullRequestStateGetter returns true if the pull request of the repository is still open
+type pullRequestStateGetter func(teamComp *s2hv1.Team, provider s2hv1.PullRequestGitProvider,
+	repository, prNumber string) (bool, error)
+
+func (c *controller) getPullRequestStateCheckInterval() time.Duration {
+	interval := c.configs.PullRequest.StateCheckInterval.Duration
+	if interval <= 0 {
+		return DefaultPullRequestStateCheckInterval
+	}
+
+	return interval
+}
+
+// checkPullRequestState destroys environments of closed or merged pull requests
+// and keeps environments of open pull requests alive for every team which enables the state check
+func (c *controller) checkPullRequestState() error {
+	defer c.queue.AddAfter(checkPullRequestState{}, c.getPullRequestStateCheckInterval())
+
+	// errors are not returned, the state check will be retried in the next interval
+	teamList, err := c.GetTeams()
+	if err != nil {
+		logger.Error(err, "cannot list teams for checking pull request states")
+		return nil
+	}
+
+	for i := range teamList.Items {
+		teamComp := &teamList.Items[i]
+		prConfig, err := c.GetConfigController().GetPullRequestConfig(teamComp.Name)
+		if err != nil || prConfig.StateCheck == nil || !prConfig.StateCheck.Enabled {
+			continue
+		}
+
+		if err := c.checkTeamPullRequestState(teamComp, prConfig); err != nil {
+			logger.Error(err, "cannot check pull request states", "team", teamComp.Name)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (c *controller) checkTeamPullRequestState(teamComp *s2hv1.Team, prConfig *s2hv1.ConfigPullRequest) error {
+	if len(teamComp.Status.Namespace.PullRequests) == 0 {
+		return nil
+	}
+
+	prQueueList := &s2hv1.PullRequestQueueList{}
+	err := c.client.List(context.TODO(), prQueueList, &client.ListOptions{Namespace: teamComp.Status.Namespace.Staging})
+	if err != nil {
+		return errors.Wrapf(err, "cannot list pullrequestqueues of team %s", teamComp.Name)
+	}
+
+	getState := c.pullRequestStateGetter
+	if getState == nil {
+		getState = c.isPullRequestOpen
+	}
+
+	// git tokens of the team are loaded once for all pull requests, samsahai tokens are used if failed
+	if err := c.LoadTeamSecret(teamComp); err != nil {
+		logger.Error(err, "cannot load team secret", "team", teamComp.Name)
+	}
+
+	stateCheck := prConfig.StateCheck
+	for _, prNamespace := range teamComp.Status.Namespace.PullRequests {
+		prQueue := findPullRequestQueueByNamespace(prQueueList, prNamespace)
+		if prQueue == nil || !prQueue.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		bundleName := prQueue.Spec.BundleName
+		prNumber := prQueue.Spec.PRNumber
+		repository := getPullRequestBundleRepository(prConfig, bundleName, stateCheck.Provider)
+		if repository == "" {
+			continue
+		}
+
+		isOpen, err := getState(teamComp, stateCheck.Provider, repository, prNumber)
+		if err != nil {
+			logger.Error(err, "cannot get pull request state", "team", teamComp.Name,
+				"repository", repository, "prNumber", prNumber)
+			continue
+		}
+
+		if !isOpen {
+			logger.Info("pull request has been closed, destroying pull request environment",
+				"team", teamComp.Name, "namespace", prNamespace, "prNumber", prNumber)
+			if err := c.DeletePullRequestDeployment(teamComp.Name, bundleName, prNumber); err != nil {
+				logger.Error(err, "cannot delete pull request deployment", "team", teamComp.Name,
+					"bundle", bundleName, "prNumber", prNumber)
+			}
+			continue
+		}
+
+		if stateCheck.KeepAliveWhileOpen {
+			if err := c.keepPullRequestEnvironmentAlive(prQueue); err != nil {
+				logger.Error(err, "cannot extend destroyed time of pull request environment",
+					"team", teamComp.Name, "namespace", prNamespace)
+			}
+		}
+	}
+
+	return nil
+}
+
+// keepPullRequestEnvironmentAlive postpones the destroyed time of the pull request environment
+// until the next state check
+func (c *controller) keepPullRequestEnvironmentAlive(prQueue *s2hv1.PullRequestQueue) error {
+	destroyedTime := prQueue.Status.DestroyedTime
+	if prQueue.Status.State != s2hv1.PullRequestQueueEnvDestroying || destroyedTime == nil {
+		return nil
+	}
+
+	keepAliveTime := metav1.NewTime(time.Now().Add(2 * c.getPullRequestStateCheckInterval()))
+	if !destroyedTime.Before(&keepAliveTime) {
+		return nil
+	}
+
+	prQueue.Status.SetDestroyedTime(keepAliveTime)
+	if err := c.client.Update(context.TODO(), prQueue); err != nil {
+		if k8serrors.IsConflict(err) {
+			logger.Debug("pullrequestqueue has been changed, will retry on the next state check",
+				"name", prQueue.Name, "namespace", prQueue.Namespace)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// isPullRequestOpen queries the state of pull request from the git provider
+func (c *controller) isPullRequestOpen(teamComp *s2hv1.Team, provider s2hv1.PullRequestGitProvider,
+	repository, prNumber string) (bool, error) {
+
+	switch provider {
+	case s2hv1.PullRequestGitProviderGithub:
+		token := c.getTeamGitToken(teamComp, provider)
+		state, err := githubutil.NewClient(c.configs.GithubURL, token).
+			GetPullRequestState(repository, prNumber)
+		if err != nil {
+			return false, err
+		}
+		return state == githubutil.PullRequestStateOpen, nil
+
+	case s2hv1.PullRequestGitProviderGitlab:
+		token := c.getTeamGitToken(teamComp, provider)
+		state, err := gitlabutil.NewClient(c.configs.GitlabURL, token).GetMRState(repository, prNumber)
+		if err != nil {
+			return false, err
+		}
+		return state == gitlabutil.MRStateOpened || state == gitlabutil.MRStateLocked, nil
+
+	case s2hv1.PullRequestGitProviderBitbucket:
+		token := c.getTeamGitToken(teamComp, provider)
+		state, err := bitbucketutil.NewClient(c.configs.BitbucketURL, token).
+			GetPullRequestState(repository, prNumber)
+		if err != nil {
+			return false, err
+		}
+		return state == bitbucketutil.PullRequestStateOpen, nil
+
+	case s2hv1.PullRequestGitProviderGitea:
+		token := c.getTeamGitToken(teamComp, provider)
+		state, err := giteautil.NewClient(c.configs.GiteaURL, token).GetPullRequestState(repository, prNumber)
+		if err != nil {
+			return false, err
+		}
+		return state == giteautil.PullRequestStateOpen, nil
+	}
+
+	return false, errors.Errorf("unsupported git provider %q", provider)
+}
+
+// getTeamGitToken returns token of the git provider of the team which the team secret has been loaded,
+// the samsahai token of the git provider is used if not defined
+func (c *controller) getTeamGitToken(teamComp *s2hv1.Team, provider s2hv1.PullRequestGitProvider) string {
+	cred := c.configs.SamsahaiCredential
+	teamCred := &teamComp.Status.Used.Credential
+	var teamToken *s2hv1.TokenCredential
+	var defaultToken string
+	switch provider {
+	case s2hv1.PullRequestGitProviderGithub:
+		teamToken, defaultToken = teamCred.Github, cred.GithubToken
+	case s2hv1.PullRequestGitProviderGitlab:
+		teamToken, defaultToken = teamCred.Gitlab, cred.GitlabToken
+	case s2hv1.PullRequestGitProviderBitbucket:
+		teamToken, defaultToken = teamCred.Bitbucket, cred.BitbucketToken
+	case s2hv1.PullRequestGitProviderGitea:
+		teamToken, defaultToken = teamCred.Gitea, cred.GiteaToken
+	}
+
+	if teamToken == nil || teamToken.Token == "" {
+		return defaultToken
+	}
+
+	return teamToken.Token
+}
+
+func findPullRequestQueueByNamespace(prQueueList *s2hv1.PullRequestQueueList,
+	prNamespace string) *s2hv1.PullRequestQueue {
+
+	for i := range prQueueList.Items {
+		if prQueueList.Items[i].Status.PullRequestNamespace == prNamespace {
+			return &prQueueList.Items[i]
+		}
+	}
+
+	return nil
+}
+
+// getPullRequestBundleRepository returns the repository of pull request bundle used for querying the git provider,
+// gitlab prefers the project id over the repository
+func getPullRequestBundleRepository(prConfig *s2hv1.ConfigPullRequest, bundleName string,
+	provider s2hv1.PullRequestGitProvider) string {
+
+	for _, bundle := range prConfig.Bundles {
+		if bundle.Name != bundleName {
+			continue
+		}
+
+		if provider == s2hv1.PullRequestGitProviderGitlab && bundle.GitProjectID != "" {
+			return bundle.GitProjectID
+		}
+		return bundle.GitRepository
+	}
+
+	return ""
+}
@@ -0,0 +1,158 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+var _ = Describe("Pull request state check", func() {
+	g := NewWithT(GinkgoT())
+
+	const (
+		teamName  = "teamtest"
+		namespace = "s2h-teamtest"
+	)
+
+	var (
+		c        client.Client
+		ctrl     *controller
+		prConfig *s2hv1.ConfigPullRequest
+		openPRs  map[string]bool
+	)
+
+	newPullRequestQueue := func(prNumber string, state s2hv1.PullRequestQueueState,
+		destroyedTime *metav1.Time) *s2hv1.PullRequestQueue {
+		return &s2hv1.PullRequestQueue{
+			ObjectMeta: metav1.ObjectMeta{Name: "wordpress-" + prNumber, Namespace: namespace},
+			Spec:       s2hv1.PullRequestQueueSpec{BundleName: "wordpress", PRNumber: prNumber},
+			Status: s2hv1.PullRequestQueueStatus{
+				State:                state,
+				PullRequestNamespace: "s2h-teamtest-wordpress-" + prNumber,
+				DestroyedTime:        destroyedTime,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		team := &s2hv1.Team{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+		team.Status.Namespace.Staging = namespace
+		team.Status.Namespace.PullRequests = []string{"s2h-teamtest-wordpress-1", "s2h-teamtest-wordpress-2"}
+
+		destroyedTime := metav1.NewTime(time.Now().Add(time.Minute))
+		c = unittest.NewFakeClient(
+			team,
+			newPullRequestQueue("1", s2hv1.PullRequestQueueTesting, nil),
+			newPullRequestQueue("2", s2hv1.PullRequestQueueEnvDestroying, &destroyedTime),
+		)
+
+		openPRs = map[string]bool{}
+		ctrl = &controller{
+			client: c,
+			configs: internal.SamsahaiConfig{
+				PullRequest: internal.PullRequestConfig{
+					StateCheckInterval: metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			pullRequestStateGetter: func(_ *s2hv1.Team, provider s2hv1.PullRequestGitProvider,
+				repository, prNumber string) (bool, error) {
+				g.Expect(provider).To(Equal(s2hv1.PullRequestGitProviderGitlab))
+				g.Expect(repository).To(Equal("15"))
+				return openPRs[prNumber], nil
+			},
+		}
+
+		prConfig = &s2hv1.ConfigPullRequest{
+			Bundles: []*s2hv1.PullRequestBundle{
+				{Name: "wordpress", GitRepository: "agoda-com/wordpress", GitProjectID: "15"},
+			},
+			StateCheck: &s2hv1.PullRequestStateCheckConfig{
+				Enabled:  true,
+				Provider: s2hv1.PullRequestGitProviderGitlab,
+			},
+		}
+	})
+
+	It("should delete pull request queues of closed pull requests", func() {
+		openPRs["2"] = true
+
+		g.Expect(ctrl.checkTeamPullRequestState(getTeam(g, c, teamName), prConfig)).To(Succeed())
+
+		err := c.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "wordpress-1"},
+			&s2hv1.PullRequestQueue{})
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+		prQueue := &s2hv1.PullRequestQueue{}
+		err = c.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "wordpress-2"}, prQueue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(prQueue.Status.DestroyedTime.Time).To(BeTemporally("<", time.Now().Add(2*time.Minute)))
+	})
+
+	It("should extend destroyed time of open pull requests if keep alive is enabled", func() {
+		openPRs["1"] = true
+		openPRs["2"] = true
+		prConfig.StateCheck.KeepAliveWhileOpen = true
+
+		g.Expect(ctrl.checkTeamPullRequestState(getTeam(g, c, teamName), prConfig)).To(Succeed())
+
+		prQueue := &s2hv1.PullRequestQueue{}
+		err := c.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "wordpress-1"}, prQueue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(prQueue.Status.DestroyedTime).To(BeNil())
+
+		err = c.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "wordpress-2"}, prQueue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(prQueue.Status.DestroyedTime.Time).To(BeTemporally(">", time.Now().Add(19*time.Minute)))
+	})
+
+	It("should use git token of the team secret", func() {
+		ctrl.namespace = "s2h-system"
+		ctrl.configs.SamsahaiCredential.GithubToken = "s2h-github-token"
+		g.Expect(c.Create(context.TODO(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "teamtest-secret", Namespace: ctrl.namespace},
+			Data:       map[string][]byte{"github-token": []byte("team-github-token")},
+		})).To(Succeed())
+
+		tokens := make([]string, 0)
+		ctrl.pullRequestStateGetter = func(teamComp *s2hv1.Team, provider s2hv1.PullRequestGitProvider,
+			repository, prNumber string) (bool, error) {
+			tokens = append(tokens, ctrl.getTeamGitToken(teamComp, s2hv1.PullRequestGitProviderGithub))
+			return true, nil
+		}
+
+		team := getTeam(g, c, teamName)
+		g.Expect(ctrl.getTeamGitToken(team, s2hv1.PullRequestGitProviderGithub)).To(Equal("s2h-github-token"))
+
+		team.Status.Used.Credential.SecretName = "teamtest-secret"
+		team.Status.Used.Credential.Github = &s2hv1.TokenCredential{
+			TokenRef: &corev1.SecretKeySelector{Key: "github-token"},
+		}
+		g.Expect(ctrl.checkTeamPullRequestState(team, prConfig)).To(Succeed())
+		g.Expect(tokens).To(Equal([]string{"team-github-token", "team-github-token"}))
+	})
+
+	It("should correctly get repository of pull request bundle", func() {
+		g.Expect(getPullRequestBundleRepository(prConfig, "wordpress", s2hv1.PullRequestGitProviderGitlab)).
+			To(Equal("15"))
+		g.Expect(getPullRequestBundleRepository(prConfig, "wordpress", s2hv1.PullRequestGitProviderGithub)).
+			To(Equal("agoda-com/wordpress"))
+		g.Expect(getPullRequestBundleRepository(prConfig, "redis", s2hv1.PullRequestGitProviderGithub)).
+			To(BeEmpty())
+	})
+})
+
+func getTeam(g *WithT, c client.Client, teamName string) *s2hv1.Team {
+	team := &s2hv1.Team{}
+	g.Expect(c.Get(context.TODO(), client.ObjectKey{Name: teamName}, team)).To(Succeed())
+	return team
+}
@@ -0,0 +1,154 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+var _ = Describe("Pull request deployment", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should correctly match repository of pull request bundle", func() {
+		bundle := &s2hv1.PullRequestBundle{Name: "wordpress", GitRepository: "agoda-com/samsahai", GitProjectID: "15"}
+
+		g.Expect(isPullRequestBundleRepository(bundle, "Agoda-com/Samsahai", "")).To(BeTrue())
+		g.Expect(isPullRequestBundleRepository(bundle, "other/repo", "15")).To(BeTrue())
+		g.Expect(isPullRequestBundleRepository(bundle, "other/repo", "16")).To(BeFalse())
+		g.Expect(isPullRequestBundleRepository(&s2hv1.PullRequestBundle{Name: "redis"}, "", "")).To(BeFalse())
+	})
+
+	It("should delete pull request trigger and queue", func() {
+		namespace := "s2h-teamtest"
+
+		team := &s2hv1.Team{ObjectMeta: metav1.ObjectMeta{Name: "teamtest"}}
+		team.Status.Namespace.Staging = namespace
+		c := unittest.NewFakeClient(
+			team,
+			&s2hv1.PullRequestTrigger{ObjectMeta: metav1.ObjectMeta{Name: "wordpress-12", Namespace: namespace}},
+			&s2hv1.PullRequestQueue{ObjectMeta: metav1.ObjectMeta{Name: "wordpress-12", Namespace: namespace}},
+		)
+		ctrl := &controller{client: c}
+
+		g.Expect(ctrl.DeletePullRequestDeployment("teamtest", "wordpress", "12")).To(Succeed())
+
+		key := client.ObjectKey{Namespace: namespace, Name: "wordpress-12"}
+		err := c.Get(context.TODO(), key, &s2hv1.PullRequestTrigger{})
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		err = c.Get(context.TODO(), key, &s2hv1.PullRequestQueue{})
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+		g.Expect(ctrl.DeletePullRequestDeployment("teamtest", "wordpress", "12")).To(Succeed())
+	})
+
+	Describe("pull request commands", func() {
+		const namespace = "s2h-teamtest"
+
+		var (
+			c    client.Client
+			ctrl *controller
+		)
+
+		getPullRequestQueue := func() *s2hv1.PullRequestQueue {
+			prQueue := &s2hv1.PullRequestQueue{}
+			err := c.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "wordpress-12"}, prQueue)
+			g.Expect(err).NotTo(HaveOccurred())
+			return prQueue
+		}
+
+		newClient := func(status s2hv1.PullRequestQueueStatus) {
+			team := &s2hv1.Team{ObjectMeta: metav1.ObjectMeta{Name: "teamtest"}}
+			team.Status.Namespace.Staging = namespace
+			c = unittest.NewFakeClient(
+				team,
+				&s2hv1.PullRequestQueue{
+					ObjectMeta: metav1.ObjectMeta{Name: "wordpress-12", Namespace: namespace},
+					Spec:       s2hv1.PullRequestQueueSpec{BundleName: "wordpress", PRNumber: "12"},
+					Status:     status,
+				},
+			)
+			ctrl = &controller{client: c}
+		}
+
+		It("should request retesting of pull request environment", func() {
+			newClient(s2hv1.PullRequestQueueStatus{State: s2hv1.PullRequestQueueEnvDestroying})
+
+			g.Expect(ctrl.RetestPullRequestQueue("teamtest", "wordpress", "12", "octocat")).To(Succeed())
+			g.Expect(getPullRequestQueue().Spec.RetestRequest.RequestedBy).To(Equal("octocat"))
+
+			By("Rejecting duplicated request")
+			err := ctrl.RetestPullRequestQueue("teamtest", "wordpress", "12", "octocat")
+			g.Expect(err).To(Equal(s2herrors.ErrPullRequestQueueCannotBeRetested))
+
+			By("Rejecting unknown pull request")
+			err = ctrl.RetestPullRequestQueue("teamtest", "wordpress", "13", "octocat")
+			g.Expect(err).To(Equal(s2herrors.ErrPullRequestQueueNotFound))
+		})
+
+		It("should not retest running pull request queue", func() {
+			newClient(s2hv1.PullRequestQueueStatus{State: s2hv1.PullRequestQueueTesting})
+
+			err := ctrl.RetestPullRequestQueue("teamtest", "wordpress", "12", "octocat")
+			g.Expect(err).To(Equal(s2herrors.ErrPullRequestQueueCannotBeRetested))
+		})
+
+		It("should extend destroyed time of pull request environment", func() {
+			destroyedTime := metav1.NewTime(time.Now().Add(time.Hour))
+			newClient(s2hv1.PullRequestQueueStatus{
+				State:         s2hv1.PullRequestQueueEnvDestroying,
+				DestroyedTime: &destroyedTime,
+			})
+
+			prQueue, err := ctrl.ExtendPullRequestEnvironment("teamtest", "wordpress", "12", 4*time.Hour, 24*time.Hour)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(prQueue.Status.DestroyedTime.Time).To(BeTemporally(">", time.Now().Add(4*time.Hour+59*time.Minute)))
+			g.Expect(getPullRequestQueue().Status.DestroyedTime.Time).
+				To(BeTemporally(">", time.Now().Add(4*time.Hour+59*time.Minute)))
+		})
+
+		It("should not extend destroyed time over the max duration", func() {
+			destroyedTime := metav1.NewTime(time.Now().Add(3 * time.Hour))
+			newClient(s2hv1.PullRequestQueueStatus{
+				State:         s2hv1.PullRequestQueueEnvDestroying,
+				DestroyedTime: &destroyedTime,
+			})
+
+			_, err := ctrl.ExtendPullRequestEnvironment("teamtest", "wordpress", "12", 4*time.Hour, 5*time.Hour)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(getPullRequestQueue().Status.DestroyedTime.Time).
+				To(BeTemporally("~", time.Now().Add(5*time.Hour), time.Minute))
+		})
+
+		It("should raise teardown duration of running pull request queue", func() {
+			newClient(s2hv1.PullRequestQueueStatus{State: s2hv1.PullRequestQueueTesting})
+			prQueue := getPullRequestQueue()
+			prQueue.Spec.TearDownDuration = s2hv1.PullRequestTearDownDuration{
+				Duration: metav1.Duration{Duration: 8 * time.Hour},
+				Criteria: s2hv1.PullRequestTearDownDurationCriteriaFailure,
+			}
+			g.Expect(c.Update(context.TODO(), prQueue)).To(Succeed())
+
+			By("Keeping the longer teardown duration")
+			prQueue, err := ctrl.ExtendPullRequestEnvironment("teamtest", "wordpress", "12", 4*time.Hour, 24*time.Hour)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(prQueue.Spec.TearDownDuration.Duration.Duration).To(Equal(8 * time.Hour))
+			g.Expect(getPullRequestQueue().Spec.TearDownDuration.Duration.Duration).To(Equal(8 * time.Hour))
+
+			By("Raising the teardown duration up to the max duration")
+			_, err = ctrl.ExtendPullRequestEnvironment("teamtest", "wordpress", "12", 48*time.Hour, 24*time.Hour)
+			g.Expect(err).NotTo(HaveOccurred())
+			tearDownDuration := getPullRequestQueue().Spec.TearDownDuration
+			g.Expect(tearDownDuration.Duration.Duration).To(Equal(24 * time.Hour))
+			g.Expect(tearDownDuration.Criteria).To(Equal(s2hv1.PullRequestTearDownDurationCriteriaFailure))
+		})
+	})
+})
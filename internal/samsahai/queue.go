@@ -0,0 +1,158 @@
+package samsahai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/queue"
+)
+
+// EnqueueComponent manually adds the component version to the staging queue of the team
+func (c *controller) EnqueueComponent(teamName, namespace, compName, version, actionBy string, atTop bool) error {
+	comps, err := c.GetConfigController().GetComponents(teamName)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get components of team %s", teamName)
+	}
+
+	comp, ok := comps[compName]
+	if !ok || comp.Image.Repository == "" {
+		return s2herrors.ErrComponentNotFound
+	}
+
+	queueComps := []*s2hv1.QueueComponent{
+		{
+			Name:       compName,
+			Repository: comp.Image.Repository,
+			Version:    version,
+		},
+	}
+	q := queue.NewQueue(teamName, namespace, compName, c.getBundleName(compName, teamName), queueComps,
+		s2hv1.QueueTypeUpgrade)
+	q.AddAction(s2hv1.QueueActionEnqueue, actionBy)
+
+	logger.Info("enqueue component manually", "team", teamName, "component", compName,
+		"version", version, "by", actionBy)
+
+	queueCtrl := queue.New(namespace, c.client)
+	if atTop {
+		return queueCtrl.AddTop(q)
+	}
+
+	priorityQueues, _ := c.GetConfigController().GetPriorityQueues(teamName)
+	return queueCtrl.Add(q, priorityQueues)
+}
+
+// MoveQueueToTop moves the waiting queue to the top of the staging queue,
+// the queue is reordered only without being re-added to the staging queue
+func (c *controller) MoveQueueToTop(namespace, queueName, actionBy string) error {
+	q, err := c.getWaitingQueue(namespace, queueName)
+	if err != nil {
+		return err
+	}
+
+	queueList := &s2hv1.QueueList{}
+	if err := c.client.List(context.TODO(), queueList, client.InNamespace(namespace)); err != nil {
+		return errors.Wrapf(err, "cannot list queues of namespace %s", namespace)
+	}
+
+	logger.Info("move queue to the top", "namespace", namespace, "queue", queueName, "by", actionBy)
+
+	q.Spec.NoOfOrder = queueList.TopQueueOrder()
+	q.AddAction(s2hv1.QueueActionMoveToTop, actionBy)
+	return errors.Wrapf(c.client.Update(context.TODO(), q), "cannot move queue %s to the top", queueName)
+}
+
+// CancelQueue cancels the running queue, the queue will be removed by staging controller
+func (c *controller) CancelQueue(namespace, queueName, actionBy string) error {
+	q, err := c.getQueue(namespace, queueName)
+	if err != nil {
+		return err
+	}
+
+	switch q.Status.State {
+	case "", s2hv1.Waiting, s2hv1.Deleting, s2hv1.Cancelling, s2hv1.Finished:
+		return s2herrors.ErrQueueNotRunning
+	}
+
+	logger.Info("cancel running queue", "namespace", namespace, "queue", queueName, "by", actionBy)
+
+	q.AddAction(s2hv1.QueueActionCancel, actionBy)
+	q.SetState(s2hv1.Cancelling)
+	return errors.Wrapf(c.client.Update(context.TODO(), q), "cannot cancel queue %s", queueName)
+}
+
+// RemoveQueue removes the waiting queue from the staging queue,
+// the removal is kept in the queue history as the queue will no longer exist
+func (c *controller) RemoveQueue(namespace, queueName, actionBy string) error {
+	q, err := c.getWaitingQueue(namespace, queueName)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("remove waiting queue", "namespace", namespace, "queue", queueName, "by", actionBy)
+
+	q.AddAction(s2hv1.QueueActionRemove, actionBy)
+	if err := c.createRemovedQueueHistory(q); err != nil {
+		return errors.Wrapf(err, "cannot create queue history of removed queue %s", queueName)
+	}
+
+	return queue.New(namespace, c.client).Remove(q)
+}
+
+// ReverifyQueue forces the waiting queue to be reverified at first
+func (c *controller) ReverifyQueue(namespace, queueName, actionBy string) error {
+	q, err := c.getWaitingQueue(namespace, queueName)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("force reverify queue", "namespace", namespace, "queue", queueName, "by", actionBy)
+
+	q.AddAction(s2hv1.QueueActionReverify, actionBy)
+	return queue.New(namespace, c.client).SetReverifyQueueAtFirst(q)
+}
+
+func (c *controller) getQueue(namespace, queueName string) (*s2hv1.Queue, error) {
+	q := &s2hv1.Queue{}
+	err := c.client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: queueName}, q)
+	return q, err
+}
+
+func (c *controller) getWaitingQueue(namespace, queueName string) (*s2hv1.Queue, error) {
+	q, err := c.getQueue(namespace, queueName)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Status.State != "" && q.Status.State != s2hv1.Waiting {
+		return nil, s2herrors.ErrQueueRunning
+	}
+
+	return q, nil
+}
+
+func (c *controller) createRemovedQueueHistory(q *s2hv1.Queue) error {
+	now := metav1.Now()
+	history := &s2hv1.QueueHistory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", q.Name, now.Format("20060102-150405")),
+			Namespace: q.Namespace,
+			Labels:    q.Labels,
+		},
+		Spec: s2hv1.QueueHistorySpec{
+			Queue: &s2hv1.Queue{
+				Spec:   q.Spec,
+				Status: q.Status,
+			},
+			CreatedAt: &now,
+		},
+	}
+
+	return c.client.Create(context.TODO(), history)
+}
@@ -0,0 +1,109 @@
+package samsahai
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+var _ = Describe("Queue management", func() {
+	g := NewWithT(GinkgoT())
+
+	namespace := "s2h-teamtest"
+	var ctrl *controller
+
+	newQueue := func(name string, order int, state s2hv1.QueueState) *s2hv1.Queue {
+		return &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: s2hv1.QueueSpec{
+				Name:       name,
+				TeamName:   "teamtest",
+				Type:       s2hv1.QueueTypeUpgrade,
+				NoOfOrder:  order,
+				Components: s2hv1.QueueComponents{{Name: name, Repository: name, Version: "1.0.0"}},
+			},
+			Status: s2hv1.QueueStatus{State: state},
+		}
+	}
+
+	getQueue := func(name string) *s2hv1.Queue {
+		q := &s2hv1.Queue{}
+		g.Expect(ctrl.client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, q)).
+			To(Succeed())
+		return q
+	}
+
+	BeforeEach(func() {
+		c := unittest.NewFakeClient(
+			newQueue("redis", 1, s2hv1.Testing),
+			newQueue("mariadb", 2, s2hv1.Waiting),
+			newQueue("wordpress", 3, s2hv1.Waiting),
+		)
+		ctrl = &controller{client: c}
+	})
+
+	It("should cancel the running queue with the actor", func() {
+		g.Expect(ctrl.CancelQueue(namespace, "redis", "john")).To(Succeed())
+
+		q := getQueue("redis")
+		g.Expect(q.Status.State).To(Equal(s2hv1.Cancelling))
+		g.Expect(q.GetLastAction().Type).To(Equal(s2hv1.QueueActionCancel))
+		g.Expect(q.GetLastAction().By).To(Equal("john"))
+
+		err := ctrl.CancelQueue(namespace, "mariadb", "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrQueueNotRunning)).To(BeTrue())
+	})
+
+	It("should remove only the waiting queue", func() {
+		g.Expect(ctrl.RemoveQueue(namespace, "mariadb", "john")).To(Succeed())
+		err := ctrl.client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "mariadb"}, &s2hv1.Queue{})
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+		By("Keeping the removal in queue history")
+		qHists := &s2hv1.QueueHistoryList{}
+		g.Expect(ctrl.client.List(context.TODO(), qHists, client.InNamespace(namespace))).To(Succeed())
+		g.Expect(qHists.Items).To(HaveLen(1))
+		g.Expect(qHists.Items[0].Spec.Queue.Spec.Name).To(Equal("mariadb"))
+		g.Expect(qHists.Items[0].Spec.Queue.GetLastAction().Type).To(Equal(s2hv1.QueueActionRemove))
+		g.Expect(qHists.Items[0].Spec.Queue.GetLastAction().By).To(Equal("john"))
+
+		err = ctrl.RemoveQueue(namespace, "redis", "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrQueueRunning)).To(BeTrue())
+
+		err = ctrl.RemoveQueue(namespace, "unknown", "john")
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should force the waiting queue to reverify at first", func() {
+		g.Expect(ctrl.ReverifyQueue(namespace, "wordpress", "john")).To(Succeed())
+
+		q := getQueue("wordpress")
+		g.Expect(q.IsReverify()).To(BeTrue())
+		g.Expect(q.Spec.NoOfOrder).To(BeNumerically("<", 1))
+		g.Expect(q.GetLastAction().Type).To(Equal(s2hv1.QueueActionReverify))
+	})
+
+	It("should move the waiting queue to the top", func() {
+		g.Expect(ctrl.MoveQueueToTop(namespace, "wordpress", "john")).To(Succeed())
+
+		q := getQueue("wordpress")
+		g.Expect(q.Spec.NoOfOrder).To(BeNumerically("<", 1))
+		g.Expect(q.Spec.Actions).To(HaveLen(1))
+		g.Expect(q.GetLastAction().Type).To(Equal(s2hv1.QueueActionMoveToTop))
+		g.Expect(getQueue("mariadb").Spec.NoOfOrder).To(Equal(2), "other queues should be kept as is")
+
+		err := ctrl.MoveQueueToTop(namespace, "redis", "john")
+		g.Expect(s2herrors.Is(err, s2herrors.ErrQueueRunning)).To(BeTrue())
+
+		err = ctrl.MoveQueueToTop(namespace, "unknown", "john")
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +22,7 @@ import (
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
 	"github.com/agoda-com/samsahai/internal/util/template"
+	"github.com/agoda-com/samsahai/internal/util/tracing"
 	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
 )
 
@@ -112,7 +115,7 @@ func (c *controller) getMissingVersions(teamInfo *rpc.TeamWithCurrentComponent,
 					}
 				}
 
-				missingImage, err := c.detectMissingImage(*source, stable.Spec.Repository, stable.Name, stable.Spec.Version)
+				missingImage, err := c.detectMissingImage(ctx, teamInfo.TeamName, *source, stable.Spec.Repository, stable.Name, stable.Spec.Version)
 				if err != nil {
 					errCh <- err
 					return
@@ -150,7 +153,7 @@ func (c *controller) getMissingVersions(teamInfo *rpc.TeamWithCurrentComponent,
 					return
 				}
 
-				missingImage, err := c.detectMissingImage(*source, qComp.Image.Repository, qComp.Name, qComp.Image.Tag)
+				missingImage, err := c.detectMissingImage(ctx, teamInfo.TeamName, *source, qComp.Image.Repository, qComp.Name, qComp.Image.Tag)
 				if err != nil {
 					errCh <- err
 					return
@@ -219,11 +222,21 @@ func (c *controller) RunPostComponentUpgrade(ctx context.Context, comp *rpc.Comp
 	if qHist.Spec.Queue.Status.TestRunner == emptyTestRunner {
 		qHist.Spec.Queue.Status.TestRunner = queueHist.Spec.Queue.Status.TestRunner
 	}
+	if len(qHist.Spec.Queue.Status.TestStages) == 0 {
+		qHist.Spec.Queue.Status.TestStages = queueHist.Spec.Queue.Status.TestStages
+	}
+	if qHist.Spec.Queue.Status.TestReport == nil {
+		qHist.Spec.Queue.Status.TestReport = queueHist.Spec.Queue.Status.TestReport
+	}
 
-	if err := c.sendDeploymentQueueReport(qHist.Name, qHist.Spec.Queue, comp); err != nil {
+	if err := c.sendDeploymentQueueReport(ctx, qHist.Name, qHist.Spec.Queue, comp); err != nil {
 		return nil, err
 	}
 
+	if queueHist.Spec.Queue != nil {
+		exporter.ObserveQueueResult(queueHist.Spec.Queue)
+	}
+
 	// Add metric updateQueueMetric & histories
 	queue := &s2hv1.Queue{}
 	err = c.client.Get(context.TODO(), types.NamespacedName{Name: queueHistName, Namespace: namespace}, queue)
@@ -255,8 +268,10 @@ func (c *controller) RunPostPullRequestQueue(ctx context.Context, comp *rpc.Comp
 	}
 
 	if prQueueHist.Spec.PullRequestQueue != nil {
+		exporter.ObservePullRequestQueueWaitDuration(comp.TeamName, prQueueHist.Spec.PullRequestQueue)
+
 		deploymentQueue := prQueueHist.Spec.PullRequestQueue.Status.DeploymentQueue
-		if err := c.sendDeploymentQueueReport(prQueueHistName, deploymentQueue, comp); err != nil {
+		if err := c.sendDeploymentQueueReport(ctx, prQueueHistName, deploymentQueue, comp); err != nil {
 			return nil, err
 		}
 	}
@@ -282,7 +297,7 @@ func (c *controller) RunPostPullRequestTrigger(ctx context.Context, prTriggerRPC
 		return nil, err
 	}
 
-	c.sendPullRequestTriggerReport(prTrigger, prTriggerRPC)
+	c.sendPullRequestTriggerReport(ctx, prTrigger, prTriggerRPC)
 
 	return &rpc.Empty{}, nil
 }
@@ -305,7 +320,7 @@ func (c *controller) RunPostPullRequestQueueTestRunnerTrigger(ctx context.Contex
 		return nil, err
 	}
 
-	if err := c.sendPullRequestTestRunnerPendingReport(prQueue, teamWithPR); err != nil {
+	if err := c.sendPullRequestTestRunnerPendingReport(ctx, prQueue, teamWithPR); err != nil {
 		return nil, err
 	}
 
@@ -324,7 +339,10 @@ func (c *controller) SendUpdateStateQueueMetric(ctx context.Context, comp *rpc.C
 			Namespace: comp.GetNamespace(),
 			Name:      queueName}, queue)
 		if err != nil {
-			if !k8serrors.IsNotFound(err) {
+			if k8serrors.IsNotFound(err) {
+				// queue has been finished
+				exporter.DeleteQueueMetric(comp.GetNamespace(), queueName)
+			} else {
 				logger.Error(err, "cannot get the queue")
 			}
 			return &rpc.Empty{}, nil
@@ -407,9 +425,9 @@ func (c *controller) GetComponentVersion(ctx context.Context, compSource *rpc.Co
 	}
 
 	source := compSource.Source
-	checker, err := c.getComponentChecker(source)
+	checker, err := c.getTeamComponentChecker(compSource.TeamName, source)
 	if err != nil {
-		logger.Error(err, "cannot get component checker", "source", compSource.Source)
+		logger.Error(err, "cannot get component checker", "team", compSource.TeamName, "source", source)
 		return nil, err
 	}
 
@@ -432,7 +450,8 @@ func (c *controller) GetComponentVersion(ctx context.Context, compSource *rpc.Co
 			imgRepository, imgTag)
 	}
 
-	version, err := checker.GetVersion(imgRepository, compSource.ComponentName, imgTag)
+	version, err := checker.GetVersion(imgRepository, compSource.ComponentName, imgTag,
+		c.getComponentCheckOptions(compSource)...)
 	if err != nil {
 		switch err.Error() {
 		case s2herrors.ErrNoDesiredComponentVersion.Error(), s2herrors.ErrRequestTimeout.Error():
@@ -445,6 +464,40 @@ func (c *controller) GetComponentVersion(ctx context.Context, compSource *rpc.Co
 	return &rpc.ComponentVersion{Version: version}, nil
 }
 
+// getComponentCheckOptions returns the constraint and ordering of the pull request component,
+// those of the component are used if the pull request component does not override the image pattern
+func (c *controller) getComponentCheckOptions(compSource *rpc.ComponentSource) []s2h.CheckOption {
+	if compSource.TeamName == "" {
+		return nil
+	}
+
+	configCtrl := c.GetConfigController()
+	if compSource.BundleName != "" {
+		prComps, err := configCtrl.GetPullRequestComponents(compSource.TeamName, compSource.BundleName, false)
+		if err != nil {
+			logger.Error(err, "cannot get pull request components", "team", compSource.TeamName,
+				"bundle", compSource.BundleName)
+			return nil
+		}
+
+		if prComp, ok := prComps[compSource.ComponentName]; ok && prComp.Image.Pattern != "" {
+			return []s2h.CheckOption{s2h.WithComponentImage(prComp.Image)}
+		}
+	}
+
+	comps, err := configCtrl.GetComponents(compSource.TeamName)
+	if err != nil {
+		logger.Error(err, "cannot get components", "team", compSource.TeamName)
+		return nil
+	}
+
+	if comp, ok := comps[compSource.ComponentName]; ok {
+		return []s2h.CheckOption{s2h.WithComponentImage(comp.Image)}
+	}
+
+	return nil
+}
+
 func (c *controller) GetPullRequestConfig(ctx context.Context, teamWithComp *rpc.TeamWithBundleName) (
 	*rpc.PullRequestConfig, error) {
 
@@ -548,7 +601,11 @@ func (c *controller) GetPullRequestComponentSources(ctx context.Context, teamWit
 
 	compSources := make([]*rpc.ComponentSource, 0)
 	for _, prComp := range prComps {
-		compSource := &rpc.ComponentSource{Image: &rpc.Image{}}
+		compSource := &rpc.ComponentSource{
+			Image:      &rpc.Image{},
+			TeamName:   teamName,
+			BundleName: teamWithPR.BundleName,
+		}
 		if prComp.Source != nil && *prComp.Source != "" {
 			compSource.Source = string(*prComp.Source)
 		}
@@ -706,14 +763,23 @@ func (c *controller) DestroyPullRequestEnvironment(ctx context.Context, teamWith
 
 }
 
-func (c *controller) detectMissingImage(source s2hv1.UpdatingSource, repo, name, version string) (*rpc.Image, error) {
-	checker, err := c.getComponentChecker(string(source))
+func (c *controller) detectMissingImage(ctx context.Context, teamName string, source s2hv1.UpdatingSource,
+	repo, name, version string) (*rpc.Image, error) {
+
+	checker, err := c.getTeamComponentChecker(teamName, string(source))
 	if err != nil {
 		return &rpc.Image{}, errors.Wrapf(err, "cannot get component checker, source: %s", string(source))
 	}
 
 	if repo != "" {
-		if err := checker.EnsureVersion(repo, name, version); err != nil {
+		_, span := tracing.StartSpan(ctx, "checker.EnsureVersion",
+			attribute.String("checker", checker.GetName()),
+			attribute.String("repository", repo),
+			attribute.String("version", version))
+		err := checker.EnsureVersion(repo, name, version)
+		tracing.EndSpan(span, err)
+
+		if err != nil {
 			if !s2herrors.IsImageNotFound(err) && !s2herrors.IsErrRequestTimeout(err) {
 				return &rpc.Image{},
 					errors.Wrapf(err, "cannot ensure version, name: %s, source: %s, repository: %s, version: %s",
@@ -745,7 +811,9 @@ func (c *controller) getImageSource(comps map[string]*s2hv1.Component, name stri
 	return source, true
 }
 
-func (c *controller) sendDeploymentQueueReport(queueHistName string, queue *s2hv1.Queue, comp *rpc.ComponentUpgrade) error {
+func (c *controller) sendDeploymentQueueReport(ctx context.Context, queueHistName string, queue *s2hv1.Queue,
+	comp *rpc.ComponentUpgrade) error {
+
 	configCtrl := c.GetConfigController()
 
 	teamComp := &s2hv1.Team{}
@@ -759,28 +827,47 @@ func (c *controller) sendDeploymentQueueReport(queueHistName string, queue *s2hv
 		return err
 	}
 
+	var previewURLs []s2hv1.PullRequestPreviewURL
+	if comp.PullRequestComponent != nil && comp.PullRequestComponent.PRNumber != "" {
+		previewURLs = c.getPullRequestPreviewURLs(teamComp.Status.Namespace.Staging,
+			comp.PullRequestComponent.BundleName, comp.PullRequestComponent.PRNumber)
+	}
+
 	for _, reporter := range c.reporters {
 		testRunner := s2hv1.TestRunner{}
+		var testStages []s2hv1.TestStage
+		var testReport *s2hv1.TestReport
 		if queue != nil {
 			testRunner = queue.Status.TestRunner
+			testStages = queue.Status.TestStages
+			testReport = queue.Status.TestReport
 		}
 
 		upgradeComp := s2h.NewComponentUpgradeReporter(
 			comp,
 			c.configs,
 			s2h.WithTestRunner(testRunner),
+			s2h.WithTestStages(testStages),
+			s2h.WithTestReport(testReport),
 			s2h.WithQueueHistoryName(queueHistName),
 			s2h.WithNamespace(comp.PullRequestNamespace),
 			s2h.WithComponentUpgradeOptCredential(teamComp.Status.Used.Credential),
+			s2h.WithPreviewURLs(previewURLs),
 		)
 
 		if comp.PullRequestComponent != nil && comp.PullRequestComponent.PRNumber != "" {
-			if err := reporter.SendPullRequestQueue(configCtrl, upgradeComp); err != nil {
+			_, span := startReporterSpan(ctx, reporter, "SendPullRequestQueue")
+			err := reporter.SendPullRequestQueue(configCtrl, upgradeComp)
+			tracing.EndSpan(span, err)
+			if err != nil {
 				logger.Error(err, "cannot send component upgrade failure report",
 					"team", comp.TeamName, "bundle", comp.Name)
 			}
 		} else {
-			if err := reporter.SendComponentUpgrade(configCtrl, upgradeComp); err != nil {
+			_, span := startReporterSpan(ctx, reporter, "SendComponentUpgrade")
+			err := reporter.SendComponentUpgrade(configCtrl, upgradeComp)
+			tracing.EndSpan(span, err)
+			if err != nil {
 				logger.Error(err, "cannot send component upgrade failure report",
 					"team", comp.TeamName, "component", comp.Name)
 			}
@@ -790,6 +877,21 @@ func (c *controller) sendDeploymentQueueReport(queueHistName string, queue *s2hv
 	return nil
 }
 
+// getPullRequestPreviewURLs returns preview urls which have been stored in the pull request queue
+func (c *controller) getPullRequestPreviewURLs(namespace, bundleName, prNumber string) []s2hv1.PullRequestPreviewURL {
+	prQueue := &s2hv1.PullRequestQueue{}
+	prQueueName := s2h.GenPullRequestBundleName(bundleName, prNumber)
+	err := c.client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: prQueueName}, prQueue)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			logger.Error(err, "cannot get pull request queue", "name", prQueueName, "namespace", namespace)
+		}
+		return nil
+	}
+
+	return prQueue.Status.PreviewURLs
+}
+
 func (c *controller) listQueueHistory(selectors map[string]string) (*s2hv1.QueueHistoryList, error) {
 	queueHists := &s2hv1.QueueHistoryList{}
 	listOpt := &client.ListOptions{LabelSelector: labels.SelectorFromSet(selectors)}
@@ -880,7 +982,9 @@ func (c *controller) ensureTeamPullRequestNamespaceUpdated(teamName, targetNs st
 	return nil
 }
 
-func (c *controller) sendPullRequestTriggerReport(prTrigger *s2hv1.PullRequestTrigger, prTriggerRPC *rpc.PullRequestTrigger) {
+func (c *controller) sendPullRequestTriggerReport(ctx context.Context, prTrigger *s2hv1.PullRequestTrigger,
+	prTriggerRPC *rpc.PullRequestTrigger) {
+
 	configCtrl := c.GetConfigController()
 
 	bundleName := prTrigger.Spec.BundleName
@@ -895,14 +999,19 @@ func (c *controller) sendPullRequestTriggerReport(prTrigger *s2hv1.PullRequestTr
 		prTriggerRpt := s2h.NewPullRequestTriggerResultReporter(prTrigger.Status, c.configs, prTriggerRPC.TeamName,
 			bundleName, prTrigger.Spec.PRNumber, prTriggerRPC.Result, noOfRetry, comps)
 
-		if err := reporter.SendPullRequestTriggerResult(configCtrl, prTriggerRpt); err != nil {
+		_, span := startReporterSpan(ctx, reporter, "SendPullRequestTriggerResult")
+		err := reporter.SendPullRequestTriggerResult(configCtrl, prTriggerRpt)
+		tracing.EndSpan(span, err)
+		if err != nil {
 			logger.Error(err, "cannot send pull request trigger result report",
 				"team", prTriggerRPC.TeamName, "bundle", bundleName, "prNumber", prNumber)
 		}
 	}
 }
 
-func (c *controller) sendPullRequestTestRunnerPendingReport(prQueue *s2hv1.PullRequestQueue, teamWithPR *rpc.TeamWithPullRequest) error {
+func (c *controller) sendPullRequestTestRunnerPendingReport(ctx context.Context, prQueue *s2hv1.PullRequestQueue,
+	teamWithPR *rpc.TeamWithPullRequest) error {
+
 	configCtrl := c.GetConfigController()
 
 	teamComp := &s2hv1.Team{}
@@ -924,7 +1033,10 @@ func (c *controller) sendPullRequestTestRunnerPendingReport(prQueue *s2hv1.PullR
 		prTriggerRpt := s2h.NewPullRequestTestRunnerPendingReporter(c.configs, teamWithPR.TeamName,
 			bundleName, prNumber, commitSHA, teamComp.Status.Used.Credential)
 
-		if err := reporter.SendPullRequestTestRunnerPendingResult(configCtrl, prTriggerRpt); err != nil {
+		_, span := startReporterSpan(ctx, reporter, "SendPullRequestTestRunnerPendingResult")
+		err := reporter.SendPullRequestTestRunnerPendingResult(configCtrl, prTriggerRpt)
+		tracing.EndSpan(span, err)
+		if err != nil {
 			logger.Error(err, "cannot send pull request trigger result report",
 				"team", teamWithPR.TeamName, "bundle", bundleName, "prNumber", prNumber)
 			return err
@@ -932,3 +1044,9 @@ func (c *controller) sendPullRequestTestRunnerPendingReport(prQueue *s2hv1.PullR
 	}
 	return nil
 }
+
+// startReporterSpan starts a span of sending the report by the reporter
+func startReporterSpan(ctx context.Context, reporter s2h.Reporter, method string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, fmt.Sprintf("reporter.%s", method),
+		attribute.String("reporter", reporter.GetName()))
+}
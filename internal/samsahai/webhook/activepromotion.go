@@ -2,14 +2,17 @@ package webhook
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/julienschmidt/httprouter"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 )
 
 type activePromotion struct {
@@ -250,3 +253,125 @@ func (h *handler) getActivePromotionHistoryListByDESC(teamName string) (*v1.Acti
 
 	return atpHistList, nil
 }
+
+type triggerActivePromotionJSON struct {
+	// +optional
+	SkipTestRunner bool `json:"skipTestRunner,omitempty"`
+	// +optional
+	TearDownDuration *metav1.Duration `json:"tearDownDuration,omitempty" swaggertype:"string" example:"30m"`
+	// +optional
+	NoDowntimeGuarantee *bool `json:"noDowntimeGuarantee,omitempty"`
+}
+
+// triggerTeamActivePromotion godoc
+// @Summary Trigger Active Promotion
+// @Description Creates a new active promotion of the team, the caller is recorded as a person who promoted.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Accept  json
+// @Param triggerActivePromotionJSON body webhook.triggerActivePromotionJSON false "Active promotion options"
+// @Success 201 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 409 {object} errResp "Active promotion already exists"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions [post]
+func (h *handler) triggerTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	var jsonData triggerActivePromotionJSON
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &jsonData); err != nil {
+			h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+			return
+		}
+	}
+
+	spec := v1.ActivePromotionSpec{
+		SkipTestRunner:      jsonData.SkipTestRunner,
+		TearDownDuration:    jsonData.TearDownDuration,
+		NoDowntimeGuarantee: jsonData.NoDowntimeGuarantee,
+		PromotedBy:          getActionBy(r),
+	}
+	if err := h.samsahai.TriggerActivePromotion(team.Name, spec); err != nil {
+		h.activePromotionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// cancelTeamActivePromotion godoc
+// @Summary Cancel Active Promotion
+// @Description Cancels the waiting or running active promotion of the team.
+// @Description Active promotion which is destroying namespaces or rolling back cannot be canceled.
+// @Tags DELETE
+// @Param team path string true "Team name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Success 204 {string} string
+// @Failure 404 {object} errResp "Team or active promotion not found"
+// @Failure 409 {object} errResp "Active promotion cannot be canceled"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions [delete]
+func (h *handler) cancelTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	if err := h.samsahai.CancelActivePromotion(team.Name, getActionBy(r)); err != nil {
+		h.activePromotionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// rollbackTeamActivePromotion godoc
+// @Summary Rollback Active Promotion
+// @Description Switches the active environment back to the previous active namespace
+// @Description while it has not been destroyed yet after promoting successfully.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Success 202 {string} string
+// @Failure 404 {object} errResp "Team or active promotion not found"
+// @Failure 409 {object} errResp "Active promotion cannot be rolled back"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/rollback [post]
+func (h *handler) rollbackTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	if err := h.samsahai.RollbackActivePromotion(team.Name, getActionBy(r)); err != nil {
+		h.activePromotionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *handler) activePromotionError(w http.ResponseWriter, err error) {
+	switch {
+	case s2herrors.Is(err, s2herrors.ErrActivePromotionNotFound), k8serrors.IsNotFound(err):
+		h.error(w, http.StatusNotFound, err)
+	case s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeCanceled),
+		s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeRolledBack),
+		k8serrors.IsAlreadyExists(err), k8serrors.IsConflict(err):
+		h.error(w, http.StatusConflict, err)
+	default:
+		logger.Error(err, "cannot manage activepromotion")
+		h.error(w, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+
+	s2h "github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+// AuthPolicy represents an authentication policy of a route
+type AuthPolicy string
+
+const (
+	// AuthPolicyPublic allows all requests to access the route
+	AuthPolicyPublic AuthPolicy = "public"
+	// AuthPolicyAuthenticated allows only authenticated and authorized requests to access the route
+	AuthPolicyAuthenticated AuthPolicy = "authenticated"
+)
+
+type contextKey string
+
+const authUserKey contextKey = "authUser"
+
+const (
+	webhookPathPrefix      = "/webhook/"
+	pullRequestTriggerPath = "/teams/:team/pullrequest/trigger"
+)
+
+var methodVerbs = map[string]string{
+	http.MethodGet:    "get",
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// handle registers the route with authentication middleware according to its policy
+func (h *handler) handle(r *httprouter.Router, method, path string, handle httprouter.Handle) {
+	r.Handle(method, path, h.authenticate(method, path, handle))
+}
+
+// getAuthPolicy returns policy of the route, read-only routes use read-only policy
+// while mutating routes always require authentication if not specified
+func (h *handler) getAuthPolicy(method, path string) AuthPolicy {
+	if policy, ok := h.routePolicies[routeKey(method, path)]; ok {
+		return policy
+	}
+
+	if method == http.MethodGet {
+		return h.readOnlyPolicy
+	}
+
+	return AuthPolicyAuthenticated
+}
+
+func (h *handler) authenticate(method, path string, handle httprouter.Handle) httprouter.Handle {
+	if len(h.authenticators) == 0 || h.getAuthPolicy(method, path) == AuthPolicyPublic {
+		return handle
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+		data, err := h.readRequestBody(w, r)
+		if err != nil {
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+		req := &s2h.AuthRequest{
+			Request: r,
+			Body:    data,
+			Verb:    methodVerbs[method],
+			Webhook: isWebhookRoute(path),
+		}
+
+		if params.ByName("team") != "" {
+			team, err := h.loadTeam(w, params)
+			if err != nil {
+				return
+			}
+
+			if err := h.samsahai.LoadTeamSecret(team); err != nil {
+				logger.Error(err, "cannot load team secret", "team", team.Name)
+				h.error(w, http.StatusInternalServerError, s2herrors.ErrInternalError)
+				return
+			}
+			req.Team = team
+		}
+
+		if err := h.authenticateRequest(req); err != nil {
+			statusCode := http.StatusUnauthorized
+			if s2herrors.IsErrForbidden(err) {
+				statusCode = http.StatusForbidden
+			}
+			h.error(w, statusCode, err)
+			return
+		}
+
+		handle(w, r.WithContext(context.WithValue(r.Context(), authUserKey, req.User)), params)
+	}
+}
+
+// authenticateRequest passes the request if any of authenticators accepts it,
+// otherwise the most relevant error is returned
+func (h *handler) authenticateRequest(req *s2h.AuthRequest) error {
+	authErr := s2herrors.ErrAuthTokenNotFound
+	for _, authenticator := range h.authenticators {
+		err := authenticator.Authenticate(req)
+		switch {
+		case err == nil:
+			return nil
+		case s2herrors.IsErrAuthTokenNotFound(err):
+		case s2herrors.IsErrForbidden(err):
+			authErr = s2herrors.ErrForbidden
+		default:
+			if !s2herrors.IsErrUnauthorized(err) {
+				logger.Error(err, "cannot authenticate request", "authenticator", authenticator.GetName())
+			}
+			if !s2herrors.IsErrForbidden(authErr) {
+				authErr = s2herrors.ErrUnauthorized
+			}
+		}
+	}
+
+	return authErr
+}
+
+// getAuthUser returns identity of the authenticated caller, empty string if the request was not authenticated
+func getAuthUser(r *http.Request) string {
+	user, _ := r.Context().Value(authUserKey).(string)
+	return user
+}
+
+// isWebhookRoute returns true if the route receives webhooks from external systems
+func isWebhookRoute(path string) bool {
+	return strings.HasPrefix(path, webhookPathPrefix) || path == pullRequestTriggerPath
+}
+
+func routeKey(method, path string) string {
+	return fmt.Sprintf("%s %s", method, path)
+}
@@ -0,0 +1,318 @@
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2h "github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/auth"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/gitevent"
+)
+
+const (
+	githubWebhookPath    = "/webhook/github"
+	gitlabWebhookPath    = "/webhook/gitlab"
+	bitbucketWebhookPath = "/webhook/bitbucket"
+	giteaWebhookPath     = "/webhook/gitea"
+
+	// defaultMaxExtendDuration is a maximum duration from now which pull request environments
+	// can be extended by the slash command
+	defaultMaxExtendDuration = 24 * time.Hour
+)
+
+type gitEventParser func(eventType string, data []byte) (*gitevent.PullRequestEvent, error)
+
+// githubWebhook godoc
+// @Summary Webhook For GitHub Pull Request Events
+// @Description Receives native github `pull_request` and `issue_comment` events of repositories
+// @Description which are configured in pull request bundles.
+// @Description New commits are deployed to pull request environments, closed pull requests are destroyed.
+// @Description Pull request comments with slash commands e.g. `/s2h redeploy`, `/s2h retest`, `/s2h extend 4h`,
+// @Description `/s2h destroy` and `/s2h use svc-a=1.2.3` control the pull request environments.
+// @Description `X-Hub-Signature-256` is verified with the webhook secret of the team or samsahai.
+// @Tags POST
+// @Accept  json
+// @Param X-GitHub-Event header string true "GitHub event type"
+// @Param X-Hub-Signature-256 header string true "GitHub signature"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Invalid signature"
+// @Failure 500 {object} errResp
+// @Router /webhook/github [post]
+func (h *handler) githubWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.gitPullRequestWebhook(w, r, r.Header.Get(gitevent.GithubEventHeader), gitevent.ParseGithub,
+		auth.NewGithubSignature(h.webhookSecret), s2hv1.PullRequestGitProviderGithub)
+}
+
+// gitlabWebhook godoc
+// @Summary Webhook For GitLab Merge Request Events
+// @Description Receives native gitlab `Merge Request Hook` and `Note Hook` events of projects
+// @Description which are configured in pull request bundles.
+// @Description New commits are deployed to pull request environments, closed or merged requests are destroyed.
+// @Description Merge request comments with slash commands control the pull request environments.
+// @Description `X-Gitlab-Token` is verified with the webhook secret of the team or samsahai.
+// @Tags POST
+// @Accept  json
+// @Param X-Gitlab-Event header string true "GitLab event type"
+// @Param X-Gitlab-Token header string true "GitLab secret token"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Invalid secret token"
+// @Failure 500 {object} errResp
+// @Router /webhook/gitlab [post]
+func (h *handler) gitlabWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.gitPullRequestWebhook(w, r, r.Header.Get(gitevent.GitlabEventHeader), gitevent.ParseGitlab,
+		auth.NewGitlabToken(h.webhookSecret), s2hv1.PullRequestGitProviderGitlab)
+}
+
+// bitbucketWebhook godoc
+// @Summary Webhook For Bitbucket Server Pull Request Events
+// @Description Receives native bitbucket server `pr:opened`, `pr:from_ref_updated`, `pr:merged`, `pr:declined`
+// @Description and `pr:deleted` events of repositories which are configured in pull request bundles.
+// @Description Repositories are matched by `<project key>/<repository slug>` of the target repository.
+// @Description New commits are deployed to pull request environments, merged or declined pull requests are destroyed.
+// @Description `X-Hub-Signature` is verified with the webhook secret of the team or samsahai.
+// @Tags POST
+// @Accept  json
+// @Param X-Event-Key header string true "Bitbucket Server event key"
+// @Param X-Hub-Signature header string true "Bitbucket Server signature"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Invalid signature"
+// @Failure 500 {object} errResp
+// @Router /webhook/bitbucket [post]
+func (h *handler) bitbucketWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.gitPullRequestWebhook(w, r, r.Header.Get(gitevent.BitbucketEventHeader), gitevent.ParseBitbucket,
+		auth.NewBitbucketSignature(h.webhookSecret), s2hv1.PullRequestGitProviderBitbucket)
+}
+
+// giteaWebhook godoc
+// @Summary Webhook For Gitea Pull Request Events
+// @Description Receives native gitea `pull_request` events of repositories which are configured in pull request bundles.
+// @Description New commits are deployed to pull request environments, closed pull requests are destroyed.
+// @Description `X-Gitea-Signature` is verified with the webhook secret of the team or samsahai.
+// @Tags POST
+// @Accept  json
+// @Param X-Gitea-Event header string true "Gitea event type"
+// @Param X-Gitea-Signature header string true "Gitea signature"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Invalid signature"
+// @Failure 500 {object} errResp
+// @Router /webhook/gitea [post]
+func (h *handler) giteaWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.gitPullRequestWebhook(w, r, r.Header.Get(gitevent.GiteaEventHeader), gitevent.ParseGitea,
+		auth.NewGiteaSignature(h.webhookSecret), s2hv1.PullRequestGitProviderGitea)
+}
+
+// gitPullRequestWebhook applies the pull request event to all matched bundles of the teams
+// which the request has been verified by the authenticator
+func (h *handler) gitPullRequestWebhook(w http.ResponseWriter, r *http.Request, eventType string,
+	parse gitEventParser, authenticator s2h.Authenticator, provider s2hv1.PullRequestGitProvider) {
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	event, err := parse(eventType, data)
+	if err != nil {
+		h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+		return
+	}
+	if event == nil {
+		// the event is not related to pull request deployment
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	teamBundles, err := h.samsahai.GetPullRequestBundlesByRepository(event.Repository, event.ProjectID)
+	if err != nil {
+		h.error(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	verified := false
+	for teamName, bundles := range teamBundles {
+		team := &s2hv1.Team{}
+		if err := h.samsahai.GetTeam(teamName, team); err != nil {
+			logger.Error(err, "cannot get team", "team", teamName)
+			continue
+		}
+
+		if err := h.samsahai.LoadTeamSecret(team); err != nil {
+			logger.Error(err, "cannot load team secret", "team", teamName)
+			continue
+		}
+
+		req := &s2h.AuthRequest{Request: r, Body: data, Team: team, Webhook: true}
+		if err := authenticator.Authenticate(req); err != nil {
+			logger.Warn("cannot verify git webhook", "authenticator", authenticator.GetName(),
+				"team", teamName, "repository", event.Repository, "error", err.Error())
+			continue
+		}
+		verified = true
+
+		if event.Action == gitevent.ActionComment {
+			h.applyPullRequestCommand(teamName, bundles, provider, event)
+			continue
+		}
+
+		for _, bundle := range bundles {
+			if err := h.applyPullRequestEvent(teamName, bundle, event); err != nil {
+				logger.Error(err, "cannot apply pull request event", "team", teamName,
+					"bundle", bundle, "prNumber", event.PRNumber)
+				h.error(w, http.StatusInternalServerError, err)
+				return
+			}
+		}
+	}
+
+	if len(teamBundles) > 0 && !verified {
+		h.error(w, http.StatusUnauthorized, s2herrors.ErrUnauthorized)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) applyPullRequestEvent(teamName, bundleName string, event *gitevent.PullRequestEvent) error {
+	logger.Info("received pull request event", "team", teamName, "bundle", bundleName,
+		"prNumber", event.PRNumber, "commitSHA", event.CommitSHA, "action", event.Action)
+
+	switch event.Action {
+	case gitevent.ActionClose:
+		return h.samsahai.DeletePullRequestDeployment(teamName, bundleName, event.PRNumber)
+	default:
+		return h.samsahai.TriggerPullRequestDeployment(teamName, bundleName, event.PRNumber, event.CommitSHA,
+			nil, nil, nil)
+	}
+}
+
+// applyPullRequestCommand runs the slash command of the comment on all matched bundles of the team
+// and replies the results to the pull request
+func (h *handler) applyPullRequestCommand(teamName string, bundles []string, provider s2hv1.PullRequestGitProvider,
+	event *gitevent.PullRequestEvent) {
+
+	results := make([]string, 0)
+	cmdConfig := h.getPullRequestCommandConfig(teamName)
+	cmd, err := gitevent.ParseCommand(event.Comment)
+	switch {
+	case err != nil:
+		results = append(results, fmt.Sprintf("cannot run command: %s, %s", err.Error(), gitevent.CommandUsage))
+	case cmd.Name.IsRestricted() && !isCommandAllowed(event, cmdConfig):
+		logger.Warn("pull request command is not allowed", "team", teamName, "prNumber", event.PRNumber,
+			"command", cmd.Name, "by", event.Author)
+		results = append(results, fmt.Sprintf("`%s` is allowed only for collaborators of the repository "+
+			"and allowed users, %s is not allowed", cmd.Name, event.Author))
+	default:
+		logger.Info("received pull request command", "team", teamName, "prNumber", event.PRNumber,
+			"command", cmd.Name, "by", event.Author)
+
+		for _, bundle := range bundles {
+			result, err := h.runPullRequestCommand(teamName, bundle, cmd, event, cmdConfig)
+			if err != nil {
+				logger.Error(err, "cannot run pull request command", "team", teamName,
+					"bundle", bundle, "prNumber", event.PRNumber, "command", cmd.Name)
+				result = fmt.Sprintf("cannot run `%s`: %s", cmd.Name, err.Error())
+			}
+			results = append(results, fmt.Sprintf("- `%s`: %s", bundle, result))
+		}
+	}
+
+	repository := event.Repository
+	if provider == s2hv1.PullRequestGitProviderGitlab && event.ProjectID != "" {
+		repository = event.ProjectID
+	}
+
+	reply := fmt.Sprintf("Samsahai (team `%s`)\n%s", teamName, strings.Join(results, "\n"))
+	if err := h.samsahai.CommentPullRequest(teamName, provider, repository, event.PRNumber, reply); err != nil {
+		logger.Error(err, "cannot reply pull request command", "team", teamName,
+			"repository", repository, "prNumber", event.PRNumber)
+	}
+}
+
+func (h *handler) runPullRequestCommand(teamName, bundleName string, cmd *gitevent.Command,
+	event *gitevent.PullRequestEvent, cmdConfig *s2hv1.PullRequestCommandConfig) (string, error) {
+
+	switch cmd.Name {
+	case gitevent.CommandRedeploy:
+		err := h.samsahai.RedeployPullRequestDeployment(teamName, bundleName, event.PRNumber, event.CommitSHA, nil)
+		return "pull request has been triggered to redeploy", err
+	case gitevent.CommandUse:
+		err := h.samsahai.RedeployPullRequestDeployment(teamName, bundleName, event.PRNumber, event.CommitSHA,
+			cmd.Components)
+		return "pull request has been triggered to redeploy with the overridden versions", err
+	case gitevent.CommandRetest:
+		err := h.samsahai.RetestPullRequestQueue(teamName, bundleName, event.PRNumber, event.Author)
+		return "pull request environment is being retested", err
+	case gitevent.CommandExtend:
+		prQueue, err := h.samsahai.ExtendPullRequestEnvironment(teamName, bundleName, event.PRNumber,
+			cmd.Duration, getMaxExtendDuration(cmdConfig))
+		if err != nil {
+			return "", err
+		}
+		return getExtendResult(prQueue), nil
+	case gitevent.CommandDestroy:
+		err := h.samsahai.DeletePullRequestDeployment(teamName, bundleName, event.PRNumber)
+		return "pull request environment is being destroyed", err
+	}
+
+	return "", fmt.Errorf("unknown command %q", cmd.Name)
+}
+
+// getExtendResult returns the result of extending the pull request environment from its destroyed time,
+// or from its teardown duration if the pull request queue is still running
+func getExtendResult(prQueue *s2hv1.PullRequestQueue) string {
+	if prQueue.Status.DestroyedTime != nil {
+		return fmt.Sprintf("pull request environment will be destroyed at %s",
+			prQueue.Status.DestroyedTime.UTC().Format("2006-01-02 15:04:05 MST"))
+	}
+
+	return fmt.Sprintf("pull request environment will be kept for %s after the testing finished",
+		prQueue.Spec.TearDownDuration.Duration.Duration)
+}
+
+// getPullRequestCommandConfig returns the slash command configuration of the team, nil if not defined
+func (h *handler) getPullRequestCommandConfig(teamName string) *s2hv1.PullRequestCommandConfig {
+	prConfig, err := h.samsahai.GetConfigController().GetPullRequestConfig(teamName)
+	if err != nil || prConfig == nil {
+		return nil
+	}
+
+	return prConfig.Commands
+}
+
+// isCommandAllowed returns true if the author of the comment is a collaborator of the repository
+// or one of the allowed users
+func isCommandAllowed(event *gitevent.PullRequestEvent, cmdConfig *s2hv1.PullRequestCommandConfig) bool {
+	if event.IsCollaborator {
+		return true
+	}
+
+	if cmdConfig == nil || event.Author == "" {
+		return false
+	}
+
+	for _, user := range cmdConfig.AllowedUsers {
+		if strings.EqualFold(user, event.Author) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getMaxExtendDuration(cmdConfig *s2hv1.PullRequestCommandConfig) time.Duration {
+	if cmdConfig == nil || cmdConfig.MaxExtendDuration == nil || cmdConfig.MaxExtendDuration.Duration <= 0 {
+		return defaultMaxExtendDuration
+	}
+
+	return cmdConfig.MaxExtendDuration.Duration
+}
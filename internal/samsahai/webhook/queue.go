@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+type enqueueComponentJSON struct {
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	// +optional
+	AtTop bool `json:"atTop,omitempty"`
+}
+
+// enqueueComponent godoc
+// @Summary Enqueue Component
+// @Description Manually adds a specific component version to the staging queue.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Accept  json
+// @Param enqueueComponentJSON body webhook.enqueueComponentJSON true "Component version"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON or component not found"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue [post]
+func (h *handler) enqueueComponent(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	var jsonData enqueueComponentJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+		return
+	}
+
+	if jsonData.Component == "" || jsonData.Version == "" {
+		h.error(w, http.StatusBadRequest, fmt.Errorf("must define component and version"))
+		return
+	}
+
+	err = h.samsahai.EnqueueComponent(team.Name, team.Status.Namespace.Staging, jsonData.Component,
+		jsonData.Version, getActionBy(r), jsonData.AtTop)
+	if err != nil {
+		h.queueError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// moveTeamQueueToTop godoc
+// @Summary Move Queue To Top
+// @Description Moves the waiting queue to the top of the staging queue.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param queue path string true "Queue name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Success 204 {string} string
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 409 {object} errResp "Queue is running"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/{queue}/top [post]
+func (h *handler) moveTeamQueueToTop(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	err = h.samsahai.MoveQueueToTop(team.Status.Namespace.Staging, params.ByName("queue"), getActionBy(r))
+	if err != nil {
+		h.queueError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// cancelTeamQueue godoc
+// @Summary Cancel Queue
+// @Description Cancels the running queue.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param queue path string true "Queue name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Success 204 {string} string
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 409 {object} errResp "Queue is not running"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/{queue}/cancel [post]
+func (h *handler) cancelTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	err = h.samsahai.CancelQueue(team.Status.Namespace.Staging, params.ByName("queue"), getActionBy(r))
+	if err != nil {
+		h.queueError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reverifyTeamQueue godoc
+// @Summary Reverify Queue
+// @Description Forces the waiting queue to be reverified at first.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param queue path string true "Queue name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Success 204 {string} string
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 409 {object} errResp "Queue is running"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/{queue}/reverify [post]
+func (h *handler) reverifyTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	err = h.samsahai.ReverifyQueue(team.Status.Namespace.Staging, params.ByName("queue"), getActionBy(r))
+	if err != nil {
+		h.queueError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeTeamQueue godoc
+// @Summary Remove Queue
+// @Description Removes the waiting queue from the staging queue.
+// @Tags DELETE
+// @Param team path string true "Team name"
+// @Param queue path string true "Queue name"
+// @Param action_by query string false "Action by, ignored if the request is authenticated"
+// @Success 204 {string} string
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 409 {object} errResp "Queue is running"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/{queue} [delete]
+func (h *handler) removeTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	err = h.samsahai.RemoveQueue(team.Status.Namespace.Staging, params.ByName("queue"), getActionBy(r))
+	if err != nil {
+		h.queueError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) queueError(w http.ResponseWriter, err error) {
+	switch {
+	case k8serrors.IsNotFound(err):
+		h.error(w, http.StatusNotFound, fmt.Errorf("queue not found"))
+	case s2herrors.Is(err, s2herrors.ErrComponentNotFound):
+		h.error(w, http.StatusBadRequest, err)
+	case s2herrors.Is(err, s2herrors.ErrQueueRunning), s2herrors.Is(err, s2herrors.ErrQueueNotRunning),
+		k8serrors.IsConflict(err):
+		h.error(w, http.StatusConflict, err)
+	default:
+		logger.Error(err, "cannot manage queue")
+		h.error(w, http.StatusInternalServerError, err)
+	}
+}
+
+// getActionBy returns identity of the authenticated caller,
+// a person who takes the action from `action_by` query is used only if the request was not authenticated
+func getActionBy(r *http.Request) string {
+	if user := getAuthUser(r); user != "" {
+		return user
+	}
+	return r.URL.Query().Get("action_by")
+}
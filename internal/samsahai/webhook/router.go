@@ -17,54 +17,118 @@ import (
 var logger = s2hlog.S2HLog.WithName("webhook")
 
 type handler struct {
-	samsahai s2h.SamsahaiController
+	samsahai       s2h.SamsahaiController
+	authenticators []s2h.Authenticator
+	readOnlyPolicy AuthPolicy
+	routePolicies  map[string]AuthPolicy
+	webhookSecret  string
 }
 
-func New(samsahaiCtrl s2h.SamsahaiController) *httprouter.Router {
+// Option allows specifying various configuration
+type Option func(*handler)
+
+// WithAuthenticators specifies authenticators of the routes,
+// all routes are public if there is no authenticator
+func WithAuthenticators(authenticators ...s2h.Authenticator) Option {
+	return func(h *handler) {
+		h.authenticators = append(h.authenticators, authenticators...)
+	}
+}
+
+// WithReadOnlyAuthPolicy specifies authentication policy of read-only routes, default is public
+func WithReadOnlyAuthPolicy(policy AuthPolicy) Option {
+	return func(h *handler) {
+		h.readOnlyPolicy = policy
+	}
+}
+
+// WithRouteAuthPolicy overrides authentication policy of the specific route e.g. GET /teams/:team/config
+func WithRouteAuthPolicy(method, path string, policy AuthPolicy) Option {
+	return func(h *handler) {
+		h.routePolicies[routeKey(method, path)] = policy
+	}
+}
+
+// WithWebhookSecret specifies the global secret for verifying github and gitlab webhooks,
+// the webhook secret of the team takes precedence
+func WithWebhookSecret(secret string) Option {
+	return func(h *handler) {
+		h.webhookSecret = secret
+	}
+}
+
+func New(samsahaiCtrl s2h.SamsahaiController, opts ...Option) *httprouter.Router {
 	h := handler{
-		samsahai: samsahaiCtrl,
+		samsahai:       samsahaiCtrl,
+		readOnlyPolicy: AuthPolicyPublic,
+		routePolicies:  make(map[string]AuthPolicy),
 	}
+	for _, opt := range opts {
+		opt(&h)
+	}
+
 	r := httprouter.New()
 	h.bind(r)
 	return r
 }
 
 func (h *handler) bind(r *httprouter.Router) {
+	// version and health check are always public
 	r.GET(s2h.URIVersion, h.getVersion)
 	r.GET(s2h.URIHealthz, h.getHealthz)
 
-	r.POST("/webhook/component", h.newComponentWebhook)
+	h.handle(r, http.MethodPost, "/webhook/component", h.newComponentWebhook)
+
+	// signature of git webhooks is verified by the receivers
+	r.POST(githubWebhookPath, h.githubWebhook)
+	r.POST(gitlabWebhookPath, h.gitlabWebhook)
+	r.POST(bitbucketWebhookPath, h.bitbucketWebhook)
+	r.POST(giteaWebhookPath, h.giteaWebhook)
 
 	// route from plugins
 	plugins := h.samsahai.GetPlugins()
 	for k := range plugins {
 		p := plugins[k]
-		r.POST(fmt.Sprintf("/webhook/%s", p.GetName()), pluginWebhookFunc(h, p))
+		path := fmt.Sprintf("/webhook/%s", p.GetName())
+		if path == githubWebhookPath || path == gitlabWebhookPath ||
+			path == bitbucketWebhookPath || path == giteaWebhookPath {
+			logger.Warn("plugin name conflicts with git webhook receiver", "plugin", p.GetName())
+			continue
+		}
+		h.handle(r, http.MethodPost, path, pluginWebhookFunc(h, p))
 	}
 
-	r.GET("/teams", h.getTeams)
-	r.GET("/teams/:team", h.getTeam)
-	r.GET("/teams/:team/config", h.getTeamConfig)
-	r.GET("/teams/:team/components", h.getTeamComponent)
-	r.GET("/teams/:team/queue", h.getTeamQueue)
-	r.GET("/teams/:team/queue/histories/:queue", h.getTeamQueueHistory)
-	r.GET("/teams/:team/queue/histories/:queue/log", h.getTeamQueueHistoryLog)
-
-	r.GET("/teams/:team/components/:component/values", h.getTeamComponentStableValues)
-
-	r.DELETE("/teams/:team/environment/active/delete", h.deleteTeamActiveEnvironment)
-
-	r.GET("/teams/:team/activepromotions", h.getTeamActivePromotions)
-	r.GET("/teams/:team/activepromotions/histories", h.getTeamActivePromotionHistories)
-	r.GET("/teams/:team/activepromotions/histories/:history", h.getTeamActivePromotionHistory)
-	r.GET("/teams/:team/activepromotions/histories/:history/log", h.getTeamActivePromotionHistoryLog)
-
-	r.POST("/teams/:team/pullrequest/trigger", h.pullRequestWebhook)
-	r.GET("/teams/:team/pullrequest/queue", h.getTeamPullRequestQueue)
-	r.GET("/teams/:team/pullrequest/queue/histories/:queue", h.getTeamPullRequestQueueHistory)
-	r.GET("/teams/:team/pullrequest/queue/histories/:queue/log", h.getTeamPullRequestQueueHistoryLog)
+	h.handle(r, http.MethodGet, "/teams", h.getTeams)
+	h.handle(r, http.MethodGet, "/teams/:team", h.getTeam)
+	h.handle(r, http.MethodGet, "/teams/:team/config", h.getTeamConfig)
+	h.handle(r, http.MethodGet, "/teams/:team/components", h.getTeamComponent)
+	h.handle(r, http.MethodGet, "/teams/:team/queue", h.getTeamQueue)
+	h.handle(r, http.MethodGet, "/teams/:team/queue/histories/:queue", h.getTeamQueueHistory)
+	h.handle(r, http.MethodGet, "/teams/:team/queue/histories/:queue/log", h.getTeamQueueHistoryLog)
+	h.handle(r, http.MethodPost, "/teams/:team/queue", h.enqueueComponent)
+	h.handle(r, http.MethodPost, "/teams/:team/queue/:queue/top", h.moveTeamQueueToTop)
+	h.handle(r, http.MethodPost, "/teams/:team/queue/:queue/cancel", h.cancelTeamQueue)
+	h.handle(r, http.MethodPost, "/teams/:team/queue/:queue/reverify", h.reverifyTeamQueue)
+	h.handle(r, http.MethodDelete, "/teams/:team/queue/:queue", h.removeTeamQueue)
+
+	h.handle(r, http.MethodGet, "/teams/:team/components/:component/values", h.getTeamComponentStableValues)
+
+	h.handle(r, http.MethodDelete, "/teams/:team/environment/active/delete", h.deleteTeamActiveEnvironment)
+
+	h.handle(r, http.MethodGet, "/teams/:team/activepromotions", h.getTeamActivePromotions)
+	h.handle(r, http.MethodPost, "/teams/:team/activepromotions", h.triggerTeamActivePromotion)
+	h.handle(r, http.MethodDelete, "/teams/:team/activepromotions", h.cancelTeamActivePromotion)
+	h.handle(r, http.MethodPost, "/teams/:team/activepromotions/rollback", h.rollbackTeamActivePromotion)
+	h.handle(r, http.MethodGet, "/teams/:team/activepromotions/histories", h.getTeamActivePromotionHistories)
+	h.handle(r, http.MethodGet, "/teams/:team/activepromotions/histories/:history", h.getTeamActivePromotionHistory)
+	h.handle(r, http.MethodGet, "/teams/:team/activepromotions/histories/:history/log", h.getTeamActivePromotionHistoryLog)
+
+	h.handle(r, http.MethodPost, pullRequestTriggerPath, h.pullRequestWebhook)
+	h.handle(r, http.MethodGet, "/teams/:team/pullrequest/queue", h.getTeamPullRequestQueue)
+	h.handle(r, http.MethodGet, "/teams/:team/pullrequest/queue/histories/:queue", h.getTeamPullRequestQueueHistory)
+	h.handle(r, http.MethodGet, "/teams/:team/pullrequest/queue/histories/:queue/log", h.getTeamPullRequestQueueHistoryLog)
 	////
-	r.GET("/activepromotions", h.getActivePromotions)
+	h.handle(r, http.MethodGet, "/activepromotions", h.getActivePromotions)
 
 	//r.GET("/teams/:team/queue/:queue", h.getTeamQueue)
 	//r.GET("/teams/:team/queue/:queue/logs", h.getTeamQueue)
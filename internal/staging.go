@@ -29,6 +29,13 @@ type StagingTestRunner interface {
 	IsTriggered(queue *s2hv1.Queue) bool
 }
 
+// StagingTestReportFetcher is implemented by test runners which are able to fetch test results of the build
+type StagingTestReportFetcher interface {
+	// FetchTestReport makes http request to get summary of test results of the build
+	// It returns nil if the build has no test results
+	FetchTestReport(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (*s2hv1.TestReport, error)
+}
+
 type StagingController interface {
 	// should implement RPC
 	stagingrpc.RPC
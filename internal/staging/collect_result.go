@@ -52,6 +52,10 @@ func (c *controller) collectResult(queue *s2hv1.Queue) error {
 		return err
 	}
 
+	if err := c.collectTestReport(queue); err != nil {
+		return err
+	}
+
 	// Queue will finished if type are Active promotion related
 	if queue.IsActivePromotionQueue() || queue.IsPullRequestQueue() {
 		return c.updateQueueWithState(queue, s2hv1.Finished)
@@ -66,6 +70,11 @@ func (c *controller) collectResult(queue *s2hv1.Queue) error {
 		return err
 	}
 
+	// re-run only the testing if the environment is healthy
+	if isRetesting, err := c.retestQueue(queue); err != nil || isRetesting {
+		return err
+	}
+
 	queue.Status.SetCondition(s2hv1.QueueCleaningAfterStarted, corev1.ConditionTrue,
 		"starts cleaning the namespace after running task")
 
@@ -347,6 +356,10 @@ func (c *controller) createDeploymentZipLogs(q *s2hv1.Queue) (string, error) {
 		}
 	}
 
+	for _, jobName := range getTestJobNames(q) {
+		appendTestJobLogsToZip(zipw, jobName, pods, extraArg)
+	}
+
 	if err = zipw.Close(); err != nil {
 		logger.Warn("error while closing zip: %+v", err)
 	}
@@ -363,6 +376,42 @@ func (c *controller) createDeploymentZipLogs(q *s2hv1.Queue) (string, error) {
 	return base64.URLEncoding.EncodeToString(b), nil
 }
 
+// getTestJobNames returns names of test jobs of the queue and its test stages
+func getTestJobNames(q *s2hv1.Queue) []string {
+	jobNames := make([]string, 0)
+	if jobName := q.Status.TestRunner.K8sJob.JobName; jobName != "" {
+		jobNames = append(jobNames, jobName)
+	}
+
+	for _, stage := range q.Status.TestStages {
+		if jobName := stage.TestRunner.K8sJob.JobName; jobName != "" {
+			jobNames = append(jobNames, jobName)
+		}
+	}
+
+	return jobNames
+}
+
+// appendTestJobLogsToZip appends description and logs of all pods of the test job
+func appendTestJobLogsToZip(zipw *zip.Writer, jobName string, pods *corev1.PodList, extraArg string) {
+	jobDesc := execCommand("kubectl",
+		strings.Split(fmt.Sprintf("describe job %s%s", jobName, extraArg), " ")...)
+	appendFileToZip(zipw, fmt.Sprintf("kube.describe.job.%s.txt", jobName), jobDesc)
+
+	cmdLogPod := "logs %s -c %s --tail=1000 --timestamps%s"
+	for _, pod := range pods.Items {
+		if pod.Labels["job-name"] != jobName {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			podLog := execCommand("kubectl",
+				strings.Split(fmt.Sprintf(cmdLogPod, pod.Name, container.Name, extraArg), " ")...)
+			appendFileToZip(zipw, fmt.Sprintf("test.log.%s.container.%s.txt", pod.Name, container.Name), podLog)
+		}
+	}
+}
+
 func appendFileToZip(w *zip.Writer, filename string, data []byte) {
 	if data == nil {
 		logger.Warnf("no data to zip: %s", filename)
@@ -392,7 +441,7 @@ func (c *controller) sendComponentUpgradeReport(status rpc.ComponentUpgrade_Upgr
 	var err error
 	headers := make(http.Header)
 	headers.Set(internal.SamsahaiAuthHeader, c.authToken)
-	ctx := context.TODO()
+	ctx := c.getTraceContext()
 	ctx, err = twirp.WithHTTPRequestHeaders(ctx, headers)
 	if err != nil {
 		return errors.Wrap(err, "cannot set request header")
@@ -455,104 +504,18 @@ func (c *controller) extractDeploymentIssues(pods *corev1.PodList, jobs *batchv1
 	issuesMaps map[s2hv1.DeploymentIssueType][]s2hv1.FailureComponent) {
 
 	for _, pod := range pods.Items {
-		compName := c.extractComponentNameFromPod(pod)
-		failureComp := s2hv1.FailureComponent{
-			ComponentName: compName,
-			NodeName:      pod.Spec.NodeName,
-		}
-
-		// ignore job pod
-		jobFound := false
-		for _, podRef := range pod.OwnerReferences {
-			if strings.ToLower(podRef.Kind) == "job" {
-				jobFound = true
-				break
-			}
+		issueType, containerName, restartCount := GetPodDeploymentIssue(pod)
+		if issueType == "" {
+			continue
 		}
 
-		if !jobFound {
-			// check init container issue
-			initContainerStatuses := pod.Status.InitContainerStatuses
-			initFound := false
-			for _, initContainerStatus := range initContainerStatuses {
-				if !initContainerStatus.Ready {
-					failureComp.FirstFailureContainerName = initContainerStatus.Name
-					failureComp.RestartCount = initContainerStatus.RestartCount
-					c.appendDeploymentIssues(s2hv1.DeploymentIssueWaitForInitContainer, failureComp, issuesMaps)
-					initFound = true
-					break
-				}
-			}
-			if initFound {
-				continue
-			}
-
-			containerStatuses := pod.Status.ContainerStatuses
-			found := false
-			for _, containerStatus := range containerStatuses {
-				if !containerStatus.Ready {
-					failureComp.FirstFailureContainerName = containerStatus.Name
-					failureComp.RestartCount = containerStatus.RestartCount
-
-					waitingState := containerStatus.State.Waiting
-					if waitingState != nil {
-						switch waitingState.Reason {
-						// check ImagePullBackOff issue
-						case "ImagePullBackOff", "ErrImagePull":
-							c.appendDeploymentIssues(s2hv1.DeploymentIssueImagePullBackOff, failureComp, issuesMaps)
-							found = true
-
-						case "CrashLoopBackOff", "Error":
-							c.appendDeploymentIssues(s2hv1.DeploymentIssueCrashLoopBackOff, failureComp, issuesMaps)
-							found = true
-
-						case "ContainerCreating":
-							c.appendDeploymentIssues(s2hv1.DeploymentIssueContainerCreating, failureComp, issuesMaps)
-							found = true
-						}
-					}
-
-					if found {
-						break
-					}
-
-					runningState := containerStatus.State.Running
-					if runningState != nil {
-						found = true
-
-						// if running 0/1, count as CrashLoopBackOff
-						if containerStatus.RestartCount > 0 {
-							c.appendDeploymentIssues(s2hv1.DeploymentIssueCrashLoopBackOff, failureComp, issuesMaps)
-							break
-						}
-
-						c.appendDeploymentIssues(s2hv1.DeploymentIssueReadinessProbeFailed, failureComp, issuesMaps)
-						found = true
-						break
-					}
-
-					c.appendDeploymentIssues(s2hv1.DeploymentIssueUndefined, failureComp, issuesMaps)
-					found = true
-					break
-				}
-			}
-
-			if found {
-				continue
-			}
-
-			// check pod pending issue
-			if pod.Status.Phase == corev1.PodPending {
-				c.appendDeploymentIssues(s2hv1.DeploymentIssuePending, failureComp, issuesMaps)
-				continue
-			}
-
-			// for other not running pod will be shown as undefined type
-			if pod.Status.Phase != corev1.PodRunning {
-				c.appendDeploymentIssues(s2hv1.DeploymentIssueUndefined, failureComp, issuesMaps)
-				continue
-			}
+		failureComp := s2hv1.FailureComponent{
+			ComponentName:             c.extractComponentNameFromPod(pod),
+			FirstFailureContainerName: containerName,
+			RestartCount:              restartCount,
+			NodeName:                  pod.Spec.NodeName,
 		}
+		c.appendDeploymentIssues(issueType, failureComp, issuesMaps)
 	}
 
 	// check job not complete issue
@@ -568,6 +531,70 @@ func (c *controller) extractDeploymentIssues(pods *corev1.PodList, jobs *batchv1
 	}
 }
 
+// GetPodDeploymentIssue returns the deployment issue of the pod with the first failure container,
+// the issue type is empty if the pod has no issues, pods of jobs are ignored
+func GetPodDeploymentIssue(pod corev1.Pod) (
+	issueType s2hv1.DeploymentIssueType, containerName string, restartCount int32) {
+
+	for _, podRef := range pod.OwnerReferences {
+		if strings.ToLower(podRef.Kind) == "job" {
+			return
+		}
+	}
+
+	// check init container issue
+	for _, initContainerStatus := range pod.Status.InitContainerStatuses {
+		if !initContainerStatus.Ready {
+			return s2hv1.DeploymentIssueWaitForInitContainer, initContainerStatus.Name, initContainerStatus.RestartCount
+		}
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if !containerStatus.Ready {
+			return getContainerDeploymentIssue(containerStatus), containerStatus.Name, containerStatus.RestartCount
+		}
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning:
+		return
+	// check pod pending issue
+	case corev1.PodPending:
+		return s2hv1.DeploymentIssuePending, "", 0
+	// for other not running pod will be shown as undefined type
+	default:
+		return s2hv1.DeploymentIssueUndefined, "", 0
+	}
+}
+
+func getContainerDeploymentIssue(containerStatus corev1.ContainerStatus) s2hv1.DeploymentIssueType {
+	waitingState := containerStatus.State.Waiting
+	if waitingState != nil {
+		switch waitingState.Reason {
+		// check ImagePullBackOff issue
+		case "ImagePullBackOff", "ErrImagePull":
+			return s2hv1.DeploymentIssueImagePullBackOff
+
+		case "CrashLoopBackOff", "Error":
+			return s2hv1.DeploymentIssueCrashLoopBackOff
+
+		case "ContainerCreating":
+			return s2hv1.DeploymentIssueContainerCreating
+		}
+	}
+
+	if containerStatus.State.Running != nil {
+		// if running 0/1, count as CrashLoopBackOff
+		if containerStatus.RestartCount > 0 {
+			return s2hv1.DeploymentIssueCrashLoopBackOff
+		}
+
+		return s2hv1.DeploymentIssueReadinessProbeFailed
+	}
+
+	return s2hv1.DeploymentIssueUndefined
+}
+
 func (c *controller) extractComponentNameFromPod(pod corev1.Pod) string {
 	compName := pod.Name
 	for _, podRef := range pod.OwnerReferences {
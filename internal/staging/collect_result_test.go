@@ -378,4 +378,22 @@ var _ = Describe("Collect result", func() {
 			})
 		})
 	})
+
+	Describe("Get test job names", func() {
+		g := NewWithT(GinkgoT())
+
+		It("should get job names of the queue and its test stages", func() {
+			q := &s2hv1.Queue{
+				Status: s2hv1.QueueStatus{
+					TestRunner: s2hv1.TestRunner{K8sJob: s2hv1.K8sJob{JobName: "redis-test-abcde"}},
+					TestStages: []s2hv1.TestStage{
+						{Name: "smoke", TestRunner: s2hv1.TestRunner{K8sJob: s2hv1.K8sJob{JobName: "redis-smoke-test-fghij"}}},
+						{Name: "e2e"},
+					},
+				},
+			}
+
+			g.Expect(getTestJobNames(q)).To(Equal([]string{"redis-test-abcde", "redis-smoke-test-fghij"}))
+		})
+	})
 })
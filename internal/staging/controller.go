@@ -24,9 +24,14 @@ import (
 	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	s2hobject "github.com/agoda-com/samsahai/internal/samsahai/k8sobject"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/helm3"
+	"github.com/agoda-com/samsahai/internal/staging/deploy/manifest"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/mock"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/gitlab"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/jenkins"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/k8sjob"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/rest"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/teamcity"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/testmock"
 	samsahairpc "github.com/agoda-com/samsahai/pkg/samsahai/rpc"
@@ -68,6 +73,8 @@ type controller struct {
 	gitlabToken   string
 
 	configs internal.StagingConfig
+
+	spans queueSpans
 }
 
 // TODO: move test runner config to be optional
@@ -169,6 +176,7 @@ func (c *controller) process() bool {
 
 	// no queue
 	if c.getCurrentQueue() == nil {
+		c.endQueueSpans()
 		time.Sleep(2 * time.Second)
 		return true
 	}
@@ -182,6 +190,8 @@ func (c *controller) process() bool {
 	}
 
 	queue := c.getCurrentQueue()
+	c.traceQueueState(queue)
+	defer func() { c.recordTraceError(err) }()
 
 	switch queue.Spec.Type {
 	case s2hv1.QueueTypePromoteToActive, s2hv1.QueueTypeDemoteFromActive:
@@ -234,6 +244,7 @@ func (c *controller) loadDeployEngines() {
 	engines := []internal.DeployEngine{
 		mock.New(),
 		helm3.New(c.namespace, true),
+		manifest.New(c.namespace, c.client),
 	}
 
 	for _, e := range engines {
@@ -249,6 +260,9 @@ func (c *controller) loadTestRunners() {
 	// init test runner
 	testRunners := []internal.StagingTestRunner{
 		testmock.New(),
+		rest.New(c.client, rest.WithSecret(c.namespace, s2hobject.GetTeamSecretName(c.teamName))),
+		jenkins.New(c.client, jenkins.WithSecret(c.namespace, s2hobject.GetTeamSecretName(c.teamName))),
+		k8sjob.New(c.client),
 	}
 
 	// TODO: should load teamcity credentials from secret, default from samsahai
@@ -340,6 +354,24 @@ func (c *controller) initQueue(q *s2hv1.Queue) error {
 	q.Status.SetCondition(s2hv1.QueueCleaningBeforeStarted, corev1.ConditionTrue,
 		"starts cleaning the namespace before running task")
 
+	if c.isIncrementalDeployment(q) {
+		isHealthy, err := c.isEnvironmentHealthy(c.getDeployEngine(q))
+		if err != nil {
+			return err
+		}
+
+		// keep the namespace, only changed releases will be upgraded
+		if isHealthy {
+			q.Status.SetCondition(s2hv1.QueueCleanedBefore, corev1.ConditionTrue,
+				"namespace kept for incremental deployment")
+
+			return c.updateQueueWithState(q, s2hv1.DetectingImageMissing)
+		}
+
+		logger.Info("environment is unhealthy, cleaning up the namespace before incremental deployment",
+			"queue", q.Name, "namespace", c.namespace)
+	}
+
 	return c.updateQueueWithState(q, s2hv1.CleaningBefore)
 }
 
@@ -353,7 +385,7 @@ func (c *controller) cleanBefore(queue *s2hv1.Queue) error {
 	if !queue.Status.IsConditionTrue(s2hv1.QueueCleanedBefore) {
 		for compName := range parentComps {
 			refName := internal.GenReleaseName(compName)
-			if err := deployEngine.Delete(refName); err != nil {
+			if err := c.deleteRelease(deployEngine, refName); err != nil {
 				logger.Error(err, "cannot delete release",
 					"refName", refName,
 					"namespace", c.namespace,
@@ -393,6 +425,14 @@ func (c *controller) cleanBefore(queue *s2hv1.Queue) error {
 }
 
 func (c *controller) cleanAfter(queue *s2hv1.Queue) error {
+	// keep the succeeded environment for the next incremental deployment
+	if c.isIncrementalDeployment(queue) && queue.IsDeploySuccess() && queue.IsTestSuccess() {
+		queue.Status.SetCondition(s2hv1.QueueCleanedAfter, corev1.ConditionTrue,
+			"namespace kept for incremental deployment")
+
+		return c.updateQueueWithState(queue, s2hv1.Deleting)
+	}
+
 	deployEngine := c.getDeployEngine(queue)
 
 	parentComps, err := c.configCtrl.GetParentComponents(c.teamName)
@@ -403,7 +443,7 @@ func (c *controller) cleanAfter(queue *s2hv1.Queue) error {
 	if !queue.Status.IsConditionTrue(s2hv1.QueueCleanedAfter) {
 		for compName := range parentComps {
 			refName := internal.GenReleaseName(compName)
-			if err := deployEngine.Delete(refName); err != nil {
+			if err := c.deleteRelease(deployEngine, refName); err != nil {
 				logger.Error(err, "cannot delete release",
 					"refName", refName,
 					"namespace", c.namespace,
@@ -440,6 +480,20 @@ func (c *controller) cleanAfter(queue *s2hv1.Queue) error {
 }
 
 func (c *controller) cancelQueue(q *s2hv1.Queue) error {
+	// queue has been canceled through the api, otherwise it has been deleted by user
+	if action := q.GetLastAction(); action != nil && action.Type == s2hv1.QueueActionCancel {
+		if q.Status.QueueHistoryName != "" {
+			if err := c.updateQueueHistory(q); err != nil {
+				return errors.Wrap(err, "updating queuehistory error")
+			}
+		}
+
+		if err := c.client.Delete(context.TODO(), q); err != nil && !k8serrors.IsNotFound(err) {
+			logger.Error(err, "deleting canceled queue error", "queue", q.Name)
+			return err
+		}
+	}
+
 	c.clearCurrentQueue()
 	return nil
 }
@@ -75,7 +75,35 @@ func (c *controller) deployEnvironment(queue *s2hv1.Queue) error {
 
 	// Deploy
 	if !queue.Status.IsConditionTrue(s2hv1.QueueDeployStarted) {
-		isDeployed, err := c.deployComponents(deployEngine, queue, queueComps, queueParentComps, deployTimeout.Duration)
+		waves, err := c.getDeploymentWaves(queue, queueParentComps)
+		if err != nil {
+			return err
+		}
+
+		// components are deployed wave by wave if there are dependsOn between the components,
+		// the next wave will be deployed after all components of the previous wave are ready
+		var waveComps map[string]struct{}
+		isLastWave := true
+		if len(waves) > 1 && queue.Status.DeployedWaves < len(waves) {
+			if queue.Status.DeployedWaves > 0 {
+				isReady, err := c.waitForWaveReady(deployEngine, waves[queue.Status.DeployedWaves-1])
+				if err != nil {
+					return err
+				} else if !isReady {
+					time.Sleep(2 * time.Second)
+					return nil
+				}
+			}
+
+			waveComps = make(map[string]struct{})
+			for _, name := range waves[queue.Status.DeployedWaves] {
+				waveComps[name] = struct{}{}
+			}
+			isLastWave = queue.Status.DeployedWaves == len(waves)-1
+		}
+
+		isDeployed, err := c.deployComponents(deployEngine, queue, queueComps, queueParentComps, waveComps,
+			deployTimeout.Duration)
 		if err != nil {
 			if !isDeployed {
 				return err
@@ -96,6 +124,13 @@ func (c *controller) deployEnvironment(queue *s2hv1.Queue) error {
 			return c.updateQueueWithState(queue, s2hv1.Collecting)
 		}
 
+		if !isLastWave {
+			logger.Debug("deployment wave has been deployed", "queue", queue.Name,
+				"wave", queue.Status.DeployedWaves+1, "totalWaves", len(waves))
+			queue.Status.DeployedWaves++
+			return c.updateQueue(queue)
+		}
+
 		queue.Status.SetCondition(
 			s2hv1.QueueDeployStarted,
 			corev1.ConditionTrue,
@@ -285,7 +320,7 @@ func (c *controller) checkDeployTimeout(queue *s2hv1.Queue, deployTimeout metav1
 		queue.Status.SetCondition(
 			s2hv1.QueueDeployed,
 			corev1.ConditionFalse,
-			"queue deployment timeout")
+			deployTimeoutMessage)
 
 		// update queue back to k8s
 		if err := c.updateQueueWithState(queue, s2hv1.Collecting); err != nil {
@@ -371,7 +406,7 @@ func (c *controller) getStableComponentsMapFromQueueType(q *s2hv1.Queue) (
 func (c *controller) getTeamActiveNamespace() (string, error) {
 	headers := make(http.Header)
 	headers.Set(internal.SamsahaiAuthHeader, c.authToken)
-	ctx := context.TODO()
+	ctx := c.getTraceContext()
 	ctx, err := twirp.WithHTTPRequestHeaders(ctx, headers)
 	if err != nil {
 		return "", errors.Wrap(err, "cannot set request header")
@@ -442,12 +477,78 @@ func applyEnvBaseConfig(
 	return valuesutil.MergeValues(values, target[comp.Name])
 }
 
-// deployComponents
+// getDeploymentWaves returns names of the parent components to be deployed grouped into deployment waves
+// following dependsOn of the components
+func (c *controller) getDeploymentWaves(queue *s2hv1.Queue, queueParentComps map[string]*s2hv1.Component) (
+	[][]string, error) {
+
+	configCtrl := c.getConfigController()
+	parentComps, err := configCtrl.GetParentComponents(c.teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	deployingComps := parentComps
+	if queue.IsPullRequestQueue() {
+		deployingComps = make(map[string]*s2hv1.Component)
+		for name, comp := range queueParentComps {
+			if parentComp, ok := parentComps[name]; ok {
+				comp = parentComp
+			}
+			if comp == nil {
+				continue
+			}
+			deployingComps[name] = comp
+		}
+	}
+
+	return configctrl.GetComponentDeploymentWaves(deployingComps)
+}
+
+// waitForWaveReady checks all parent components of the deployment wave are ready
+func (c *controller) waitForWaveReady(deployEngine internal.DeployEngine, waveCompNames []string) (bool, error) {
+	configCtrl := c.getConfigController()
+	parentComps, err := configCtrl.GetParentComponents(c.teamName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, name := range waveCompNames {
+		comp, ok := parentComps[name]
+		if !ok {
+			continue
+		}
+
+		selectors := deployEngine.GetLabelSelectors(c.genReleaseName(comp))
+		isReady, err := c.waitForReady(selectors)
+		if err != nil {
+			return false, err
+		} else if !isReady {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isInWave checks the component is in the deployment wave, all components are in the wave if wave is nil
+func isInWave(compName string, waveComps map[string]struct{}) bool {
+	if waveComps == nil {
+		return true
+	}
+
+	_, ok := waveComps[compName]
+	return ok
+}
+
+// deployComponents deploys the parent components,
+// only components in waveComps will be deployed if waveComps is not nil
 func (c *controller) deployComponents(
 	deployEngine internal.DeployEngine,
 	queue *s2hv1.Queue,
 	queueComps map[string]*s2hv1.Component,
 	queueParentComps map[string]*s2hv1.Component,
+	waveComps map[string]struct{},
 	deployTimeout time.Duration,
 ) (isDeployed bool, err error) {
 	isDeployed = true
@@ -465,6 +566,15 @@ func (c *controller) deployComponents(
 		releaseRevision[rel.Name] = rel.Version
 	}
 
+	// unchanged releases will be skipped on incremental deployment
+	var deployed *deployedReleases
+	if c.isIncrementalDeployment(queue) {
+		deployed, err = newDeployedReleases(deployEngine, preInstalledReleases)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	helmValidationTimeout := 20 * time.Minute
 	ctx, cancelFunc := context.WithTimeout(context.Background(), helmValidationTimeout)
 	defer cancelFunc()
@@ -478,7 +588,8 @@ func (c *controller) deployComponents(
 			return
 		}
 
-		isDeployed, err := c.deployComponentsExceptQueue(deployEngine, queue, queueParentComps, stableMap, deployTimeout)
+		isDeployed, err := c.deployComponentsExceptQueue(deployEngine, queue, queueParentComps, waveComps, deployed,
+			stableMap, deployTimeout)
 		if err != nil {
 			logger.Error(err, "cannot deploy components except current queue",
 				"queue", queue.Name, "queueType", queue.Spec.Type)
@@ -494,7 +605,8 @@ func (c *controller) deployComponents(
 			return
 		}
 
-		err := c.deployQueueComponent(deployEngine, queue, queueComps, queueParentComps, stableMap, deployTimeout)
+		err := c.deployQueueComponent(deployEngine, queue, queueComps, queueParentComps, waveComps, deployed,
+			stableMap, deployTimeout)
 		if err != nil {
 			logger.Error(err, "cannot deploy current queue component",
 				"queue", queue.Name, "queueType", queue.Spec.Type)
@@ -555,6 +667,8 @@ func (c *controller) deployComponentsExceptQueue(
 	deployEngine internal.DeployEngine,
 	queue *s2hv1.Queue,
 	queueParentComps map[string]*s2hv1.Component,
+	waveComps map[string]struct{},
+	deployed *deployedReleases,
 	stableMap map[string]s2hv1.StableComponent,
 	deployTimeout time.Duration,
 ) (isDeployed bool, err error) {
@@ -576,6 +690,11 @@ func (c *controller) deployComponentsExceptQueue(
 			continue
 		}
 
+		// skip components of other deployment waves
+		if !isInWave(name, waveComps) {
+			continue
+		}
+
 		baseValues, err := configctrl.GetEnvComponentValues(cfg, name, c.teamName, s2hv1.EnvBase)
 		if err != nil {
 			return false, err
@@ -590,12 +709,18 @@ func (c *controller) deployComponentsExceptQueue(
 		switch queue.Spec.Type {
 		case s2hv1.QueueTypeDemoteFromActive:
 			// rollback current active instead of upgrading
-			if err := deployEngine.Rollback(c.genReleaseName(comp), 1); err != nil {
+			if err := c.rollbackRelease(deployEngine, c.genReleaseName(comp), 1); err != nil {
 				return true, err
 			}
 		default:
 			values = applyEnvBaseConfig(cfg, values, queue.Spec.Type, comp, c.teamName)
-			if err := deployEngine.Create(c.genReleaseName(comp), comp, comp, values, &deployTimeout); err != nil {
+			refName := c.genReleaseName(comp)
+			if !deployed.isChanged(refName, comp, values) {
+				logger.Debug("skip deploying unchanged release", "release", refName, "queue", queue.Name)
+				continue
+			}
+
+			if err := c.createRelease(deployEngine, refName, comp, comp, values, &deployTimeout); err != nil {
 				return true, err
 			}
 		}
@@ -611,6 +736,8 @@ func (c *controller) deployQueueComponent(
 	queue *s2hv1.Queue,
 	queueComps map[string]*s2hv1.Component,
 	queueParentComps map[string]*s2hv1.Component,
+	waveComps map[string]struct{},
+	deployed *deployedReleases,
 	stableMap map[string]s2hv1.StableComponent,
 	deployTimeout time.Duration,
 ) error {
@@ -619,13 +746,20 @@ func (c *controller) deployQueueComponent(
 		return err
 	}
 
-	errCh := make(chan error, len(queueParentComps))
+	deployingParentComps := make(map[string]*s2hv1.Component)
+	for parentName, parentComp := range queueParentComps {
+		if isInWave(parentName, waveComps) {
+			deployingParentComps[parentName] = parentComp
+		}
+	}
+
+	errCh := make(chan error, len(deployingParentComps))
 
 	ctx, cancelFunc := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancelFunc()
 
 	// deploy current queue
-	for parentName, parentComp := range queueParentComps {
+	for parentName, parentComp := range deployingParentComps {
 		go func(parentName string, parentComp *s2hv1.Component) {
 			if parentComp == nil {
 				errCh <- fmt.Errorf("parent components should not be empty, component: %s", parentName)
@@ -678,7 +812,14 @@ func (c *controller) deployQueueComponent(
 			}
 
 			values = applyEnvBaseConfig(cfg, values, queue.Spec.Type, parentComp, c.teamName)
-			err = deployEngine.Create(c.genReleaseName(parentComp), parentComp, parentComp, values, &deployTimeout)
+			refName := c.genReleaseName(parentComp)
+			if !deployed.isChanged(refName, parentComp, values) {
+				logger.Debug("skip deploying unchanged release", "release", refName, "queue", queue.Name)
+				errCh <- nil
+				return
+			}
+
+			err = c.createRelease(deployEngine, refName, parentComp, parentComp, values, &deployTimeout)
 			if err != nil {
 				errCh <- err
 				return
@@ -688,7 +829,7 @@ func (c *controller) deployQueueComponent(
 		}(parentName, parentComp)
 	}
 
-	for i := 0; i < len(queueParentComps); i++ {
+	for i := 0; i < len(deployingParentComps); i++ {
 		select {
 		case <-ctx.Done():
 			return nil
@@ -903,7 +1044,7 @@ func (c *controller) checkAllReleasesDeployed(deployEngine internal.DeployEngine
 func (c *controller) deployActiveServicesIntoPullRequestEnvironment() error {
 	headers := make(http.Header)
 	headers.Set(internal.SamsahaiAuthHeader, c.authToken)
-	ctx := context.TODO()
+	ctx := c.getTraceContext()
 	ctx, err := twirp.WithHTTPRequestHeaders(ctx, headers)
 	if err != nil {
 		return errors.Wrap(err, "cannot set request header")
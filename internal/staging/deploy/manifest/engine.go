@@ -0,0 +1,356 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	helmtime "helm.sh/helm/v3/pkg/time"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+)
+
+var logger = s2hlog.Log.WithName(EngineName)
+
+const (
+	EngineName = "manifest"
+
+	// FieldManager is a field manager name of server-side apply
+	FieldManager = "samsahai"
+
+	// MaxHistory is a maximum number of revisions which are kept per release
+	MaxHistory = 10
+
+	// ReleaseLabel is a label key which is added to all resources of the release
+	ReleaseLabel = "release"
+
+	DefaultApplyTimeout = 300 * time.Second
+)
+
+type engine struct {
+	namespace string
+	client    client.Client
+}
+
+// New creates a new manifest deploy engine which renders kustomize bases or raw manifests
+// and applies them into the namespace by server-side apply
+func New(ns string, c client.Client) internal.DeployEngine {
+	return &engine{
+		namespace: ns,
+		client:    c,
+	}
+}
+
+func (e *engine) GetName() string {
+	return EngineName
+}
+
+func (e *engine) GetLabelSelectors(refName string) map[string]string {
+	return map[string]string{ReleaseLabel: refName}
+}
+
+func (e *engine) IsMocked() bool {
+	return false
+}
+
+func (e *engine) Create(
+	refName string,
+	_ *s2hv1.Component,
+	parentComp *s2hv1.Component,
+	values map[string]interface{},
+	deployTimeout *time.Duration,
+) error {
+	manifest, err := Render(e.namespace, refName, parentComp, values)
+	if err != nil {
+		logger.Error(err, "render manifests failed", "releaseName", refName)
+		return errors.Wrapf(err, "cannot render manifests of release %q", refName)
+	}
+
+	ctx, cancel := e.newContext(deployTimeout)
+	defer cancel()
+
+	prev, err := e.getLatestRelease(ctx, refName)
+	if err != nil && err != driver.ErrReleaseNotFound {
+		return errors.Wrapf(err, "cannot get history of release %q", refName)
+	}
+
+	description := "Install complete"
+	if prev != nil {
+		description = "Upgrade complete"
+	}
+
+	return e.deploy(ctx, refName, prev, manifest, values, description)
+}
+
+func (e *engine) Rollback(refName string, revision int) error {
+	logger.Debug("manifest rollback", "releaseName", refName, "revision", revision)
+
+	ctx, cancel := e.newContext(nil)
+	defer cancel()
+
+	target, err := e.getRelease(ctx, refName, revision)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get revision %d of release %q", revision, refName)
+	}
+
+	prev, err := e.getLatestRelease(ctx, refName)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get history of release %q", refName)
+	}
+
+	err = e.deploy(ctx, refName, prev, target.Manifest, target.Config, fmt.Sprintf("Rollback to %d", revision))
+	if err != nil {
+		logger.Error(err, "manifest rollback failed", "releaseName", refName, "revision", revision)
+		return errors.Wrapf(err, "manifest rollback failed")
+	}
+
+	return nil
+}
+
+// GetHistories returns the latest revision of the release, same as helm3 engine
+func (e *engine) GetHistories(refName string) ([]*release.Release, error) {
+	rel, err := e.getLatestRelease(context.TODO(), refName)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*release.Release{rel}, nil
+}
+
+func (e *engine) Delete(refName string) error {
+	releaseName, err := e.ensureReleaseName(refName)
+	if err != nil {
+		return err
+	}
+
+	if releaseName == "" {
+		return nil
+	}
+
+	logger.Debug("deleting release", "releaseName", releaseName)
+	return e.uninstall(releaseName, false)
+}
+
+func (e *engine) ForceDelete(refName string) error {
+	releaseName, err := e.ensureReleaseName(refName)
+	if err != nil {
+		return err
+	}
+
+	if releaseName == "" {
+		return nil
+	}
+
+	return e.uninstall(releaseName, true)
+}
+
+func (e *engine) GetValues() (map[string][]byte, error) {
+	releases, err := e.GetReleases()
+	if err != nil {
+		return nil, err
+	}
+
+	valuesYaml := make(map[string][]byte)
+	for _, r := range releases {
+		yml, err := yaml.Marshal(r.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		valuesYaml[r.Name] = yml
+	}
+
+	return valuesYaml, nil
+}
+
+func (e *engine) GetReleases() ([]*release.Release, error) {
+	releases, err := e.listReleases(context.TODO(), nil)
+	if err != nil {
+		return []*release.Release{}, err
+	}
+
+	latest := make(map[string]*release.Release)
+	for _, r := range releases {
+		if l, ok := latest[r.Name]; !ok || r.Version > l.Version {
+			latest[r.Name] = r
+		}
+	}
+
+	out := make([]*release.Release, 0, len(latest))
+	for _, r := range latest {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+
+	return out, nil
+}
+
+// WaitForPreHookReady always returns true, manifest engine does not support hooks
+func (e *engine) WaitForPreHookReady(_ client.Client, _ string) (bool, error) {
+	return true, nil
+}
+
+func (e *engine) newContext(timeout *time.Duration) (context.Context, context.CancelFunc) {
+	if timeout != nil && *timeout > 0 {
+		return context.WithTimeout(context.Background(), *timeout)
+	}
+	return context.WithTimeout(context.Background(), DefaultApplyTimeout)
+}
+
+// deploy applies the manifest, prunes resources which were removed from the previous revision
+// and stores the result as a new revision of the release
+func (e *engine) deploy(
+	ctx context.Context,
+	refName string,
+	prev *release.Release,
+	manifest string,
+	values map[string]interface{},
+	description string,
+) error {
+	now := helmtime.Now()
+	rel := &release.Release{
+		Name:      refName,
+		Namespace: e.namespace,
+		Version:   1,
+		Manifest:  manifest,
+		Config:    values,
+		Info: &release.Info{
+			FirstDeployed: now,
+			LastDeployed:  now,
+			Status:        release.StatusDeployed,
+			Description:   description,
+		},
+	}
+
+	prevManifest := ""
+	if prev != nil {
+		rel.Version = prev.Version + 1
+		if prev.Info != nil {
+			rel.Info.FirstDeployed = prev.Info.FirstDeployed
+		}
+		prevManifest = prev.Manifest
+	}
+
+	if err := e.apply(ctx, manifest, prevManifest); err != nil {
+		logger.Error(err, "apply manifests failed", "releaseName", refName)
+		rel.Info.Status = release.StatusFailed
+		rel.Info.Description = err.Error()
+		if err := e.createRelease(ctx, rel); err != nil {
+			logger.Error(err, "cannot store failed release", "releaseName", refName)
+		}
+		return errors.Wrapf(err, "apply manifests of release %q failed", refName)
+	}
+
+	if err := e.createRelease(ctx, rel); err != nil {
+		return errors.Wrapf(err, "cannot store release %q", refName)
+	}
+
+	return e.supersedeReleases(ctx, rel)
+}
+
+func (e *engine) apply(ctx context.Context, manifest, prevManifest string) error {
+	objs, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	applied := make(map[string]struct{}, len(objs))
+	for _, obj := range objs {
+		if err := e.client.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(FieldManager)); err != nil {
+			return errors.Wrapf(err, "cannot apply %s %q", obj.GetKind(), obj.GetName())
+		}
+		applied[objectKey(obj)] = struct{}{}
+	}
+
+	prevObjs, err := decodeManifest(prevManifest)
+	if err != nil {
+		logger.Warnf("cannot decode previous manifest, skip pruning: %v", err)
+		return nil
+	}
+
+	for _, obj := range prevObjs {
+		if _, ok := applied[objectKey(obj)]; ok {
+			continue
+		}
+
+		logger.Debug("pruning resource", "kind", obj.GetKind(), "name", obj.GetName())
+		if err := e.deleteObject(ctx, obj, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *engine) uninstall(refName string, force bool) error {
+	ctx, cancel := e.newContext(nil)
+	defer cancel()
+
+	rel, err := e.getLatestRelease(ctx, refName)
+	if err != nil {
+		if err == driver.ErrReleaseNotFound {
+			return nil
+		}
+		return errors.Wrap(err, "error while deleting manifest release")
+	}
+
+	objs, err := decodeManifest(rel.Manifest)
+	if err != nil && !force {
+		return errors.Wrap(err, "error while deleting manifest release")
+	}
+
+	// delete in reverse order of applying
+	for i := len(objs) - 1; i >= 0; i-- {
+		if err := e.deleteObject(ctx, objs[i], force); err != nil {
+			if !force {
+				return errors.Wrap(err, "error while deleting manifest release")
+			}
+			logger.Warnf("cannot force delete %s %q: %v", objs[i].GetKind(), objs[i].GetName(), err)
+		}
+	}
+
+	if err := e.deleteReleases(ctx, refName); err != nil {
+		return errors.Wrap(err, "error while deleting manifest release")
+	}
+
+	return nil
+}
+
+func (e *engine) deleteObject(ctx context.Context, obj *unstructured.Unstructured, force bool) error {
+	opts := []client.DeleteOption{client.PropagationPolicy("Background")}
+	if force {
+		opts = append(opts, client.GracePeriodSeconds(0))
+	}
+
+	if err := e.client.Delete(ctx, obj, opts...); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot delete %s %q", obj.GetKind(), obj.GetName())
+	}
+
+	return nil
+}
+
+func (e *engine) ensureReleaseName(refName string) (string, error) {
+	releases, err := e.GetReleases()
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range releases {
+		if strings.Contains(r.Name, refName) {
+			return r.Name, nil
+		}
+	}
+
+	return "", nil
+}
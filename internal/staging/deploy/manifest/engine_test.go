@@ -0,0 +1,258 @@
+package manifest
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestManifestEngine(t *testing.T) {
+	unittest.InitGinkgo(t, "Manifest Engine")
+}
+
+const (
+	mockDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+spec:
+  selector:
+    matchLabels:
+      app: redis
+  template:
+    metadata:
+      labels:
+        app: redis
+    spec:
+      containers:
+      - name: redis
+        image: bitnami/redis:5.0.5
+`
+	mockService = `apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+spec:
+  selector:
+    app: redis
+  ports:
+  - port: 6379
+`
+)
+
+var _ = Describe("Manifest Engine", func() {
+	g := NewWithT(GinkgoT())
+	mockNamespace := "test"
+
+	var baseDir string
+
+	BeforeEach(func() {
+		var err error
+		baseDir, err = ioutil.TempDir("", "manifest-engine-test-")
+		g.Expect(err).NotTo(HaveOccurred())
+
+		g.Expect(ioutil.WriteFile(filepath.Join(baseDir, "deployment.yaml"), []byte(mockDeployment), 0644)).
+			To(Succeed())
+		g.Expect(ioutil.WriteFile(filepath.Join(baseDir, "service.yaml"), []byte(mockService), 0644)).
+			To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(baseDir)
+	})
+
+	Describe("render", func() {
+		It("should successfully render raw manifests with release label and namespace", func() {
+			comp := &s2hv1.Component{
+				Name:  "redis",
+				Chart: s2hv1.ComponentChart{Repository: baseDir},
+			}
+
+			manifest, err := Render(mockNamespace, "team-redis", comp, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			objs, err := decodeManifest(manifest)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(objs).To(HaveLen(2))
+			for _, obj := range objs {
+				g.Expect(obj.GetNamespace()).To(Equal(mockNamespace))
+				g.Expect(obj.GetLabels()).To(HaveKeyWithValue(ReleaseLabel, "team-redis"))
+			}
+		})
+
+		It("should successfully render kustomize base with image and patches from values", func() {
+			kustomization := "resources:\n- deployment.yaml\n"
+			g.Expect(ioutil.WriteFile(filepath.Join(baseDir, "kustomization.yaml"), []byte(kustomization), 0644)).
+				To(Succeed())
+
+			comp := &s2hv1.Component{
+				Name:  "redis",
+				Chart: s2hv1.ComponentChart{Repository: baseDir},
+				Image: s2hv1.ComponentImage{Repository: "bitnami/redis"},
+			}
+			values := map[string]interface{}{
+				"image": map[string]interface{}{
+					"repository": "registry.example.com/redis",
+					"tag":        "5.0.7",
+				},
+				"patches": []interface{}{
+					map[string]interface{}{
+						"patch": "- op: add\n  path: /spec/replicas\n  value: 2\n",
+						"target": map[string]interface{}{
+							"kind": "Deployment",
+							"name": "redis",
+						},
+					},
+				},
+			}
+
+			manifest, err := Render(mockNamespace, "team-redis", comp, values)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			objs, err := decodeManifest(manifest)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(objs).To(HaveLen(1))
+
+			containers, _, _ := unstructured.NestedSlice(objs[0].Object, "spec", "template", "spec", "containers")
+			g.Expect(containers).To(HaveLen(1))
+			g.Expect(containers[0].(map[string]interface{})["image"]).To(Equal("registry.example.com/redis:5.0.7"))
+			g.Expect(objs[0].Object["spec"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(2))
+		})
+
+		It("should correctly generate remote resource", func() {
+			resources, err := genResources(s2hv1.ComponentChart{
+				Repository: "https://github.com/agoda-com/samsahai",
+				Name:       "examples/manifests",
+				Version:    "v1.0.0",
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(resources).To(Equal([]string{
+				"https://github.com/agoda-com/samsahai//examples/manifests?ref=v1.0.0",
+			}))
+		})
+
+		It("should fail to render patches from file", func() {
+			comp := &s2hv1.Component{
+				Name:  "redis",
+				Chart: s2hv1.ComponentChart{Repository: baseDir},
+			}
+			values := map[string]interface{}{
+				"patches": []interface{}{
+					map[string]interface{}{"path": "/etc/passwd"},
+				},
+			}
+
+			_, err := Render(mockNamespace, "team-redis", comp, values)
+			g.Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("releases", func() {
+		var e *engine
+		var c client.Client
+
+		BeforeEach(func() {
+			c = fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build()
+			e = New(mockNamespace, c).(*engine)
+		})
+
+		newRelease := func(name string, version int, status release.Status, manifest string) *release.Release {
+			return &release.Release{
+				Name:      name,
+				Namespace: mockNamespace,
+				Version:   version,
+				Manifest:  manifest,
+				Config:    map[string]interface{}{"version": version},
+				Info:      &release.Info{Status: status},
+			}
+		}
+
+		It("should successfully get latest releases", func() {
+			ctx := context.TODO()
+			g.Expect(e.createRelease(ctx, newRelease("team-redis", 1, release.StatusSuperseded, ""))).To(Succeed())
+			g.Expect(e.createRelease(ctx, newRelease("team-redis", 2, release.StatusDeployed, ""))).To(Succeed())
+			g.Expect(e.createRelease(ctx, newRelease("team-mariadb", 1, release.StatusFailed, ""))).To(Succeed())
+
+			releases, err := e.GetReleases()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(releases).To(HaveLen(2))
+			g.Expect(releases[0].Name).To(Equal("team-mariadb"))
+			g.Expect(releases[1].Name).To(Equal("team-redis"))
+			g.Expect(releases[1].Version).To(Equal(2))
+
+			histories, err := e.GetHistories("team-redis")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(histories).To(HaveLen(1))
+			g.Expect(histories[0].Info.Status).To(Equal(release.StatusDeployed))
+
+			_, err = e.GetHistories("team-unknown")
+			g.Expect(err).To(Equal(driver.ErrReleaseNotFound))
+
+			values, err := e.GetValues()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(values["team-redis"])).To(Equal("version: 2\n"))
+		})
+
+		It("should supersede previous revisions and keep max history", func() {
+			ctx := context.TODO()
+			for i := 1; i <= MaxHistory+2; i++ {
+				g.Expect(e.createRelease(ctx, newRelease("team-redis", i, release.StatusDeployed, ""))).To(Succeed())
+			}
+
+			current := newRelease("team-redis", MaxHistory+2, release.StatusDeployed, "")
+			g.Expect(e.supersedeReleases(ctx, current)).To(Succeed())
+
+			releases, err := e.listReleases(ctx, map[string]string{labelName: "team-redis"})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(releases).To(HaveLen(MaxHistory))
+			g.Expect(releases[0].Version).To(Equal(3))
+			for _, r := range releases[:len(releases)-1] {
+				g.Expect(r.Info.Status).To(Equal(release.StatusSuperseded))
+			}
+			g.Expect(releases[len(releases)-1].Info.Status).To(Equal(release.StatusDeployed))
+		})
+
+		It("should successfully delete resources and releases", func() {
+			ctx := context.TODO()
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: mockNamespace},
+			}
+			g.Expect(c.Create(ctx, svc)).To(Succeed())
+
+			manifest := "apiVersion: v1\nkind: Service\nmetadata:\n  name: redis\n  namespace: test\n"
+			g.Expect(e.createRelease(ctx, newRelease("team-redis", 1, release.StatusDeployed, manifest))).
+				To(Succeed())
+
+			g.Expect(e.Delete("redis")).To(Succeed())
+
+			err := c.Get(ctx, types.NamespacedName{Namespace: mockNamespace, Name: "redis"}, &corev1.Service{})
+			g.Expect(err).To(HaveOccurred())
+
+			releases, err := e.GetReleases()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(releases).To(BeEmpty())
+		})
+
+		It("should do nothing when deleting not existing release", func() {
+			g.Expect(e.Delete("redis")).To(Succeed())
+			g.Expect(e.ForceDelete("redis")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,194 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+// releases are stored as secrets, one secret per revision, similar to helm secrets driver
+const (
+	storageOwner      = "samsahai-manifest"
+	storageReleaseKey = "release"
+
+	labelOwner   = "owner"
+	labelName    = "name"
+	labelVersion = "version"
+	labelStatus  = "status"
+)
+
+func releaseSecretName(name string, version int) string {
+	return fmt.Sprintf("samsahai.manifest.v1.%s.v%d", name, version)
+}
+
+func newReleaseSecret(rel *release.Release) (*corev1.Secret, error) {
+	data, err := json.Marshal(rel)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot encode release %q", rel.Name)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseSecretName(rel.Name, rel.Version),
+			Namespace: rel.Namespace,
+			Labels:    releaseLabels(rel),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{storageReleaseKey: data},
+	}, nil
+}
+
+func releaseLabels(rel *release.Release) map[string]string {
+	status := ""
+	if rel.Info != nil {
+		status = rel.Info.Status.String()
+	}
+
+	return map[string]string{
+		labelOwner:   storageOwner,
+		labelName:    rel.Name,
+		labelVersion: strconv.Itoa(rel.Version),
+		labelStatus:  status,
+	}
+}
+
+func decodeRelease(secret *corev1.Secret) (*release.Release, error) {
+	rel := &release.Release{}
+	if err := json.Unmarshal(secret.Data[storageReleaseKey], rel); err != nil {
+		return nil, errors.Wrapf(err, "cannot decode release from secret %q", secret.Name)
+	}
+
+	return rel, nil
+}
+
+func (e *engine) createRelease(ctx context.Context, rel *release.Release) error {
+	secret, err := newReleaseSecret(rel)
+	if err != nil {
+		return err
+	}
+
+	return e.client.Create(ctx, secret)
+}
+
+func (e *engine) updateRelease(ctx context.Context, rel *release.Release) error {
+	secret := &corev1.Secret{}
+	err := e.client.Get(ctx, types.NamespacedName{Namespace: e.namespace, Name: releaseSecretName(rel.Name, rel.Version)}, secret)
+	if err != nil {
+		return err
+	}
+
+	desired, err := newReleaseSecret(rel)
+	if err != nil {
+		return err
+	}
+
+	secret.Labels = desired.Labels
+	secret.Data = desired.Data
+
+	return e.client.Update(ctx, secret)
+}
+
+func (e *engine) getRelease(ctx context.Context, name string, version int) (*release.Release, error) {
+	secret := &corev1.Secret{}
+	err := e.client.Get(ctx, types.NamespacedName{Namespace: e.namespace, Name: releaseSecretName(name, version)}, secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, driver.ErrReleaseNotFound
+		}
+		return nil, err
+	}
+
+	return decodeRelease(secret)
+}
+
+func (e *engine) getLatestRelease(ctx context.Context, name string) (*release.Release, error) {
+	releases, err := e.listReleases(ctx, map[string]string{labelName: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(releases) == 0 {
+		return nil, driver.ErrReleaseNotFound
+	}
+
+	return releases[len(releases)-1], nil
+}
+
+// listReleases returns all revisions of releases matched the labels, sorted by version
+func (e *engine) listReleases(ctx context.Context, labels map[string]string) ([]*release.Release, error) {
+	selectors := client.MatchingLabels{labelOwner: storageOwner}
+	for k, v := range labels {
+		selectors[k] = v
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := e.client.List(ctx, secrets, client.InNamespace(e.namespace), selectors); err != nil {
+		return nil, errors.Wrap(err, "cannot list release secrets")
+	}
+
+	releases := make([]*release.Release, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		rel, err := decodeRelease(&secrets.Items[i])
+		if err != nil {
+			logger.Warnf("skip invalid release secret %q: %v", secrets.Items[i].Name, err)
+			continue
+		}
+		releases = append(releases, rel)
+	}
+
+	sort.Slice(releases, func(i, j int) bool { return releases[i].Version < releases[j].Version })
+
+	return releases, nil
+}
+
+// supersedeReleases marks all older deployed revisions as superseded and
+// removes revisions exceeding MaxHistory
+func (e *engine) supersedeReleases(ctx context.Context, current *release.Release) error {
+	releases, err := e.listReleases(ctx, map[string]string{labelName: current.Name})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range releases {
+		if r.Version >= current.Version || r.Info == nil || r.Info.Status != release.StatusDeployed {
+			continue
+		}
+
+		r.Info.Status = release.StatusSuperseded
+		if err := e.updateRelease(ctx, r); err != nil {
+			return errors.Wrapf(err, "cannot supersede revision %d of release %q", r.Version, r.Name)
+		}
+	}
+
+	for i := 0; i < len(releases)-MaxHistory; i++ {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      releaseSecretName(releases[i].Name, releases[i].Version),
+				Namespace: e.namespace,
+			},
+		}
+		if err := e.client.Delete(ctx, secret); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete revision %d of release %q", releases[i].Version, releases[i].Name)
+		}
+	}
+
+	return nil
+}
+
+func (e *engine) deleteReleases(ctx context.Context, name string) error {
+	return e.client.DeleteAllOf(ctx, &corev1.Secret{},
+		client.InNamespace(e.namespace),
+		client.MatchingLabels{labelOwner: storageOwner, labelName: name})
+}
@@ -0,0 +1,293 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/kustomize/api/filesys"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	kusttypes "sigs.k8s.io/kustomize/api/types"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+// keys of component values which are converted into the kustomization
+const (
+	// ValuesKeyImage is an image of the component, `image.repository` and `image.tag`
+	// replace the image defined in `Component.Image.Repository`
+	ValuesKeyImage = "image"
+
+	// ValuesKeyImages is a list of kustomize images
+	ValuesKeyImages = "images"
+
+	// ValuesKeyPatches is a list of kustomize patches, strategic merge or json6902
+	ValuesKeyPatches = "patches"
+)
+
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// Render builds the kustomize base of the component with its values as patches and
+// returns multi-document yaml of all resources
+//
+// The base location is taken from the component chart,
+// `chart.repository` is a local directory or a remote kustomize url,
+// `chart.name` is a path inside the repository and
+// `chart.version` is a git ref of the remote url.
+// A local directory without kustomization file is loaded as raw manifests.
+func Render(namespace, refName string, comp *s2hv1.Component, values map[string]interface{}) (string, error) {
+	if comp == nil {
+		return "", errors.New("component should not be empty")
+	}
+
+	dir, err := ioutil.TempDir("", "s2h-manifest-")
+	if err != nil {
+		return "", errors.Wrap(err, "cannot create kustomize directory")
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	resources, err := genResources(comp.Chart)
+	if err != nil {
+		return "", err
+	}
+
+	// kustomize does not accept absolute path of directory
+	for i, res := range resources {
+		if !filepath.IsAbs(res) {
+			continue
+		}
+		if rel, err := filepath.Rel(dir, res); err == nil {
+			resources[i] = rel
+		}
+	}
+
+	k, err := genKustomization(namespace, refName, comp, values)
+	if err != nil {
+		return "", err
+	}
+	k.Resources = resources
+
+	data, err := yaml.Marshal(k)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot encode kustomization")
+	}
+
+	fSys := filesys.MakeFsOnDisk()
+	if err := fSys.WriteFile(filepath.Join(dir, konfig.DefaultKustomizationFileName()), data); err != nil {
+		return "", errors.Wrap(err, "cannot write kustomization")
+	}
+
+	opts := krusty.MakeDefaultOptions()
+	opts.LoadRestrictions = kusttypes.LoadRestrictionsNone
+	resMap, err := krusty.MakeKustomizer(opts).Run(fSys, dir)
+	if err != nil {
+		return "", errors.Wrap(err, "kustomize build failed")
+	}
+
+	out, err := resMap.AsYaml()
+	if err != nil {
+		return "", errors.Wrap(err, "cannot encode resources")
+	}
+
+	return string(out), nil
+}
+
+// genResources returns kustomize resources of the chart
+func genResources(chart s2hv1.ComponentChart) ([]string, error) {
+	if chart.Repository == "" {
+		return nil, errors.New("chart repository should not be empty")
+	}
+
+	if !isLocalPath(chart.Repository) {
+		target := strings.TrimSuffix(chart.Repository, "/")
+		if chart.Name != "" {
+			target = target + "//" + strings.TrimPrefix(chart.Name, "/")
+		}
+		if chart.Version != "" {
+			target = target + "?ref=" + chart.Version
+		}
+		return []string{target}, nil
+	}
+
+	target, err := filepath.Abs(filepath.Join(chart.Repository, chart.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest path %q", chart.Repository)
+	}
+
+	info, err := os.Stat(target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read manifest path %q", target)
+	}
+
+	if !info.IsDir() || hasKustomization(target) {
+		return []string{target}, nil
+	}
+
+	// raw manifests
+	files, err := ioutil.ReadDir(target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read manifest directory %q", target)
+	}
+
+	resources := make([]string, 0)
+	for _, f := range files {
+		if f.IsDir() || !isManifestFile(f.Name()) {
+			continue
+		}
+		resources = append(resources, filepath.Join(target, f.Name()))
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("no manifests found in %q", target)
+	}
+
+	return resources, nil
+}
+
+func genKustomization(namespace, refName string, comp *s2hv1.Component, values map[string]interface{}) (
+	*kusttypes.Kustomization, error) {
+
+	k := &kusttypes.Kustomization{
+		TypeMeta: kusttypes.TypeMeta{
+			APIVersion: kusttypes.KustomizationVersion,
+			Kind:       kusttypes.KustomizationKind,
+		},
+		Namespace:    namespace,
+		CommonLabels: map[string]string{ReleaseLabel: refName},
+	}
+
+	if img := genImage(comp.Image.Repository, values); img != nil {
+		k.Images = append(k.Images, *img)
+	}
+
+	for _, dep := range comp.Dependencies {
+		if dep == nil {
+			continue
+		}
+		depValues, ok := values[dep.Name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if img := genImage(dep.Image.Repository, depValues); img != nil {
+			k.Images = append(k.Images, *img)
+		}
+	}
+
+	if v, ok := values[ValuesKeyImages]; ok {
+		images := make([]kusttypes.Image, 0)
+		if err := convertValues(v, &images); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s values", ValuesKeyImages)
+		}
+		k.Images = append(k.Images, images...)
+	}
+
+	if v, ok := values[ValuesKeyPatches]; ok {
+		patches := make([]kusttypes.Patch, 0)
+		if err := convertValues(v, &patches); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s values", ValuesKeyPatches)
+		}
+		for _, p := range patches {
+			if p.Path != "" {
+				return nil, fmt.Errorf("patch from file is not supported, path: %s", p.Path)
+			}
+		}
+		k.Patches = patches
+	}
+
+	return k, nil
+}
+
+// genImage returns kustomize image which replaces the repository by `image` values
+func genImage(repository string, values map[string]interface{}) *kusttypes.Image {
+	if repository == "" {
+		return nil
+	}
+
+	newName, _, _ := unstructured.NestedString(values, ValuesKeyImage, "repository")
+	newTag, _, _ := unstructured.NestedString(values, ValuesKeyImage, "tag")
+	if newName == "" && newTag == "" {
+		return nil
+	}
+
+	return &kusttypes.Image{
+		Name:    repository,
+		NewName: newName,
+		NewTag:  newTag,
+	}
+}
+
+func convertValues(in interface{}, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// decodeManifest decodes multi-document yaml into objects
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	objs := make([]*unstructured.Unstructured, 0)
+	if manifest == "" {
+		return objs, nil
+	}
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrap(err, "cannot decode manifest")
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName())
+}
+
+func isLocalPath(path string) bool {
+	if filepath.IsAbs(path) || strings.HasPrefix(path, ".") {
+		return true
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func hasKustomization(dir string) bool {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isManifestFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range manifestExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
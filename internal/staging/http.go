@@ -7,10 +7,12 @@ import (
 	"github.com/julienschmidt/httprouter"
 
 	s2h "github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/tracing"
 )
 
 func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	ctx := context.WithValue(req.Context(), s2h.HTTPHeader(s2h.SamsahaiAuthHeader), req.Header.Get(s2h.SamsahaiAuthHeader))
+	ctx := context.WithValue(tracing.ExtractContext(req), s2h.HTTPHeader(s2h.SamsahaiAuthHeader),
+		req.Header.Get(s2h.SamsahaiAuthHeader))
 	req = req.WithContext(ctx)
 
 	router := httprouter.New()
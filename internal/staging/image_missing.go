@@ -1,7 +1,6 @@
 package staging
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/pkg/errors"
@@ -19,7 +18,7 @@ func (c *controller) detectImageMissing(queue *s2hv1.Queue) error {
 		var err error
 		headers := make(http.Header)
 		headers.Set(internal.SamsahaiAuthHeader, c.authToken)
-		ctx := context.TODO()
+		ctx := c.getTraceContext()
 		ctx, err = twirp.WithHTTPRequestHeaders(ctx, headers)
 		if err != nil {
 			return errors.Wrap(err, "cannot set request header")
@@ -57,6 +56,7 @@ func (c *controller) detectImageMissing(queue *s2hv1.Queue) error {
 		}
 	}
 
+	queue.Status.DeployedWaves = 0
 	return c.updateQueueWithState(queue, s2hv1.Creating)
 }
 
@@ -0,0 +1,116 @@
+package staging
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghodss/yaml"
+	"helm.sh/helm/v3/pkg/release"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+)
+
+// deployedReleases represents values and chart versions of the deployed releases,
+// it is used for skipping unchanged releases on incremental deployment
+type deployedReleases struct {
+	values        map[string][]byte // map[release name]yaml values
+	chartVersions map[string]string // map[release name]chart version
+}
+
+func newDeployedReleases(deployEngine internal.DeployEngine, releases []*release.Release) (*deployedReleases, error) {
+	values, err := deployEngine.GetValues()
+	if err != nil {
+		return nil, err
+	}
+
+	chartVersions := make(map[string]string)
+	for _, rel := range releases {
+		if rel.Chart != nil && rel.Chart.Metadata != nil {
+			chartVersions[rel.Name] = rel.Chart.Metadata.Version
+		}
+	}
+
+	return &deployedReleases{
+		values:        values,
+		chartVersions: chartVersions,
+	}, nil
+}
+
+// isChanged checks the release has to be deployed,
+// the release is always changed if it has not been deployed
+func (d *deployedReleases) isChanged(refName string, parentComp *s2hv1.Component, values map[string]interface{}) bool {
+	if d == nil {
+		return true
+	}
+
+	deployedValues, ok := d.values[refName]
+	if !ok {
+		return true
+	}
+
+	if parentComp.Chart.Version != "" && parentComp.Chart.Version != d.chartVersions[refName] {
+		return true
+	}
+
+	// values are converted in the same way as the deploy engine does
+	valuesData, err := json.Marshal(values)
+	if err != nil {
+		return true
+	}
+
+	valuesYaml, err := yaml.JSONToYAML(valuesData)
+	if err != nil {
+		return true
+	}
+
+	return !bytes.Equal(valuesYaml, deployedValues)
+}
+
+// isIncrementalDeployment checks the queue can be deployed incrementally,
+// only upgrade queue is deployed incrementally, reverify queue always runs on the clean namespace
+func isIncrementalDeployment(q *s2hv1.Queue, stagingConfig *s2hv1.ConfigStaging) bool {
+	if stagingConfig == nil || !stagingConfig.Incremental {
+		return false
+	}
+
+	return q.Spec.Type == s2hv1.QueueTypeUpgrade
+}
+
+func (c *controller) isIncrementalDeployment(q *s2hv1.Queue) bool {
+	cfg, err := c.getConfiguration()
+	if err != nil {
+		logger.Error(err, "cannot get configuration", "team", c.teamName)
+		return false
+	}
+
+	return isIncrementalDeployment(q, cfg.Staging)
+}
+
+// isEnvironmentHealthy checks all releases in the namespace are deployed and all components are ready,
+// the namespace has to be fully cleaned up if the environment is unhealthy
+func (c *controller) isEnvironmentHealthy(deployEngine internal.DeployEngine) (bool, error) {
+	parentComps, err := c.getConfigController().GetParentComponents(c.teamName)
+	if err != nil {
+		return false, err
+	}
+
+	releases, err := deployEngine.GetReleases()
+	if err != nil {
+		return false, err
+	}
+
+	if isDeployed, isFailed, _ := c.checkAllReleasesDeployed(deployEngine, releases); !isDeployed || isFailed {
+		return false, nil
+	}
+
+	for _, comp := range parentComps {
+		selectors := deployEngine.GetLabelSelectors(c.genReleaseName(comp))
+		isReady, err := c.waitForReady(selectors)
+		if err != nil || !isReady {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
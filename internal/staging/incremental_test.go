@@ -0,0 +1,69 @@
+package staging
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Incremental deployment", func() {
+	g := NewWithT(GinkgoT())
+
+	redisComp := &s2hv1.Component{
+		Name: "redis",
+		Chart: s2hv1.ComponentChart{
+			Name:    "redis",
+			Version: "10.5.7",
+		},
+	}
+
+	It("should deploy incrementally only upgrade queue of enabled configuration", func() {
+		upgradeQueue := &s2hv1.Queue{Spec: s2hv1.QueueSpec{Type: s2hv1.QueueTypeUpgrade}}
+		reverifyQueue := &s2hv1.Queue{Spec: s2hv1.QueueSpec{Type: s2hv1.QueueTypeReverify}}
+		prQueue := &s2hv1.Queue{Spec: s2hv1.QueueSpec{Type: s2hv1.QueueTypePullRequest}}
+		stagingConfig := &s2hv1.ConfigStaging{Incremental: true}
+
+		g.Expect(isIncrementalDeployment(upgradeQueue, stagingConfig)).To(BeTrue())
+		g.Expect(isIncrementalDeployment(reverifyQueue, stagingConfig)).To(BeFalse())
+		g.Expect(isIncrementalDeployment(prQueue, stagingConfig)).To(BeFalse())
+		g.Expect(isIncrementalDeployment(upgradeQueue, &s2hv1.ConfigStaging{})).To(BeFalse())
+		g.Expect(isIncrementalDeployment(upgradeQueue, nil)).To(BeFalse())
+	})
+
+	It("should detect changed releases correctly", func() {
+		values := map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "bitnami/redis",
+				"tag":        "5.0.7-debian-9-r56",
+			},
+		}
+		deployed := &deployedReleases{
+			values: map[string][]byte{
+				"redis": []byte("image:\n  repository: bitnami/redis\n  tag: 5.0.7-debian-9-r56\n"),
+			},
+			chartVersions: map[string]string{"redis": "10.5.7"},
+		}
+
+		g.Expect(deployed.isChanged("redis", redisComp, values)).To(BeFalse())
+		g.Expect(deployed.isChanged("mariadb", redisComp, values)).To(BeTrue())
+
+		By("changing image tag")
+		newValues := map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "bitnami/redis",
+				"tag":        "5.0.7-debian-9-r57",
+			},
+		}
+		g.Expect(deployed.isChanged("redis", redisComp, newValues)).To(BeTrue())
+
+		By("changing chart version")
+		newComp := redisComp.DeepCopy()
+		newComp.Chart.Version = "10.6.0"
+		g.Expect(deployed.isChanged("redis", newComp, values)).To(BeTrue())
+
+		By("not tracking deployed releases")
+		var noDeployed *deployedReleases
+		g.Expect(noDeployed.isChanged("redis", redisComp, values)).To(BeTrue())
+	})
+})
@@ -0,0 +1,132 @@
+package staging
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/queue"
+)
+
+const (
+	deployTimeoutMessage = "queue deployment timeout"
+	testTimeoutMessage   = "queue testing timeout"
+)
+
+// getQueueFailureClass classifies failure of the queue from deployment issues and conditions,
+// empty string is returned if the queue did not fail
+func getQueueFailureClass(q *s2hv1.Queue) s2hv1.QueueFailureClass {
+	if !q.IsDeploySuccess() {
+		switch {
+		case hasDeploymentIssue(q, s2hv1.DeploymentIssueImagePullBackOff):
+			return s2hv1.FailureImagePullBackOff
+		case hasDeploymentIssue(q, s2hv1.DeploymentIssueCrashLoopBackOff):
+			return s2hv1.FailureCrashLoopBackOff
+		}
+
+		if cond := q.Status.GetCondition(s2hv1.QueueDeployed); cond != nil && cond.Message == deployTimeoutMessage {
+			return s2hv1.FailureDeployTimeout
+		}
+		return s2hv1.FailureDeployFailed
+	}
+
+	if !q.IsTestSuccess() {
+		if cond := q.Status.GetCondition(s2hv1.QueueTested); cond != nil && cond.Message == testTimeoutMessage {
+			return s2hv1.FailureTestTimeout
+		}
+		return s2hv1.FailureTestFailed
+	}
+
+	return ""
+}
+
+func hasDeploymentIssue(q *s2hv1.Queue, issueType s2hv1.DeploymentIssueType) bool {
+	for _, issue := range q.Status.DeploymentIssues {
+		if issue.IssueType == issueType {
+			return true
+		}
+	}
+	return false
+}
+
+// canRetryQueue returns true if the failed queue can be retried and the backoff before retrying,
+// the retry rule of the failure class is used if defined, otherwise MaxRetry of staging configuration
+func canRetryQueue(q *s2hv1.Queue, stagingConfig *s2hv1.ConfigStaging) (bool, time.Duration) {
+	if stagingConfig == nil {
+		return false, 0
+	}
+
+	failure := getQueueFailureClass(q)
+	rule := stagingConfig.RetryPolicy.GetRule(failure)
+	if rule == nil {
+		return q.Spec.NoOfRetry+1 <= stagingConfig.MaxRetry, 0
+	}
+
+	noOfFailureRetry := q.Spec.NoOfFailureRetry[failure] + 1
+	return noOfFailureRetry <= rule.MaxRetry, rule.GetBackoff(noOfFailureRetry)
+}
+
+// increaseQueueRetry increases retry counts of the queue and its failure class
+func increaseQueueRetry(q *s2hv1.Queue) {
+	q.Spec.NoOfRetry++
+
+	failure := getQueueFailureClass(q)
+	if failure == "" {
+		return
+	}
+
+	if q.Spec.NoOfFailureRetry == nil {
+		q.Spec.NoOfFailureRetry = make(map[s2hv1.QueueFailureClass]int)
+	}
+	q.Spec.NoOfFailureRetry[failure]++
+}
+
+// isRetestable returns true if only the testing failed and the environment is healthy,
+// so the testing can be re-run without redeploying
+func isRetestable(q *s2hv1.Queue, stagingConfig *s2hv1.ConfigStaging) bool {
+	if stagingConfig == nil || stagingConfig.RetryPolicy == nil || !stagingConfig.RetryPolicy.RetestOnly {
+		return false
+	}
+
+	if q.IsReverify() || len(q.Status.DeploymentIssues) > 0 {
+		return false
+	}
+
+	failure := getQueueFailureClass(q)
+	return failure == s2hv1.FailureTestFailed || failure == s2hv1.FailureTestTimeout
+}
+
+// retestQueue re-runs only the testing of the failed queue if the environment is healthy,
+// it returns true if the queue is going to be retested
+func (c *controller) retestQueue(q *s2hv1.Queue) (bool, error) {
+	cfg, err := c.getConfiguration()
+	if err != nil {
+		return false, err
+	}
+
+	if !isRetestable(q, cfg.Staging) {
+		return false, nil
+	}
+
+	canRetry, backoff := canRetryQueue(q, cfg.Staging)
+	if !canRetry {
+		return false, nil
+	}
+
+	increaseQueueRetry(q)
+	nextProcessAt := metav1.NewTime(time.Now().Add(backoff))
+	q.Spec.NextProcessAt = &nextProcessAt
+	resetTestingStatus(q)
+
+	logger.Info("retesting queue without redeploying",
+		"queue", q.Name, "noOfRetry", q.Spec.NoOfRetry, "backoff", backoff.String())
+
+	return true, c.updateQueueWithState(q, s2hv1.Testing)
+}
+
+// resetTestingStatus resets status of the queue to run the testing again on the deployed environment
+func resetTestingStatus(q *s2hv1.Queue) {
+	queue.ResetTestingStatus(q)
+	q.Status.QueueHistoryName = generateQueueHistoryName(q.Name)
+}
@@ -0,0 +1,126 @@
+package staging
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Retry policy", func() {
+	g := NewWithT(GinkgoT())
+
+	newFailedQueue := func(deployed bool, deployMsg, testMsg string, issues ...s2hv1.DeploymentIssueType) *s2hv1.Queue {
+		q := &s2hv1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "redis"}}
+		if deployed {
+			q.Status.SetCondition(s2hv1.QueueDeployed, corev1.ConditionTrue, "queue deployment succeeded")
+			q.Status.SetCondition(s2hv1.QueueTested, corev1.ConditionFalse, testMsg)
+		} else {
+			q.Status.SetCondition(s2hv1.QueueDeployed, corev1.ConditionFalse, deployMsg)
+		}
+		for _, issue := range issues {
+			q.Status.DeploymentIssues = append(q.Status.DeploymentIssues, s2hv1.DeploymentIssue{IssueType: issue})
+		}
+		return q
+	}
+
+	It("should correctly classify failure of the queue", func() {
+		q := newFailedQueue(false, deployTimeoutMessage, "",
+			s2hv1.DeploymentIssueCrashLoopBackOff, s2hv1.DeploymentIssueImagePullBackOff)
+		g.Expect(getQueueFailureClass(q)).To(Equal(s2hv1.FailureImagePullBackOff))
+
+		q = newFailedQueue(false, deployTimeoutMessage, "", s2hv1.DeploymentIssueCrashLoopBackOff)
+		g.Expect(getQueueFailureClass(q)).To(Equal(s2hv1.FailureCrashLoopBackOff))
+
+		q = newFailedQueue(false, deployTimeoutMessage, "", s2hv1.DeploymentIssuePending)
+		g.Expect(getQueueFailureClass(q)).To(Equal(s2hv1.FailureDeployTimeout))
+
+		q = newFailedQueue(false, "release deployment failed: error", "")
+		g.Expect(getQueueFailureClass(q)).To(Equal(s2hv1.FailureDeployFailed))
+
+		q = newFailedQueue(true, "", testTimeoutMessage)
+		g.Expect(getQueueFailureClass(q)).To(Equal(s2hv1.FailureTestTimeout))
+
+		q = newFailedQueue(true, "", "queue testing failed")
+		g.Expect(getQueueFailureClass(q)).To(Equal(s2hv1.FailureTestFailed))
+	})
+
+	It("should retry with max retry if no rule of the failure class", func() {
+		stagingConfig := &s2hv1.ConfigStaging{
+			MaxRetry: 1,
+			RetryPolicy: &s2hv1.ConfigRetryPolicy{
+				Rules: []s2hv1.ConfigRetryRule{{Failure: s2hv1.FailureTestFailed, MaxRetry: 3}},
+			},
+		}
+
+		q := newFailedQueue(false, deployTimeoutMessage, "")
+		canRetry, backoff := canRetryQueue(q, stagingConfig)
+		g.Expect(canRetry).To(BeTrue())
+		g.Expect(backoff).To(BeZero())
+
+		increaseQueueRetry(q)
+		canRetry, _ = canRetryQueue(q, stagingConfig)
+		g.Expect(canRetry).To(BeFalse())
+	})
+
+	It("should retry with retry counts and backoff of the failure class", func() {
+		stagingConfig := &s2hv1.ConfigStaging{
+			RetryPolicy: &s2hv1.ConfigRetryPolicy{
+				Rules: []s2hv1.ConfigRetryRule{{
+					Failure:  s2hv1.FailureTestFailed,
+					MaxRetry: 2,
+					Backoff:  metav1.Duration{Duration: time.Minute},
+				}},
+			},
+		}
+
+		q := newFailedQueue(true, "", "queue testing failed")
+		canRetry, backoff := canRetryQueue(q, stagingConfig)
+		g.Expect(canRetry).To(BeTrue())
+		g.Expect(backoff).To(Equal(time.Minute))
+
+		increaseQueueRetry(q)
+		g.Expect(q.Spec.NoOfRetry).To(Equal(1))
+		g.Expect(q.Spec.NoOfFailureRetry).To(HaveKeyWithValue(s2hv1.FailureTestFailed, 1))
+
+		canRetry, backoff = canRetryQueue(q, stagingConfig)
+		g.Expect(canRetry).To(BeTrue())
+		g.Expect(backoff).To(Equal(2 * time.Minute))
+
+		increaseQueueRetry(q)
+		canRetry, _ = canRetryQueue(q, stagingConfig)
+		g.Expect(canRetry).To(BeFalse())
+	})
+
+	It("should retest only if the testing failed on the healthy environment", func() {
+		stagingConfig := &s2hv1.ConfigStaging{RetryPolicy: &s2hv1.ConfigRetryPolicy{RetestOnly: true}}
+
+		g.Expect(isRetestable(newFailedQueue(true, "", "queue testing failed"), stagingConfig)).To(BeTrue())
+		g.Expect(isRetestable(newFailedQueue(true, "", testTimeoutMessage), stagingConfig)).To(BeTrue())
+		g.Expect(isRetestable(newFailedQueue(true, "", "queue testing failed",
+			s2hv1.DeploymentIssueCrashLoopBackOff), stagingConfig)).To(BeFalse())
+		g.Expect(isRetestable(newFailedQueue(false, deployTimeoutMessage, ""), stagingConfig)).To(BeFalse())
+		g.Expect(isRetestable(newFailedQueue(true, "", "queue testing failed"),
+			&s2hv1.ConfigStaging{})).To(BeFalse())
+	})
+
+	It("should reset testing status and keep deployment status", func() {
+		q := newFailedQueue(true, "", "queue testing failed")
+		q.Status.SetCondition(s2hv1.QueueTestTriggered, corev1.ConditionTrue, "queue testing triggered")
+		q.Status.TestRunner.Gitlab.PipelineID = "1234"
+		q.Status.KubeZipLog = "log"
+		q.Status.QueueHistoryName = "redis-1"
+
+		resetTestingStatus(q)
+		g.Expect(q.Status.IsConditionTrue(s2hv1.QueueDeployed)).To(BeTrue())
+		g.Expect(q.Status.IsContains(s2hv1.QueueTested)).To(BeFalse())
+		g.Expect(q.Status.IsContains(s2hv1.QueueTestTriggered)).To(BeFalse())
+		g.Expect(q.Status.TestRunner).To(Equal(s2hv1.TestRunner{}))
+		g.Expect(q.Status.KubeZipLog).To(BeEmpty())
+		g.Expect(q.Status.QueueHistoryName).NotTo(Equal("redis-1"))
+	})
+})
@@ -1,7 +1,6 @@
 package staging
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -16,6 +15,9 @@ import (
 	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/gitlab"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/jenkins"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/k8sjob"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/rest"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/teamcity"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/testmock"
 	samsahairpc "github.com/agoda-com/samsahai/pkg/samsahai/rpc"
@@ -37,8 +39,15 @@ const (
 )
 
 func (c *controller) startTesting(queue *s2hv1.Queue) error {
+	// wait for backoff before retesting the queue
+	if queue.Spec.NextProcessAt != nil && time.Now().Before(queue.Spec.NextProcessAt.Time) {
+		time.Sleep(2 * time.Second)
+		return nil
+	}
+
+	testConfig := c.getTestConfiguration(queue)
 	testingTimeout := metav1.Duration{Duration: testTimeout}
-	if testConfig := c.getTestConfiguration(queue); testConfig != nil && testConfig.Timeout.Duration != 0 {
+	if testConfig != nil && testConfig.Timeout.Duration != 0 {
 		testingTimeout = testConfig.Timeout
 	}
 
@@ -50,18 +59,23 @@ func (c *controller) startTesting(queue *s2hv1.Queue) error {
 
 	// check test config
 	// if no test configuration, change state to `s2hv1.Collecting`
-	skipTest, testRunners, err := c.checkTestConfig(queue)
+	skipTest, testRunners, err := c.checkTestConfig(queue, testConfig)
 	if err != nil || skipTest {
 		return err
 	}
 
+	// run named test stages instead of test runners
+	if len(testConfig.Stages) > 0 {
+		return c.runTestStages(queue, testConfig)
+	}
+
 	// trigger the tests
 	notTriggeredTest := !queue.Status.IsConditionTrue(s2hv1.QueueTestTriggered)
 	notReachTriggerTimeout := queue.Status.StartTestingTime != nil &&
 		metav1.Now().Sub(queue.Status.StartTestingTime.Time) <= testTriggerTimeout
 	// check testing timeout
 	if notTriggeredTest && notReachTriggerTimeout {
-		err = c.triggerTest(queue, testRunners)
+		err = c.triggerTest(queue, testConfig, testRunners)
 		if err != nil {
 			// retry util time passed testTriggerTimeout
 			return err
@@ -79,11 +93,8 @@ func (c *controller) startTesting(queue *s2hv1.Queue) error {
 	}
 
 	// send pending status while test is running
-	isSentPendingStatus := queue.Status.IsContains(s2hv1.QueueTestPendingStatusSent)
-	if queue.Spec.Type == s2hv1.QueueTypePullRequest && !isSentPendingStatus {
-		if err := c.sendTestPendingResult(queue); err != nil {
-			return err
-		}
+	if err := c.ensureTestPendingResultSent(queue); err != nil {
+		return err
 	}
 
 	// get result from tests (polling check)
@@ -100,7 +111,7 @@ func (c *controller) startTesting(queue *s2hv1.Queue) error {
 			continue
 		}
 
-		testResult, err := c.getTestResult(queue, testRunner)
+		testResult, err := c.getTestResult(queue, testConfig, testRunner)
 
 		// unfinished test
 		if testResult == testResultUnknown && err == nil {
@@ -137,7 +148,7 @@ func (c *controller) checkTestTimeout(queue *s2hv1.Queue, testingTimeout metav1.
 		now.Sub(queue.Status.StartTestingTime.Time) > testingTimeout.Duration {
 
 		// testing timeout
-		if err := c.updateTestQueueCondition(queue, v1.ConditionFalse, "queue testing timeout"); err != nil {
+		if err := c.updateTestQueueCondition(queue, v1.ConditionFalse, testTimeoutMessage); err != nil {
 			return err
 		}
 
@@ -149,11 +160,9 @@ func (c *controller) checkTestTimeout(queue *s2hv1.Queue, testingTimeout metav1.
 }
 
 // checkTestConfig checks test configuration and return list of testRunners
-func (c *controller) checkTestConfig(queue *s2hv1.Queue) (
+func (c *controller) checkTestConfig(queue *s2hv1.Queue, testConfig *s2hv1.ConfigTestRunner) (
 	skipTest bool, testRunners []internal.StagingTestRunner, err error) {
 
-	testRunners = make([]internal.StagingTestRunner, 0)
-
 	if queue.Spec.SkipTestRunner {
 		if err = c.updateTestQueueCondition(
 			queue,
@@ -165,7 +174,6 @@ func (c *controller) checkTestConfig(queue *s2hv1.Queue) (
 		return true, nil, nil
 	}
 
-	testConfig := c.getTestConfiguration(queue)
 	if testConfig == nil {
 		if err = c.updateTestQueueCondition(
 			queue,
@@ -178,18 +186,10 @@ func (c *controller) checkTestConfig(queue *s2hv1.Queue) (
 	}
 
 	skipTest = false
+	testRunners = c.getTestRunners(testConfig)
 
-	if testConfig.Teamcity != nil {
-		testRunners = append(testRunners, c.testRunners[teamcity.TestRunnerName])
-	}
-	if testConfig.Gitlab != nil {
-		testRunners = append(testRunners, c.testRunners[gitlab.TestRunnerName])
-	}
-	if testConfig.TestMock != nil {
-		testRunners = append(testRunners, c.testRunners[testmock.TestRunnerName])
-	}
-
-	if len(testRunners) == 0 {
+	// test runners of stages are selected when running each stage
+	if len(testRunners) == 0 && len(testConfig.Stages) == 0 {
 		if err = c.updateTestQueueCondition(queue, v1.ConditionFalse, "test runner not found"); err != nil {
 			return
 		}
@@ -206,16 +206,46 @@ func (c *controller) checkTestConfig(queue *s2hv1.Queue) (
 	return
 }
 
-func (c *controller) triggerTest(queue *s2hv1.Queue, testRunners []internal.StagingTestRunner) error {
+// getTestRunners returns list of testRunners of the test configuration
+func (c *controller) getTestRunners(testConfig *s2hv1.ConfigTestRunner) []internal.StagingTestRunner {
+	testRunners := make([]internal.StagingTestRunner, 0)
+	if testConfig == nil {
+		return testRunners
+	}
+
+	if testConfig.Teamcity != nil {
+		testRunners = append(testRunners, c.testRunners[teamcity.TestRunnerName])
+	}
+	if testConfig.Gitlab != nil {
+		testRunners = append(testRunners, c.testRunners[gitlab.TestRunnerName])
+	}
+	if testConfig.TestMock != nil {
+		testRunners = append(testRunners, c.testRunners[testmock.TestRunnerName])
+	}
+	if testConfig.Rest != nil {
+		testRunners = append(testRunners, c.testRunners[rest.TestRunnerName])
+	}
+	if testConfig.Jenkins != nil {
+		testRunners = append(testRunners, c.testRunners[jenkins.TestRunnerName])
+	}
+	if testConfig.K8sJob != nil {
+		testRunners = append(testRunners, c.testRunners[k8sjob.TestRunnerName])
+	}
+
+	return testRunners
+}
+
+func (c *controller) triggerTest(queue *s2hv1.Queue, testConfig *s2hv1.ConfigTestRunner,
+	testRunners []internal.StagingTestRunner) error {
+
 	var wg sync.WaitGroup
 	errs := make([]error, len(testRunners))
-	testConfig := c.getTestConfiguration(queue)
 
 	for i, testRunner := range testRunners {
 		// if test is not triggered yet, do...
 		if !testRunner.IsTriggered(queue) {
 			wg.Add(1)
-			go func(runner internal.StagingTestRunner) {
+			go func(i int, runner internal.StagingTestRunner) {
 				defer wg.Done()
 				// trigger test and update k8s object
 				if err := runner.Trigger(testConfig, c.getCurrentQueue()); err != nil {
@@ -228,7 +258,7 @@ func (c *controller) triggerTest(queue *s2hv1.Queue, testRunners []internal.Stag
 				if tr := runner.GetName(); tr == teamcity.TestRunnerName {
 					queue.Status.TestRunner.Teamcity.BuildNumber = "Build cannot be triggered in time"
 				}
-			}(testRunner)
+			}(i, testRunner)
 		}
 	}
 
@@ -246,14 +276,15 @@ func (c *controller) triggerTest(queue *s2hv1.Queue, testRunners []internal.Stag
 	return nil
 }
 
-func (c *controller) getTestResult(queue *s2hv1.Queue, testRunner internal.StagingTestRunner) (testResult, error) {
+func (c *controller) getTestResult(queue *s2hv1.Queue, testConfig *s2hv1.ConfigTestRunner,
+	testRunner internal.StagingTestRunner) (testResult, error) {
+
 	pollingTime := metav1.Duration{Duration: testPolling}
-	if c.getTestConfiguration(queue).PollingTime.Duration != 0 {
-		pollingTime = c.getTestConfiguration(queue).PollingTime
+	if testConfig.PollingTime.Duration != 0 {
+		pollingTime = testConfig.PollingTime
 	}
 
 	testRunnerName := testRunner.GetName()
-	testConfig := c.getTestConfiguration(queue)
 
 	// Getting result with retry MAXRETRY times
 	var isResultSuccess, isBuildFinished bool
@@ -306,6 +337,12 @@ func (c *controller) setTestResultCondition(queue *s2hv1.Queue, testRunnerName s
 		condType = s2hv1.QueueGitlabTestResult
 	case teamcity.TestRunnerName:
 		condType = s2hv1.QueueTeamcityTestResult
+	case rest.TestRunnerName:
+		condType = s2hv1.QueueRestTestResult
+	case jenkins.TestRunnerName:
+		condType = s2hv1.QueueJenkinsTestResult
+	case k8sjob.TestRunnerName:
+		condType = s2hv1.QueueK8sJobTestResult
 	default:
 		return nil
 	}
@@ -332,10 +369,20 @@ func (c *controller) setTestResultCondition(queue *s2hv1.Queue, testRunnerName s
 	return c.updateQueue(queue)
 }
 
+// ensureTestPendingResultSent sends pending status of pull request queue if it has not been sent
+func (c *controller) ensureTestPendingResultSent(queue *s2hv1.Queue) error {
+	isSentPendingStatus := queue.Status.IsContains(s2hv1.QueueTestPendingStatusSent)
+	if queue.Spec.Type == s2hv1.QueueTypePullRequest && !isSentPendingStatus {
+		return c.sendTestPendingResult(queue)
+	}
+
+	return nil
+}
+
 func (c *controller) sendTestPendingResult(queue *s2hv1.Queue) error {
 	headers := make(http.Header)
 	headers.Set(internal.SamsahaiAuthHeader, c.authToken)
-	ctx, err := twirp.WithHTTPRequestHeaders(context.TODO(), headers)
+	ctx, err := twirp.WithHTTPRequestHeaders(c.getTraceContext(), headers)
 	if err != nil {
 		logger.Error(err, "cannot set request header")
 		return err
@@ -0,0 +1,251 @@
+package staging
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+)
+
+// runTestStages runs named test stages sequentially or in parallel,
+// the queue testing succeeds if all required stages passed
+func (c *controller) runTestStages(queue *s2hv1.Queue, testConfig *s2hv1.ConfigTestRunner) error {
+	if !isTestStagesMatched(queue.Status.TestStages, testConfig.Stages) {
+		queue.Status.TestStages = newTestStages(testConfig.Stages)
+		if err := c.updateQueue(queue); err != nil {
+			return err
+		}
+	}
+
+	// send pending status while test is running
+	if err := c.ensureTestPendingResultSent(queue); err != nil {
+		return err
+	}
+
+	allStageFinished := true
+	requiredStageFailed := false
+	for i := range testConfig.Stages {
+		stage := &queue.Status.TestStages[i]
+
+		// the next stages are skipped if the previous required stage failed
+		if !testConfig.Parallel && requiredStageFailed && !stage.IsFinished() {
+			stage.SetResult(s2hv1.TestStageSkipped, "previous required stage failed")
+			if err := c.updateQueue(queue); err != nil {
+				return err
+			}
+		}
+
+		if !stage.IsFinished() {
+			if err := c.runTestStage(queue, i, testConfig); err != nil {
+				return err
+			}
+		}
+
+		stage = &queue.Status.TestStages[i]
+		if !stage.IsFinished() {
+			allStageFinished = false
+			if !testConfig.Parallel {
+				break
+			}
+			continue
+		}
+
+		if stage.Required && stage.Result == s2hv1.TestStageFailed {
+			requiredStageFailed = true
+		}
+	}
+
+	if !allStageFinished {
+		pollingTime := testPolling
+		if testConfig.PollingTime.Duration != 0 {
+			pollingTime = testConfig.PollingTime.Duration
+		}
+		time.Sleep(pollingTime)
+		return nil
+	}
+
+	testCondition, message := getTestStagesResult(queue.Status.TestStages)
+	return c.updateTestQueueCondition(queue, testCondition, message)
+}
+
+// runTestStage triggers test runners of the stage and updates the stage result when they have finished
+func (c *controller) runTestStage(queue *s2hv1.Queue, i int, testConfig *s2hv1.ConfigTestRunner) error {
+	stageConfig := testConfig.Stages[i]
+	stageTestConfig := stageConfig.GetTestRunner(testConfig)
+	stage := &queue.Status.TestStages[i]
+
+	testRunners := c.getTestRunners(stageTestConfig)
+	if len(testRunners) == 0 {
+		stage.SetResult(s2hv1.TestStageFailed, "test runner not found")
+		return c.updateQueue(queue)
+	}
+
+	now := metav1.Now()
+	if stage.StartTime == nil {
+		stage.StartTime = &now
+		stage.Result = s2hv1.TestStageRunning
+		queue.Status.SetCondition(
+			s2hv1.QueueTestTriggered,
+			v1.ConditionTrue,
+			"queue testing triggered")
+		if err := c.updateQueue(queue); err != nil {
+			return err
+		}
+	}
+
+	stageTimeout := testTimeout
+	if stageTestConfig.Timeout.Duration != 0 {
+		stageTimeout = stageTestConfig.Timeout.Duration
+	}
+	if now.Sub(stage.StartTime.Time) > stageTimeout {
+		stage.SetResult(s2hv1.TestStageFailed, "stage testing timeout")
+		return c.updateQueue(queue)
+	}
+
+	// test runners update the test runner of the queue, so the stage is run on a copy of the queue
+	// which holds the test runner of the stage
+	stageQueue := queue.DeepCopy()
+	stageQueue.Status.TestRunner = stage.TestRunner
+
+	notReachTriggerTimeout := now.Sub(stage.StartTime.Time) <= testTriggerTimeout
+	allTestFinished := true
+	failedTests := make([]string, 0)
+	for _, testRunner := range testRunners {
+		testRunnerName := testRunner.GetName()
+
+		if !testRunner.IsTriggered(stageQueue) {
+			if !notReachTriggerTimeout {
+				failedTests = append(failedTests, fmt.Sprintf("cannot trigger test on %s", testRunnerName))
+				continue
+			}
+
+			allTestFinished = false
+			err := testRunner.Trigger(stageTestConfig, stageQueue)
+			if syncErr := c.syncTestStage(queue, stageQueue, i); syncErr != nil {
+				return syncErr
+			}
+			if err != nil {
+				// retry util time passed testTriggerTimeout
+				logger.Error(err, "testing triggered error", "name", testRunnerName, "stage", stage.Name)
+				time.Sleep(testPolling)
+				return err
+			}
+			continue
+		}
+
+		result := c.getTestStageResult(stageTestConfig, stageQueue, testRunner)
+		if err := c.syncTestStage(queue, stageQueue, i); err != nil {
+			return err
+		}
+
+		switch result {
+		case testResultSuccess:
+		case testResultFailure, testResultUnknown:
+			failedTests = append(failedTests, fmt.Sprintf("test on %s %s", testRunnerName,
+				strings.ToLower(string(result))))
+		default:
+			allTestFinished = false
+		}
+	}
+
+	if !allTestFinished {
+		return nil
+	}
+
+	stage = &queue.Status.TestStages[i]
+	if len(failedTests) > 0 {
+		stage.SetResult(s2hv1.TestStageFailed, strings.Join(failedTests, ", "))
+	} else {
+		stage.SetResult(s2hv1.TestStagePassed, "stage testing succeeded")
+	}
+
+	return c.updateQueue(queue)
+}
+
+// getTestStageResult gets result of the test runner of the stage,
+// empty result is returned if the test is still running
+func (c *controller) getTestStageResult(stageTestConfig *s2hv1.ConfigTestRunner, stageQueue *s2hv1.Queue,
+	testRunner internal.StagingTestRunner) testResult {
+
+	isResultSuccess, isBuildFinished, err := testRunner.GetResult(stageTestConfig, stageQueue)
+	if err != nil {
+		// if configuration is invalid, or test is not triggered in first place
+		if isBuildFinished {
+			logger.Error(err, "testing get result error", "name", testRunner.GetName())
+			return testResultUnknown
+		}
+
+		// retry on the next polling
+		logger.Debug("waiting for test result", "name", testRunner.GetName())
+		return ""
+	}
+
+	if !isBuildFinished {
+		return ""
+	}
+
+	if !isResultSuccess {
+		return testResultFailure
+	}
+	return testResultSuccess
+}
+
+// syncTestStage copies the test runner of the stage queue back to the stage status and updates the queue
+func (c *controller) syncTestStage(queue, stageQueue *s2hv1.Queue, i int) error {
+	queue.Status.TestStages[i].TestRunner = stageQueue.Status.TestRunner
+	queue.ResourceVersion = stageQueue.ResourceVersion
+	if err := c.updateQueue(queue); err != nil {
+		return err
+	}
+
+	stageQueue.ResourceVersion = queue.ResourceVersion
+	return nil
+}
+
+func newTestStages(stageConfigs []s2hv1.ConfigTestStage) []s2hv1.TestStage {
+	stages := make([]s2hv1.TestStage, len(stageConfigs))
+	for i, stageConfig := range stageConfigs {
+		stages[i] = s2hv1.TestStage{
+			Name:     stageConfig.Name,
+			Required: stageConfig.IsRequired(),
+		}
+	}
+
+	return stages
+}
+
+// isTestStagesMatched checks whether stage statuses are created from the same named stages of the configuration
+func isTestStagesMatched(stages []s2hv1.TestStage, stageConfigs []s2hv1.ConfigTestStage) bool {
+	if len(stages) != len(stageConfigs) {
+		return false
+	}
+
+	for i := range stages {
+		if stages[i].Name != stageConfigs[i].Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getTestStagesResult returns the queue testing condition from results of test stages
+func getTestStagesResult(stages []s2hv1.TestStage) (v1.ConditionStatus, string) {
+	failedStages := make([]string, 0)
+	for _, stage := range stages {
+		if stage.Required && stage.Result != s2hv1.TestStagePassed {
+			failedStages = append(failedStages, stage.Name)
+		}
+	}
+
+	if len(failedStages) > 0 {
+		return v1.ConditionFalse, fmt.Sprintf("queue testing failed on stages: %s", strings.Join(failedStages, ", "))
+	}
+
+	return v1.ConditionTrue, "queue testing succeeded"
+}
@@ -0,0 +1,56 @@
+package staging
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Test stages", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should create stage statuses from configuration", func() {
+		notRequired := false
+		stages := newTestStages([]s2hv1.ConfigTestStage{
+			{Name: "smoke"},
+			{Name: "regression", Required: &notRequired},
+		})
+		g.Expect(stages).To(Equal([]s2hv1.TestStage{
+			{Name: "smoke", Required: true},
+			{Name: "regression", Required: false},
+		}))
+	})
+
+	It("should match stage statuses by names of configured stages", func() {
+		stages := []s2hv1.TestStage{{Name: "smoke"}, {Name: "regression"}}
+		g.Expect(isTestStagesMatched(stages, []s2hv1.ConfigTestStage{
+			{Name: "smoke"}, {Name: "regression"},
+		})).To(BeTrue())
+		g.Expect(isTestStagesMatched(stages, []s2hv1.ConfigTestStage{
+			{Name: "smoke"}, {Name: "perf"},
+		})).To(BeFalse())
+		g.Expect(isTestStagesMatched(stages, []s2hv1.ConfigTestStage{
+			{Name: "smoke"},
+		})).To(BeFalse())
+	})
+
+	It("should succeed if all required stages passed", func() {
+		status, _ := getTestStagesResult([]s2hv1.TestStage{
+			{Name: "smoke", Required: true, Result: s2hv1.TestStagePassed},
+			{Name: "regression", Required: false, Result: s2hv1.TestStageFailed},
+		})
+		g.Expect(status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("should fail with names of failed required stages", func() {
+		status, message := getTestStagesResult([]s2hv1.TestStage{
+			{Name: "smoke", Required: true, Result: s2hv1.TestStageFailed},
+			{Name: "regression", Required: true, Result: s2hv1.TestStageSkipped},
+			{Name: "perf", Required: false, Result: s2hv1.TestStageFailed},
+		})
+		g.Expect(status).To(Equal(corev1.ConditionFalse))
+		g.Expect(message).To(Equal("queue testing failed on stages: smoke, regression"))
+	})
+})
@@ -0,0 +1,101 @@
+package staging
+
+import (
+	"fmt"
+	"time"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/junit"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+const maxTestReportRequestTimeout = 10 * time.Second
+
+// collectTestReport collects summary of test results from test runners and JUnit XML url,
+// failing to collect test report does not affect the queue result
+func (c *controller) collectTestReport(queue *s2hv1.Queue) error {
+	testConfig := c.getTestConfiguration(queue)
+	if testConfig == nil || testConfig.TestReport == nil || queue.Status.TestReport != nil {
+		return nil
+	}
+
+	var report *s2hv1.TestReport
+	if len(testConfig.Stages) == 0 {
+		report = mergeTestReport(report, c.fetchTestRunnerReport(testConfig, queue))
+	}
+
+	for i, stage := range queue.Status.TestStages {
+		if i >= len(testConfig.Stages) {
+			break
+		}
+
+		// test runners read builds from the test runner of the queue
+		stageQueue := queue.DeepCopy()
+		stageQueue.Status.TestRunner = stage.TestRunner
+		stageTestConfig := testConfig.Stages[i].GetTestRunner(testConfig)
+		report = mergeTestReport(report, c.fetchTestRunnerReport(stageTestConfig, stageQueue))
+	}
+
+	if testConfig.TestReport.URL != "" {
+		reportURL := template.TextRender("TestReportURL", testConfig.TestReport.URL, queue)
+		r, err := fetchJUnitReport(reportURL)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("cannot fetch junit report: %v", err), "url", reportURL, "queue", queue.Name)
+		}
+		report = mergeTestReport(report, r)
+	}
+
+	if report == nil {
+		return nil
+	}
+
+	report.TruncateFailedTests(testConfig.TestReport.GetMaxFailedTests())
+	queue.Status.TestReport = report
+
+	return c.updateQueue(queue)
+}
+
+// fetchTestRunnerReport fetches test reports from builds of test runners
+func (c *controller) fetchTestRunnerReport(testConfig *s2hv1.ConfigTestRunner, queue *s2hv1.Queue) *s2hv1.TestReport {
+	var report *s2hv1.TestReport
+	for _, testRunner := range c.getTestRunners(testConfig) {
+		fetcher, ok := testRunner.(internal.StagingTestReportFetcher)
+		if !ok {
+			continue
+		}
+
+		r, err := fetcher.FetchTestReport(testConfig, queue)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("cannot fetch test report: %v", err),
+				"name", testRunner.GetName(), "queue", queue.Name)
+			continue
+		}
+		report = mergeTestReport(report, r)
+	}
+
+	return report
+}
+
+func fetchJUnitReport(reportURL string) (*s2hv1.TestReport, error) {
+	_, data, err := http.Get(reportURL, http.WithSkipTLSVerify(), http.WithTimeout(maxTestReportRequestTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return junit.Parse(data)
+}
+
+// mergeTestReport adds test results of other into report, nil is returned if both reports are nil
+func mergeTestReport(report, other *s2hv1.TestReport) *s2hv1.TestReport {
+	if other == nil {
+		return report
+	}
+	if report == nil {
+		report = &s2hv1.TestReport{}
+	}
+
+	report.Add(other)
+	return report
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	gohttp "net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
 	"github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/junit"
 	"github.com/agoda-com/samsahai/internal/util/template"
 )
 
@@ -53,6 +55,12 @@ type ResultResponse struct {
 	Status     string `json:"status"`
 }
 
+// JobResponse represents a job of the pipeline
+type JobResponse struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 type testRunner struct {
 	baseURL      string
 	privateToken string
@@ -244,3 +252,71 @@ func (t *testRunner) GetResult(testConfig *s2hv1.ConfigTestRunner, currentQueue
 func (t *testRunner) IsTriggered(queue *s2hv1.Queue) bool {
 	return queue.Status.TestRunner.Gitlab.PipelineID != ""
 }
+
+// FetchTestReport implements the staging testReportFetcher FetchTestReport function,
+// JUnit XML reports are collected from artifacts of all jobs in the pipeline
+func (t *testRunner) FetchTestReport(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (
+	*s2hv1.TestReport, error) {
+
+	if testConfig == nil || testConfig.Gitlab == nil ||
+		testConfig.TestReport == nil || testConfig.TestReport.GitlabArtifactPath == "" {
+		return nil, nil
+	}
+
+	if !t.IsTriggered(currentQueue) {
+		return nil, nil
+	}
+
+	projectID := testConfig.Gitlab.ProjectID
+	pipelineID := currentQueue.Status.TestRunner.Gitlab.PipelineID
+	artifactPath := strings.TrimPrefix(testConfig.TestReport.GitlabArtifactPath, "/")
+
+	opts := []http.Option{
+		http.WithSkipTLSVerify(),
+		http.WithTimeout(maxHTTPRequestTimeout),
+	}
+	if t.privateToken != "" {
+		opts = append(opts, http.WithHeader("PRIVATE-TOKEN", t.privateToken))
+	}
+
+	apiURL := fmt.Sprintf("%s/%s/%s/pipelines/%s/jobs?per_page=100", t.baseURL, baseAPIPath, projectID, pipelineID)
+	_, resp, err := http.Get(apiURL, opts...)
+	if err != nil {
+		logger.Error(err, "The HTTP request failed", "URL", apiURL)
+		return nil, err
+	}
+
+	var jobs []JobResponse
+	if err := json.Unmarshal(resp, &jobs); err != nil {
+		logger.Error(err, "cannot unmarshal request data")
+		return nil, err
+	}
+
+	var report *s2hv1.TestReport
+	for _, job := range jobs {
+		artifactURL := fmt.Sprintf("%s/%s/%s/jobs/%d/artifacts/%s",
+			t.baseURL, baseAPIPath, projectID, job.ID, artifactPath)
+		statusCode, data, err := http.Get(artifactURL, opts...)
+		if err != nil {
+			// the job does not have the test report artifact
+			if statusCode == gohttp.StatusNotFound {
+				continue
+			}
+			logger.Error(err, "The HTTP request failed", "URL", artifactURL)
+			return nil, err
+		}
+
+		jobReport, err := junit.Parse(data)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("cannot parse junit report of job %s: %v", job.Name, err))
+			continue
+		}
+
+		if report == nil {
+			report = &s2hv1.TestReport{}
+		}
+		report.Add(jobReport)
+	}
+
+	return report, nil
+}
@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 )
 
@@ -254,4 +255,41 @@ var _ = Describe("GitLab", func() {
 
 		})
 	})
+
+	Describe("Fetch Test Report", func() {
+		It("should collect junit reports from job artifacts", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				switch r.URL.Path {
+				case "/api/v4/projects/1234/pipelines/1111/jobs":
+					_, _ = w.Write([]byte(`[{"id": 1, "name": "build"}, {"id": 2, "name": "e2e"}]`))
+				case "/api/v4/projects/1234/jobs/2/artifacts/reports/junit.xml":
+					_, _ = w.Write([]byte(`<testsuite><testcase name="a"/><testcase name="b"><failure/></testcase></testsuite>`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			testConfig := mockTestConfig
+			testConfig.TestReport = &s2hv1.ConfigTestReport{GitlabArtifactPath: "/reports/junit.xml"}
+			currentQueue := mockQueue
+
+			glRunner := gitlab.New(nil, server.URL).(internal.StagingTestReportFetcher)
+			report, err := glRunner.FetchTestReport(&testConfig, &currentQueue)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(report).To(Equal(&s2hv1.TestReport{Total: 2, Failed: 1, FailedTests: []string{"b"}}))
+		})
+
+		It("should not collect test report without configuration", func() {
+			testConfig := mockTestConfig
+			currentQueue := mockQueue
+
+			glRunner := gitlab.New(nil, "").(internal.StagingTestReportFetcher)
+			report, err := glRunner.FetchTestReport(&testConfig, &currentQueue)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(report).To(BeNil())
+		})
+	})
 })
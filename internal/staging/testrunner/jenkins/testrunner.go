@@ -0,0 +1,330 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+var logger = s2hlog.Log.WithName(TestRunnerName)
+
+const (
+	TestRunnerName = "jenkins"
+
+	maxRunnerTimeout      = 30 * time.Second
+	maxHTTPRequestTimeout = 10 * time.Second
+
+	resultSuccess = "SUCCESS"
+
+	ParamEnvType     = "s2hEnvType"
+	ParamNamespace   = "s2hNamespace"
+	ParamVersion     = "s2hVersion"
+	ParamTeam        = "s2hTeam"
+	ParamGitCommit   = "s2hGitCommit"
+	ParamCompName    = "s2hComponentName"
+	ParamCompVersion = "s2hComponentVersion"
+	ParamQueueType   = "s2hQueueType"
+	ParamPRNumber    = "s2hPRNumber"
+)
+
+// QueueItemResponse represents a queue item of Jenkins which is resolved to the build when it starts
+type QueueItemResponse struct {
+	Cancelled  bool `json:"cancelled"`
+	Executable *struct {
+		Number int    `json:"number"`
+		URL    string `json:"url"`
+	} `json:"executable"`
+}
+
+// ResultResponse represents a build of Jenkins
+type ResultResponse struct {
+	Building bool   `json:"building"`
+	Result   string `json:"result"`
+}
+
+type testRunner struct {
+	client     client.Client
+	namespace  string
+	secretName string
+	username   string
+	apiToken   string
+}
+
+// NewOption allows specifying various configuration
+type NewOption func(*testRunner)
+
+// WithSecret specifies a secret which stores Jenkins username and api token,
+// the credentials are read from the secret every request
+func WithSecret(namespace, secretName string) NewOption {
+	return func(r *testRunner) {
+		r.namespace = namespace
+		r.secretName = secretName
+	}
+}
+
+// WithCredential specifies Jenkins username and api token to override when creating Jenkins test runner
+func WithCredential(username, apiToken string) NewOption {
+	return func(r *testRunner) {
+		r.username = username
+		r.apiToken = apiToken
+	}
+}
+
+// New creates a new jenkins test runner
+func New(client client.Client, opts ...NewOption) internal.StagingTestRunner {
+	t := &testRunner{
+		client: client,
+	}
+
+	// apply the new options
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
+}
+
+// GetName implements the staging testRunner GetName function
+func (t *testRunner) GetName() string {
+	return TestRunnerName
+}
+
+// Trigger implements the staging testRunner Trigger function
+func (t *testRunner) Trigger(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) error {
+	if testConfig == nil || testConfig.Jenkins == nil {
+		return errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"test configuration should not be nil. queue: %s", currentQueue.Name)
+	}
+
+	jenkinsConfig := testConfig.Jenkins
+	if jenkinsConfig.URL == "" || jenkinsConfig.JobName == "" {
+		return errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"jenkins url and job name should not be empty. queue: %s", currentQueue.Name)
+	}
+
+	prData := internal.PullRequestData{PRNumber: currentQueue.Spec.PRNumber}
+	branchName := template.TextRender("PullRequestBranchName", jenkinsConfig.Branch, prData)
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxRunnerTimeout)
+	defer cancelFn()
+
+	username, apiToken, err := t.getCredential(ctx)
+	if err != nil {
+		return err
+	}
+
+	apiURL := fmt.Sprintf("%s/buildWithParameters",
+		getJobURL(jenkinsConfig.URL, jenkinsConfig.JobName, branchName))
+	reqBody := []byte(getBuildParameters(jenkinsConfig, currentQueue, prData).Encode())
+
+	respHeader := gohttp.Header{}
+	opts := []http.Option{
+		http.WithSkipTLSVerify(),
+		http.WithTimeout(maxHTTPRequestTimeout),
+		http.WithContext(ctx),
+		http.WithHeader("Content-Type", "application/x-www-form-urlencoded"),
+		http.WithBasicAuth(username, apiToken),
+		http.WithResponseHeader(&respHeader),
+	}
+
+	if _, _, err := http.Post(apiURL, reqBody, opts...); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			logger.Error(s2herrors.ErrRequestTimeout, fmt.Sprintf("triggering took more than %v", maxRunnerTimeout))
+			return s2herrors.ErrRequestTimeout
+		}
+		logger.Error(err, "POST request failed", "url", apiURL)
+		return err
+	}
+
+	// Jenkins returns location of the queue item, the build number is resolved later when the build starts
+	queueItemURL := strings.TrimSuffix(respHeader.Get("Location"), "/")
+	if queueItemURL == "" {
+		return fmt.Errorf("queue item location not found in response, url: %s", apiURL)
+	}
+
+	currentQueue.Status.TestRunner.Jenkins.SetJenkins(jenkinsConfig.JobName, branchName, queueItemURL)
+	if t.client != nil {
+		if err := t.client.Update(ctx, currentQueue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetResult implements the staging testRunner GetResult function
+func (t *testRunner) GetResult(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (
+	isResultSuccess bool, isBuildFinished bool, err error) {
+
+	if testConfig == nil || testConfig.Jenkins == nil {
+		return false, true, errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"test configuration should not be nil. queue: %s", currentQueue.Name)
+	}
+
+	queueItemURL := currentQueue.Status.TestRunner.Jenkins.QueueItemURL
+	if !t.IsTriggered(currentQueue) {
+		return false, true, errors.Wrapf(s2herrors.ErrTestPipelineIDNotFound,
+			"cannot get test result. queueItemURL: '%s'. queue: %s", queueItemURL, currentQueue.Name)
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxRunnerTimeout)
+	defer cancelFn()
+
+	username, apiToken, err := t.getCredential(ctx)
+	if err != nil {
+		return false, false, err
+	}
+
+	opts := []http.Option{
+		http.WithSkipTLSVerify(),
+		http.WithTimeout(maxHTTPRequestTimeout),
+		http.WithContext(ctx),
+		http.WithBasicAuth(username, apiToken),
+	}
+
+	if currentQueue.Status.TestRunner.Jenkins.BuildURL == "" {
+		queueItem := &QueueItemResponse{}
+		if err := getJSON(queueItemURL+"/api/json", queueItem, opts...); err != nil {
+			return false, false, err
+		}
+
+		if queueItem.Cancelled {
+			logger.Warn("jenkins queue item has been cancelled", "queueItemURL", queueItemURL)
+			return false, true, nil
+		}
+
+		// the build has not been started yet
+		if queueItem.Executable == nil {
+			return false, false, nil
+		}
+
+		buildURL := strings.TrimSuffix(queueItem.Executable.URL, "/")
+		currentQueue.Status.TestRunner.Jenkins.SetBuild("#"+strconv.Itoa(queueItem.Executable.Number), buildURL)
+		if t.client != nil {
+			if err := t.client.Update(ctx, currentQueue); err != nil {
+				return false, false, err
+			}
+		}
+	}
+
+	buildURL := currentQueue.Status.TestRunner.Jenkins.BuildURL
+	build := &ResultResponse{}
+	if err := getJSON(buildURL+"/api/json", build, opts...); err != nil {
+		return false, false, err
+	}
+
+	isBuildFinished = !build.Building && build.Result != ""
+	isResultSuccess = strings.EqualFold(resultSuccess, build.Result)
+
+	return
+}
+
+func (t *testRunner) IsTriggered(queue *s2hv1.Queue) bool {
+	return queue.Status.TestRunner.Jenkins.QueueItemURL != ""
+}
+
+// getCredential returns Jenkins username and api token from the secret,
+// the credential from option is used if the secret does not exist
+func (t *testRunner) getCredential(ctx context.Context) (username, apiToken string, err error) {
+	username, apiToken = t.username, t.apiToken
+	if t.client == nil || t.secretName == "" {
+		return
+	}
+
+	secret := &corev1.Secret{}
+	err = t.client.Get(ctx, types.NamespacedName{Namespace: t.namespace, Name: t.secretName}, secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return username, apiToken, nil
+		}
+		return "", "", errors.Wrapf(err, "cannot get secret %s", t.secretName)
+	}
+
+	if v, ok := secret.Data[internal.VKJenkinsUsername]; ok && len(v) > 0 {
+		username = string(v)
+	}
+	if v, ok := secret.Data[internal.VKJenkinsAPIToken]; ok && len(v) > 0 {
+		apiToken = string(v)
+	}
+
+	return username, apiToken, nil
+}
+
+func getJSON(apiURL string, out interface{}, opts ...http.Option) error {
+	_, resp, err := http.Get(apiURL, opts...)
+	if err != nil {
+		logger.Error(err, "GET request failed", "url", apiURL)
+		return err
+	}
+
+	if err := json.Unmarshal(resp, out); err != nil {
+		logger.Error(err, "cannot unmarshal json response data", "url", apiURL)
+		return err
+	}
+
+	return nil
+}
+
+// getJobURL returns url of the job, folders of job name and branch of multibranch pipeline
+// are converted to Jenkins job path e.g. `team/regression` -> `/job/team/job/regression`
+func getJobURL(baseURL, jobName, branchName string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.TrimSuffix(baseURL, "/"))
+	for _, name := range strings.Split(strings.Trim(jobName, "/"), "/") {
+		sb.WriteString("/job/")
+		sb.WriteString(url.PathEscape(name))
+	}
+
+	if branchName != "" {
+		sb.WriteString("/job/")
+		sb.WriteString(url.PathEscape(branchName))
+	}
+
+	return sb.String()
+}
+
+func getBuildParameters(jenkinsConfig *s2hv1.ConfigJenkins, currentQueue *s2hv1.Queue,
+	prData internal.PullRequestData) url.Values {
+
+	compVersion := "multiple-components"
+	if len(currentQueue.Spec.Components) == 1 {
+		compVersion = currentQueue.Spec.Components[0].Version
+	}
+
+	params := url.Values{}
+	for k, v := range jenkinsConfig.Parameters {
+		params.Set(k, template.TextRender("JenkinsParameter", v, prData))
+	}
+
+	params.Set(ParamEnvType, currentQueue.GetEnvType())
+	params.Set(ParamNamespace, currentQueue.Namespace)
+	params.Set(ParamVersion, internal.Version)
+	params.Set(ParamTeam, currentQueue.Spec.TeamName)
+	params.Set(ParamGitCommit, internal.GitCommit)
+	params.Set(ParamCompName, currentQueue.Name)
+	params.Set(ParamCompVersion, compVersion)
+	params.Set(ParamQueueType, currentQueue.GetQueueType())
+	if prData.PRNumber != "" {
+		params.Set(ParamPRNumber, prData.PRNumber)
+	}
+
+	return params
+}
@@ -0,0 +1,210 @@
+package jenkins_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/jenkins"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestJenkins(t *testing.T) {
+	unittest.InitGinkgo(t, "Jenkins Test Runner")
+}
+
+var _ = Describe("Jenkins Test Runner", func() {
+	g := NewWithT(GinkgoT())
+
+	mockNamespace := "s2h-teamtest"
+	mockSecretName := "s2h-teamtest-secret"
+
+	var server *httptest.Server
+
+	newQueue := func() *s2hv1.Queue {
+		return &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: mockNamespace},
+			Spec: s2hv1.QueueSpec{
+				Name:     "redis",
+				TeamName: "teamtest",
+				Type:     s2hv1.QueueTypeUpgrade,
+				Components: s2hv1.QueueComponents{
+					{Name: "redis", Repository: "bitnami/redis", Version: "5.0.7"},
+				},
+			},
+		}
+	}
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("Trigger", func() {
+		It("should successfully trigger parameterized job with credential from secret", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.URL.Path).To(Equal("/job/team/job/regression/job/PR-12/buildWithParameters"))
+
+				username, apiToken, ok := r.BasicAuth()
+				g.Expect(ok).To(BeTrue())
+				g.Expect(username).To(Equal("jenkins-user"))
+				g.Expect(apiToken).To(Equal("jenkins-token"))
+
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).NotTo(HaveOccurred())
+				params, err := url.ParseQuery(string(body))
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(params.Get(jenkins.ParamCompName)).To(Equal("redis"))
+				g.Expect(params.Get(jenkins.ParamCompVersion)).To(Equal("5.0.7"))
+				g.Expect(params.Get(jenkins.ParamPRNumber)).To(Equal("12"))
+				g.Expect(params.Get("suite")).To(Equal("pr-12"))
+
+				w.Header().Set("Location", fmt.Sprintf("http://%s/queue/item/42/", r.Host))
+				w.WriteHeader(http.StatusCreated)
+			}))
+
+			q := newQueue()
+			q.Spec.PRNumber = "12"
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: mockSecretName, Namespace: mockNamespace},
+				Data: map[string][]byte{
+					internal.VKJenkinsUsername: []byte("jenkins-user"),
+					internal.VKJenkinsAPIToken: []byte("jenkins-token"),
+				},
+			}
+			c := unittest.NewFakeClient(q, secret)
+
+			runner := jenkins.New(c, jenkins.WithSecret(mockNamespace, mockSecretName))
+			g.Expect(runner.GetName()).To(Equal(jenkins.TestRunnerName))
+			g.Expect(runner.IsTriggered(q)).To(BeFalse())
+
+			testConfig := &s2hv1.ConfigTestRunner{
+				Jenkins: &s2hv1.ConfigJenkins{
+					URL:        server.URL,
+					JobName:    "team/regression",
+					Branch:     "PR-{{ .PRNumber }}",
+					Parameters: map[string]string{"suite": "pr-{{ .PRNumber }}"},
+				},
+			}
+			err := runner.Trigger(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(runner.IsTriggered(q)).To(BeTrue())
+			g.Expect(q.Status.TestRunner.Jenkins.JobName).To(Equal("team/regression"))
+			g.Expect(q.Status.TestRunner.Jenkins.Branch).To(Equal("PR-12"))
+			g.Expect(q.Status.TestRunner.Jenkins.QueueItemURL).To(Equal(server.URL + "/queue/item/42"))
+		})
+
+		It("should fail to trigger test without configuration", func() {
+			runner := jenkins.New(nil)
+			err := runner.Trigger(&s2hv1.ConfigTestRunner{}, newQueue())
+			g.Expect(errors.Is(err, s2herrors.ErrTestConfigurationNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("GetResult", func() {
+		It("should resolve queue item to build and get test result", func() {
+			executable := ""
+			building := "true"
+			result := "null"
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.Method).To(Equal(http.MethodGet))
+				username, apiToken, ok := r.BasicAuth()
+				g.Expect(ok).To(BeTrue())
+				g.Expect(username).To(Equal("user"))
+				g.Expect(apiToken).To(Equal("token"))
+
+				switch r.URL.Path {
+				case "/queue/item/42/api/json":
+					if executable == "" {
+						_, _ = w.Write([]byte(`{"cancelled": false, "executable": null}`))
+						return
+					}
+					_, _ = w.Write([]byte(executable))
+				case "/job/regression/7/api/json":
+					_, _ = w.Write([]byte(fmt.Sprintf(`{"building": %s, "result": %s}`, building, result)))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			q := newQueue()
+			q.Status.TestRunner.Jenkins.QueueItemURL = server.URL + "/queue/item/42"
+			testConfig := &s2hv1.ConfigTestRunner{
+				Jenkins: &s2hv1.ConfigJenkins{URL: server.URL, JobName: "regression"},
+			}
+			runner := jenkins.New(nil, jenkins.WithCredential("user", "token"))
+
+			By("waiting for the build to start")
+			isSuccess, isFinished, err := runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeFalse())
+			g.Expect(isSuccess).To(BeFalse())
+			g.Expect(q.Status.TestRunner.Jenkins.BuildNumber).To(BeEmpty())
+
+			By("the build is running")
+			executable = fmt.Sprintf(`{"executable": {"number": 7, "url": "%s/job/regression/7/"}}`, server.URL)
+			isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeFalse())
+			g.Expect(isSuccess).To(BeFalse())
+			g.Expect(q.Status.TestRunner.Jenkins.BuildNumber).To(Equal("#7"))
+			g.Expect(q.Status.TestRunner.Jenkins.BuildURL).To(Equal(server.URL + "/job/regression/7"))
+
+			By("the build has finished")
+			building = "false"
+			result = `"SUCCESS"`
+			isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+			g.Expect(isSuccess).To(BeTrue())
+
+			result = `"UNSTABLE"`
+			isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+			g.Expect(isSuccess).To(BeFalse())
+		})
+
+		It("should finish with failure if queue item has been cancelled", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"cancelled": true}`))
+			}))
+
+			q := newQueue()
+			q.Status.TestRunner.Jenkins.QueueItemURL = server.URL + "/queue/item/42"
+			testConfig := &s2hv1.ConfigTestRunner{
+				Jenkins: &s2hv1.ConfigJenkins{URL: server.URL, JobName: "regression"},
+			}
+
+			isSuccess, isFinished, err := jenkins.New(nil).GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+			g.Expect(isSuccess).To(BeFalse())
+		})
+
+		It("should fail to get result if test has not been triggered", func() {
+			testConfig := &s2hv1.ConfigTestRunner{
+				Jenkins: &s2hv1.ConfigJenkins{URL: "http://localhost", JobName: "regression"},
+			}
+			_, isFinished, err := jenkins.New(nil).GetResult(testConfig, newQueue())
+			g.Expect(errors.Is(err, s2herrors.ErrTestPipelineIDNotFound)).To(BeTrue())
+			g.Expect(isFinished).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,263 @@
+package k8sjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+)
+
+var logger = s2hlog.Log.WithName(TestRunnerName)
+
+var invalidJobNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+const (
+	TestRunnerName = "k8sjob"
+
+	// JobLabelQueue is a label of the job which tells the queue name
+	JobLabelQueue = "samsahai.io/queue"
+	// JobLabelTestStage is a label of the job which tells the test stage name
+	JobLabelTestStage = "samsahai.io/test-stage"
+
+	maxRunnerTimeout    = 30 * time.Second
+	maxJobNameLength    = 63
+	jobNameSuffixLength = 5
+
+	EnvEnvType     = "S2H_ENV_TYPE"
+	EnvNamespace   = "S2H_NAMESPACE"
+	EnvVersion     = "S2H_VERSION"
+	EnvTeam        = "S2H_TEAM"
+	EnvGitCommit   = "S2H_GIT_COMMIT"
+	EnvCompName    = "S2H_COMPONENT_NAME"
+	EnvCompVersion = "S2H_COMPONENT_VERSION"
+	EnvComponents  = "S2H_COMPONENTS"
+	EnvQueueType   = "S2H_QUEUE_TYPE"
+	EnvPRNumber    = "S2H_PR_NUMBER"
+)
+
+type testRunner struct {
+	client client.Client
+}
+
+// New creates a new k8sjob test runner
+func New(client client.Client) internal.StagingTestRunner {
+	t := &testRunner{
+		client: client,
+	}
+
+	return t
+}
+
+// GetName implements the staging testRunner GetName function
+func (t *testRunner) GetName() string {
+	return TestRunnerName
+}
+
+// Trigger implements the staging testRunner Trigger function
+func (t *testRunner) Trigger(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) error {
+	if testConfig == nil || testConfig.K8sJob == nil {
+		return errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"test configuration should not be nil. queue: %s", currentQueue.Name)
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxRunnerTimeout)
+	defer cancelFn()
+
+	job, err := newJob(testConfig.K8sJob, currentQueue, testConfig.StageName)
+	if err != nil {
+		return err
+	}
+
+	if err := controllerutil.SetControllerReference(currentQueue, job, t.client.Scheme()); err != nil {
+		logger.Warn(fmt.Sprintf("cannot set controller reference for job %s", job.Name))
+	}
+
+	if err := t.client.Create(ctx, job); err != nil {
+		logger.Error(err, "cannot create test job", "job", job.Name, "namespace", job.Namespace)
+		return err
+	}
+
+	currentQueue.Status.TestRunner.K8sJob.JobName = job.Name
+	if err := t.client.Update(ctx, currentQueue); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetResult implements the staging testRunner GetResult function
+func (t *testRunner) GetResult(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (
+	isResultSuccess bool, isBuildFinished bool, err error) {
+
+	if testConfig == nil || testConfig.K8sJob == nil {
+		return false, true, errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"test configuration should not be nil. queue: %s", currentQueue.Name)
+	}
+
+	jobName := currentQueue.Status.TestRunner.K8sJob.JobName
+	if !t.IsTriggered(currentQueue) {
+		return false, true, errors.Wrapf(s2herrors.ErrTestPipelineIDNotFound,
+			"cannot get test result. jobName: '%s'. queue: %s", jobName, currentQueue.Name)
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxRunnerTimeout)
+	defer cancelFn()
+
+	job := &batchv1.Job{}
+	err = t.client.Get(ctx, types.NamespacedName{Namespace: currentQueue.Namespace, Name: jobName}, job)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, true, errors.Wrapf(err, "test job %s not found", jobName)
+		}
+		return false, false, err
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, true, nil
+		case batchv1.JobFailed:
+			logger.Debug("test job failed", "job", jobName, "reason", cond.Reason)
+			return false, true, nil
+		}
+	}
+
+	return false, false, nil
+}
+
+func (t *testRunner) IsTriggered(queue *s2hv1.Queue) bool {
+	return queue.Status.TestRunner.K8sJob.JobName != ""
+}
+
+// newJob creates a job from the pod template with env vars of the queue
+func newJob(jobConfig *s2hv1.ConfigK8sJob, q *s2hv1.Queue, stageName string) (*batchv1.Job, error) {
+	podTemplate, err := jobConfig.Template.PodTemplateSpec()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pod template of k8sjob")
+	}
+
+	if len(podTemplate.Spec.Containers) == 0 {
+		return nil, errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"pod template of k8sjob should have at least one container. queue: %s", q.Name)
+	}
+
+	if podTemplate.Spec.RestartPolicy == "" {
+		podTemplate.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+
+	envVars, err := getEnvVars(q)
+	if err != nil {
+		return nil, err
+	}
+	for i := range podTemplate.Spec.Containers {
+		podTemplate.Spec.Containers[i].Env = append(podTemplate.Spec.Containers[i].Env, envVars...)
+	}
+
+	labels := internal.GetDefaultLabels(q.Spec.TeamName)
+	labels[JobLabelQueue] = q.Name
+	if stageName != "" {
+		labels[JobLabelTestStage] = normalizeStageName(stageName)
+	}
+
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = make(map[string]string)
+	}
+	for k, v := range labels {
+		podTemplate.Labels[k] = v
+	}
+
+	backoffLimit := int32(0)
+	if jobConfig.BackoffLimit != nil {
+		backoffLimit = *jobConfig.BackoffLimit
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GenJobName(q, stageName),
+			Namespace: q.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template:     podTemplate,
+		},
+	}
+
+	return job, nil
+}
+
+// GenJobName returns a unique job name of the queue and the test stage,
+// the random suffix prevents reusing jobs of other stages or previous triggers
+func GenJobName(q *s2hv1.Queue, stageName string) string {
+	suffix := fmt.Sprintf("-test-%s", rand.String(jobNameSuffixLength))
+	name := q.Name
+	if stageName != "" {
+		name = fmt.Sprintf("%s-%s", name, normalizeStageName(stageName))
+	}
+	if len(name)+len(suffix) > maxJobNameLength {
+		name = strings.TrimRight(name[:maxJobNameLength-len(suffix)], "-.")
+	}
+
+	return name + suffix
+}
+
+// normalizeStageName converts the stage name to be a valid part of job name and label value
+func normalizeStageName(stageName string) string {
+	name := invalidJobNameChars.ReplaceAllString(strings.ToLower(stageName), "-")
+	if len(name) > maxJobNameLength {
+		name = name[:maxJobNameLength]
+	}
+
+	return strings.Trim(name, "-")
+}
+
+func getEnvVars(q *s2hv1.Queue) ([]corev1.EnvVar, error) {
+	compVersion := "multiple-components"
+	if len(q.Spec.Components) == 1 {
+		compVersion = q.Spec.Components[0].Version
+	}
+
+	components, err := json.Marshal(q.Spec.Components)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal queue components")
+	}
+
+	envVars := []corev1.EnvVar{
+		{Name: EnvEnvType, Value: q.GetEnvType()},
+		{Name: EnvNamespace, Value: q.Namespace},
+		{Name: EnvVersion, Value: internal.Version},
+		{Name: EnvTeam, Value: q.Spec.TeamName},
+		{Name: EnvGitCommit, Value: internal.GitCommit},
+		{Name: EnvCompName, Value: q.Name},
+		{Name: EnvCompVersion, Value: compVersion},
+		{Name: EnvComponents, Value: string(components)},
+		{Name: EnvQueueType, Value: q.GetQueueType()},
+	}
+
+	if q.Spec.PRNumber != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: EnvPRNumber, Value: q.Spec.PRNumber})
+	}
+
+	return envVars, nil
+}
@@ -0,0 +1,174 @@
+package k8sjob_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/k8sjob"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestK8sJob(t *testing.T) {
+	unittest.InitGinkgo(t, "K8sJob Test Runner")
+}
+
+var _ = Describe("K8sJob Test Runner", func() {
+	g := NewWithT(GinkgoT())
+
+	mockNamespace := "s2h-teamtest"
+
+	var c client.Client
+	var q *s2hv1.Queue
+	var testConfig *s2hv1.ConfigTestRunner
+
+	BeforeEach(func() {
+		q = &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: mockNamespace, UID: "queue-uid"},
+			Spec: s2hv1.QueueSpec{
+				Name:     "redis",
+				TeamName: "teamtest",
+				Type:     s2hv1.QueueTypeUpgrade,
+				Components: s2hv1.QueueComponents{
+					{Name: "redis", Repository: "bitnami/redis", Version: "5.0.7"},
+				},
+			},
+		}
+
+		testConfig = &s2hv1.ConfigTestRunner{
+			K8sJob: &s2hv1.ConfigK8sJob{
+				Template: s2hv1.PodTemplate{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":    "smoke",
+								"image":   "alpine:3",
+								"command": []interface{}{"sh", "-c", "echo $S2H_NAMESPACE"},
+								"env": []interface{}{
+									map[string]interface{}{"name": "SUITE", "value": "smoke"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c = unittest.NewFakeClient(q)
+	})
+
+	getJob := func() *batchv1.Job {
+		job := &batchv1.Job{}
+		err := c.Get(context.TODO(),
+			types.NamespacedName{Namespace: mockNamespace, Name: q.Status.TestRunner.K8sJob.JobName}, job)
+		g.Expect(err).NotTo(HaveOccurred())
+		return job
+	}
+
+	It("should create job from pod template with env vars of the queue", func() {
+		runner := k8sjob.New(c)
+		g.Expect(runner.GetName()).To(Equal(k8sjob.TestRunnerName))
+		g.Expect(runner.IsTriggered(q)).To(BeFalse())
+
+		err := runner.Trigger(testConfig, q)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(runner.IsTriggered(q)).To(BeTrue())
+		g.Expect(q.Status.TestRunner.K8sJob.JobName).To(HavePrefix("redis-test-"))
+
+		job := getJob()
+		g.Expect(job.Labels).To(HaveKeyWithValue(k8sjob.JobLabelQueue, "redis"))
+		g.Expect(*job.Spec.BackoffLimit).To(BeEquivalentTo(0))
+		g.Expect(job.OwnerReferences).To(HaveLen(1))
+		g.Expect(job.OwnerReferences[0].Name).To(Equal("redis"))
+
+		podSpec := job.Spec.Template.Spec
+		g.Expect(podSpec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		g.Expect(podSpec.Containers).To(HaveLen(1))
+		g.Expect(podSpec.Containers[0].Image).To(Equal("alpine:3"))
+		g.Expect(podSpec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "SUITE", Value: "smoke"},
+			corev1.EnvVar{Name: k8sjob.EnvNamespace, Value: mockNamespace},
+			corev1.EnvVar{Name: k8sjob.EnvCompName, Value: "redis"},
+			corev1.EnvVar{Name: k8sjob.EnvCompVersion, Value: "5.0.7"},
+			corev1.EnvVar{Name: k8sjob.EnvQueueType, Value: "component-upgrade"},
+		))
+	})
+
+	It("should correctly get test result from job conditions", func() {
+		runner := k8sjob.New(c)
+		g.Expect(runner.Trigger(testConfig, q)).To(Succeed())
+
+		isSuccess, isFinished, err := runner.GetResult(testConfig, q)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isFinished).To(BeFalse())
+		g.Expect(isSuccess).To(BeFalse())
+
+		job := getJob()
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}
+		g.Expect(c.Update(context.TODO(), job)).To(Succeed())
+
+		isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isFinished).To(BeTrue())
+		g.Expect(isSuccess).To(BeFalse())
+
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+		}
+		g.Expect(c.Update(context.TODO(), job)).To(Succeed())
+
+		isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isFinished).To(BeTrue())
+		g.Expect(isSuccess).To(BeTrue())
+	})
+
+	It("should fail to trigger test with invalid configuration", func() {
+		runner := k8sjob.New(c)
+		err := runner.Trigger(&s2hv1.ConfigTestRunner{}, q)
+		g.Expect(errors.Is(err, s2herrors.ErrTestConfigurationNotFound)).To(BeTrue())
+
+		err = runner.Trigger(&s2hv1.ConfigTestRunner{K8sJob: &s2hv1.ConfigK8sJob{}}, q)
+		g.Expect(errors.Is(err, s2herrors.ErrTestConfigurationNotFound)).To(BeTrue())
+	})
+
+	It("should fail to get result if test has not been triggered", func() {
+		_, isFinished, err := k8sjob.New(c).GetResult(testConfig, q)
+		g.Expect(errors.Is(err, s2herrors.ErrTestPipelineIDNotFound)).To(BeTrue())
+		g.Expect(isFinished).To(BeTrue())
+	})
+
+	It("should create a new job of the test stage on every trigger", func() {
+		runner := k8sjob.New(c)
+		stageConfig := s2hv1.ConfigTestStage{Name: "E2E_Test", K8sJob: testConfig.K8sJob}
+		stageTestConfig := stageConfig.GetTestRunner(testConfig)
+
+		g.Expect(runner.Trigger(stageTestConfig, q)).To(Succeed())
+		firstJobName := q.Status.TestRunner.K8sJob.JobName
+		g.Expect(firstJobName).To(HavePrefix("redis-e2e-test-test-"))
+		g.Expect(getJob().Labels).To(HaveKeyWithValue(k8sjob.JobLabelTestStage, "e2e-test"))
+
+		g.Expect(runner.Trigger(stageTestConfig, q)).To(Succeed())
+		g.Expect(q.Status.TestRunner.K8sJob.JobName).NotTo(Equal(firstJobName))
+	})
+
+	It("should generate job name within 63 characters", func() {
+		q.Name = strings.Repeat("a", 70)
+		g.Expect(len(k8sjob.GenJobName(q, ""))).To(BeNumerically("<=", 63))
+		g.Expect(len(k8sjob.GenJobName(q, "integration"))).To(BeNumerically("<=", 63))
+		g.Expect(k8sjob.GenJobName(q, "smoke")).NotTo(Equal(k8sjob.GenJobName(q, "smoke")))
+	})
+})
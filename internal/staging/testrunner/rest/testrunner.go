@@ -0,0 +1,351 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	s2hhttp "github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+var logger = s2hlog.Log.WithName(TestRunnerName)
+
+const (
+	TestRunnerName = "rest"
+
+	// HeaderSecretKeyPrefix is a prefix of secret keys which are sent as http headers,
+	// e.g. `rest-header.Authorization`
+	HeaderSecretKeyPrefix = "rest-header."
+
+	maxRunnerTimeout      = 30 * time.Second
+	maxHTTPRequestTimeout = 10 * time.Second
+
+	multipleComponents = "multiple-components"
+)
+
+// TemplateData represents data for rendering url and body of requests
+type TemplateData struct {
+	TeamName         string `json:"teamName"`
+	Namespace        string `json:"namespace"`
+	EnvType          string `json:"envType"`
+	QueueType        string `json:"queueType"`
+	QueueName        string `json:"queueName"`
+	ComponentName    string `json:"componentName"`
+	ComponentVersion string `json:"componentVersion"`
+	PRNumber         string `json:"prNumber,omitempty"`
+	BuildID          string `json:"buildID,omitempty"`
+	Version          string `json:"version"`
+	GitCommit        string `json:"gitCommit"`
+}
+
+type testRunner struct {
+	client     client.Client
+	namespace  string
+	secretName string
+}
+
+// NewOption allows specifying various configuration
+type NewOption func(*testRunner)
+
+// WithSecret specifies a secret which stores http headers with `rest-header.` prefix
+func WithSecret(namespace, secretName string) NewOption {
+	return func(r *testRunner) {
+		r.namespace = namespace
+		r.secretName = secretName
+	}
+}
+
+// New creates a new rest test runner
+func New(client client.Client, opts ...NewOption) internal.StagingTestRunner {
+	t := &testRunner{
+		client: client,
+	}
+
+	// apply the new options
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
+}
+
+// GetName implements the staging testRunner GetName function
+func (t *testRunner) GetName() string {
+	return TestRunnerName
+}
+
+// Trigger implements the staging testRunner Trigger function
+func (t *testRunner) Trigger(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) error {
+	if testConfig == nil || testConfig.Rest == nil {
+		return errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"test configuration should not be nil. queue: %s", currentQueue.Name)
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxRunnerTimeout)
+	defer cancelFn()
+
+	restConfig := testConfig.Rest
+	data := newTemplateData(currentQueue)
+
+	reqBody := []byte(template.TextRender("RestTriggerBody", restConfig.Trigger.Body, data))
+	if restConfig.Trigger.Body == "" {
+		var err error
+		if reqBody, err = json.Marshal(data); err != nil {
+			logger.Error(err, "cannot marshal request data")
+			return err
+		}
+	}
+
+	resp, err := t.request(ctx, restConfig, restConfig.Trigger, http.MethodPost, reqBody, data)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			logger.Error(s2herrors.ErrRequestTimeout, fmt.Sprintf("triggering took more than %v", maxRunnerTimeout))
+			return s2herrors.ErrRequestTimeout
+		}
+		return err
+	}
+
+	buildID, err := findJSONPath(resp, restConfig.BuildIDPath)
+	if err != nil {
+		logger.Error(err, "cannot get build id from response", "path", restConfig.BuildIDPath)
+		return err
+	}
+	if buildID == "" {
+		return fmt.Errorf("build id not found in response, path: %s", restConfig.BuildIDPath)
+	}
+
+	buildNumber := buildID
+	if restConfig.BuildNumberPath != "" {
+		if buildNumber, err = findJSONPath(resp, restConfig.BuildNumberPath); err != nil {
+			logger.Error(err, "cannot get build number from response", "path", restConfig.BuildNumberPath)
+			return err
+		}
+	}
+
+	var buildURL string
+	if restConfig.BuildURLPath != "" {
+		if buildURL, err = findJSONPath(resp, restConfig.BuildURLPath); err != nil {
+			logger.Error(err, "cannot get build url from response", "path", restConfig.BuildURLPath)
+			return err
+		}
+	}
+
+	currentQueue.Status.TestRunner.Rest.SetBuild(buildID, buildNumber, buildURL)
+	if t.client != nil {
+		if err := t.client.Update(ctx, currentQueue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetResult implements the staging testRunner GetResult function
+func (t *testRunner) GetResult(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (
+	isResultSuccess bool, isBuildFinished bool, err error) {
+
+	if testConfig == nil || testConfig.Rest == nil {
+		return false, true, errors.Wrapf(s2herrors.ErrTestConfigurationNotFound,
+			"test configuration should not be nil. queue: %s", currentQueue.Name)
+	}
+
+	buildID := currentQueue.Status.TestRunner.Rest.BuildID
+	if !t.IsTriggered(currentQueue) {
+		return false, true, errors.Wrapf(s2herrors.ErrTestPipelineIDNotFound,
+			"cannot get test result. buildID: '%s'. queue: %s", buildID, currentQueue.Name)
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxRunnerTimeout)
+	defer cancelFn()
+
+	restConfig := testConfig.Rest
+	data := newTemplateData(currentQueue)
+
+	reqBody := []byte(template.TextRender("RestStatusBody", restConfig.Status.Body, data))
+	resp, err := t.request(ctx, restConfig, restConfig.Status, http.MethodGet, reqBody, data)
+	if err != nil {
+		// retry the process to get the result
+		logger.Warn(fmt.Sprintf("cannot get test status: %v", err), "buildID", buildID)
+		return false, false, nil
+	}
+
+	finished, err := findJSONPath(resp, restConfig.FinishedPath)
+	if err != nil {
+		logger.Error(err, "cannot get finished field from response", "path", restConfig.FinishedPath)
+		return false, false, err
+	}
+
+	success, err := findJSONPath(resp, restConfig.SuccessPath)
+	if err != nil {
+		logger.Error(err, "cannot get success field from response", "path", restConfig.SuccessPath)
+		return false, false, err
+	}
+
+	isBuildFinished = isFinished(finished, restConfig.FinishedValues)
+	isResultSuccess = isSuccess(success, restConfig.SuccessValues)
+
+	return
+}
+
+func (t *testRunner) IsTriggered(queue *s2hv1.Queue) bool {
+	return queue.Status.TestRunner.Rest.BuildID != ""
+}
+
+// request renders url of the request and sends with http headers from configuration and secret
+func (t *testRunner) request(ctx context.Context, restConfig *s2hv1.ConfigRest, req s2hv1.RestRequest,
+	defaultMethod string, body []byte, data TemplateData) (interface{}, error) {
+
+	method := strings.ToUpper(req.Method)
+	if method == "" {
+		method = defaultMethod
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		body = nil
+	}
+
+	reqURL := template.TextRender("RestURL", req.URL, data)
+	opts := []s2hhttp.Option{
+		s2hhttp.WithSkipTLSVerify(),
+		s2hhttp.WithTimeout(maxHTTPRequestTimeout),
+		s2hhttp.WithContext(ctx),
+	}
+
+	headers, err := t.getHeaders(ctx, restConfig)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		opts = append(opts, s2hhttp.WithHeader(k, v))
+	}
+
+	_, resp, err := s2hhttp.Do(method, reqURL, body, opts...)
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("%s request failed", method), "url", reqURL)
+		return nil, err
+	}
+
+	var out interface{}
+	decoder := json.NewDecoder(bytes.NewReader(resp))
+	decoder.UseNumber()
+	if err := decoder.Decode(&out); err != nil {
+		logger.Error(err, "cannot unmarshal json response data", "url", reqURL)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// getHeaders returns http headers from configuration and the secret
+func (t *testRunner) getHeaders(ctx context.Context, restConfig *s2hv1.ConfigRest) (map[string]string, error) {
+	headers := make(map[string]string)
+	for k, v := range restConfig.Headers {
+		headers[k] = v
+	}
+
+	if t.client == nil || t.secretName == "" {
+		return headers, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := t.client.Get(ctx, types.NamespacedName{Namespace: t.namespace, Name: t.secretName}, secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return headers, nil
+		}
+		return nil, errors.Wrapf(err, "cannot get secret %s", t.secretName)
+	}
+
+	for k, v := range secret.Data {
+		if !strings.HasPrefix(k, HeaderSecretKeyPrefix) {
+			continue
+		}
+		headers[strings.TrimPrefix(k, HeaderSecretKeyPrefix)] = string(v)
+	}
+
+	return headers, nil
+}
+
+func newTemplateData(q *s2hv1.Queue) TemplateData {
+	compVersion := multipleComponents
+	if len(q.Spec.Components) == 1 {
+		compVersion = q.Spec.Components[0].Version
+	}
+
+	return TemplateData{
+		TeamName:         q.Spec.TeamName,
+		Namespace:        q.Namespace,
+		EnvType:          q.GetEnvType(),
+		QueueType:        q.GetQueueType(),
+		QueueName:        q.Name,
+		ComponentName:    q.Spec.Name,
+		ComponentVersion: compVersion,
+		PRNumber:         q.Spec.PRNumber,
+		BuildID:          q.Status.TestRunner.Rest.BuildID,
+		Version:          internal.Version,
+		GitCommit:        internal.GitCommit,
+	}
+}
+
+// findJSONPath returns a value of json path expression, both `{.id}` and `.id` are supported
+func findJSONPath(data interface{}, expr string) (string, error) {
+	if expr == "" {
+		return "", nil
+	}
+
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+
+	jp := jsonpath.New("rest")
+	jp.AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return "", errors.Wrapf(err, "invalid json path %s", expr)
+	}
+
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func isFinished(value string, finishedValues []string) bool {
+	if len(finishedValues) == 0 {
+		return value != "" && !strings.EqualFold(value, "false") && !strings.EqualFold(value, "null")
+	}
+	return containsFold(finishedValues, value)
+}
+
+func isSuccess(value string, successValues []string) bool {
+	if len(successValues) == 0 {
+		return strings.EqualFold(value, "true")
+	}
+	return containsFold(successValues, value)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/rest"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestRest(t *testing.T) {
+	unittest.InitGinkgo(t, "Rest Test Runner")
+}
+
+var _ = Describe("Rest Test Runner", func() {
+	g := NewWithT(GinkgoT())
+
+	mockNamespace := "s2h-teamtest"
+	mockSecretName := "s2h-teamtest-secret"
+	mockToken := "Bearer 123456"
+
+	var server *httptest.Server
+
+	newQueue := func() *s2hv1.Queue {
+		return &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: mockNamespace},
+			Spec: s2hv1.QueueSpec{
+				Name:     "redis",
+				TeamName: "teamtest",
+				Type:     s2hv1.QueueTypeUpgrade,
+				Components: s2hv1.QueueComponents{
+					{Name: "redis", Repository: "bitnami/redis", Version: "5.0.7"},
+				},
+			},
+		}
+	}
+
+	newTestConfig := func(url string) *s2hv1.ConfigTestRunner {
+		return &s2hv1.ConfigTestRunner{
+			Rest: &s2hv1.ConfigRest{
+				Trigger: s2hv1.RestRequest{
+					URL:  url + "/teams/{{ .TeamName }}/builds",
+					Body: `{"component": "{{ .ComponentName }}", "version": "{{ .ComponentVersion }}"}`,
+				},
+				Status: s2hv1.RestRequest{
+					URL: url + "/builds/{{ .BuildID }}",
+				},
+				BuildIDPath:    "{.build.id}",
+				BuildURLPath:   ".build.url",
+				FinishedPath:   "{.state}",
+				FinishedValues: []string{"completed", "cancelled"},
+				SuccessPath:    "{.result}",
+				SuccessValues:  []string{"passed"},
+				Headers:        map[string]string{"X-Source": "samsahai"},
+			},
+		}
+	}
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+		}
+	})
+
+	Describe("Trigger", func() {
+		It("should successfully trigger test with headers from secret", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.URL.Path).To(Equal("/teams/teamtest/builds"))
+				g.Expect(r.Header.Get("Authorization")).To(Equal(mockToken))
+				g.Expect(r.Header.Get("X-Source")).To(Equal("samsahai"))
+
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(string(body)).To(Equal(`{"component": "redis", "version": "5.0.7"}`))
+
+				_, err = w.Write([]byte(`{"build": {"id": 1234567, "url": "https://ci.example.com/builds/1234567"}}`))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+
+			q := newQueue()
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: mockSecretName, Namespace: mockNamespace},
+				Data: map[string][]byte{
+					rest.HeaderSecretKeyPrefix + "Authorization": []byte(mockToken),
+					"teamcity-username":                          []byte("user"),
+				},
+			}
+			c := unittest.NewFakeClient(q, secret)
+
+			runner := rest.New(c, rest.WithSecret(mockNamespace, mockSecretName))
+			g.Expect(runner.GetName()).To(Equal(rest.TestRunnerName))
+			g.Expect(runner.IsTriggered(q)).To(BeFalse())
+
+			err := runner.Trigger(newTestConfig(server.URL), q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(runner.IsTriggered(q)).To(BeTrue())
+			g.Expect(q.Status.TestRunner.Rest).To(Equal(s2hv1.Build{
+				BuildID:     "1234567",
+				BuildNumber: "1234567",
+				BuildURL:    "https://ci.example.com/builds/1234567",
+			}))
+
+			updated := &s2hv1.Queue{}
+			g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(q), updated)).To(Succeed())
+			g.Expect(updated.Status.TestRunner.Rest.BuildID).To(Equal("1234567"))
+		})
+
+		It("should fail to trigger test if build id not found", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"message": "queued"}`))
+			}))
+
+			runner := rest.New(nil)
+			err := runner.Trigger(newTestConfig(server.URL), newQueue())
+			g.Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail to trigger test without configuration", func() {
+			runner := rest.New(nil)
+			err := runner.Trigger(&s2hv1.ConfigTestRunner{}, newQueue())
+			g.Expect(errors.Is(err, s2herrors.ErrTestConfigurationNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("GetResult", func() {
+		It("should correctly get test result", func() {
+			status := map[string]interface{}{"state": "running", "result": ""}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.Method).To(Equal(http.MethodGet))
+				g.Expect(r.URL.Path).To(Equal("/builds/1234"))
+				g.Expect(json.NewEncoder(w).Encode(status)).To(Succeed())
+			}))
+
+			q := newQueue()
+			q.Status.TestRunner.Rest.BuildID = "1234"
+			runner := rest.New(nil)
+			testConfig := newTestConfig(server.URL)
+
+			isSuccess, isFinished, err := runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeFalse())
+			g.Expect(isSuccess).To(BeFalse())
+
+			status = map[string]interface{}{"state": "completed", "result": "PASSED"}
+			isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+			g.Expect(isSuccess).To(BeTrue())
+
+			status = map[string]interface{}{"state": "cancelled", "result": "failed"}
+			isSuccess, isFinished, err = runner.GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+			g.Expect(isSuccess).To(BeFalse())
+		})
+
+		It("should use boolean fields by default", func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"finished": true, "success": true}`))
+			}))
+
+			q := newQueue()
+			q.Status.TestRunner.Rest.BuildID = "1234"
+			testConfig := newTestConfig(server.URL)
+			testConfig.Rest.FinishedPath = ".finished"
+			testConfig.Rest.FinishedValues = nil
+			testConfig.Rest.SuccessPath = ".success"
+			testConfig.Rest.SuccessValues = nil
+
+			isSuccess, isFinished, err := rest.New(nil).GetResult(testConfig, q)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+			g.Expect(isSuccess).To(BeTrue())
+		})
+
+		It("should fail to get result if test has not been triggered", func() {
+			_, isFinished, err := rest.New(nil).GetResult(newTestConfig("http://localhost"), newQueue())
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(isFinished).To(BeTrue())
+		})
+	})
+})
@@ -68,6 +68,22 @@ type ResultResponse struct {
 	Status      string `xml:"status,attr"`
 }
 
+// BuildTestOccurrencesResponse represents a summary of test occurrences of the build
+type BuildTestOccurrencesResponse struct {
+	TestOccurrences struct {
+		Count   int `xml:"count,attr"`
+		Failed  int `xml:"failed,attr"`
+		Ignored int `xml:"ignored,attr"`
+	} `xml:"testOccurrences"`
+}
+
+// TestOccurrencesResponse represents test occurrences of the build
+type TestOccurrencesResponse struct {
+	TestOccurrences []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"testOccurrence"`
+}
+
 type testRunner struct {
 	username string
 	password string
@@ -270,3 +286,68 @@ func (t *testRunner) GetResult(testConfig *s2hv1.ConfigTestRunner, currentQueue
 func (t *testRunner) IsTriggered(queue *s2hv1.Queue) bool {
 	return queue.Status.TestRunner.Teamcity.BuildID != ""
 }
+
+// FetchTestReport implements the staging testReportFetcher FetchTestReport function,
+// the summary is collected from test occurrences of the build
+func (t *testRunner) FetchTestReport(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (
+	*s2hv1.TestReport, error) {
+
+	if testConfig == nil || testConfig.Teamcity == nil ||
+		testConfig.TestReport == nil || !testConfig.TestReport.Teamcity {
+		return nil, nil
+	}
+
+	if !t.IsTriggered(currentQueue) {
+		return nil, nil
+	}
+
+	buildID := currentQueue.Status.TestRunner.Teamcity.BuildID
+	opts := []http.Option{
+		http.WithSkipTLSVerify(),
+		http.WithTimeout(maxHTTPRequestTimeout),
+		http.WithBasicAuth(t.username, t.password),
+	}
+
+	apiURL := fmt.Sprintf("%s/%s/builds/id:%s?fields=testOccurrences(count,failed,ignored)",
+		t.baseURL, baseAPIPath, buildID)
+	_, resp, err := http.Get(apiURL, opts...)
+	if err != nil {
+		logger.Error(err, "The HTTP request failed", "URL", apiURL)
+		return nil, err
+	}
+
+	var build BuildTestOccurrencesResponse
+	if err := xml.Unmarshal(resp, &build); err != nil {
+		logger.Error(err, "cannot unmarshal request data")
+		return nil, err
+	}
+
+	report := &s2hv1.TestReport{
+		Total:   build.TestOccurrences.Count,
+		Failed:  build.TestOccurrences.Failed,
+		Skipped: build.TestOccurrences.Ignored,
+	}
+	if report.Failed == 0 {
+		return report, nil
+	}
+
+	apiURL = fmt.Sprintf("%s/%s/testOccurrences?locator=build:(id:%s),status:FAILURE,count:%d&fields=testOccurrence(name)",
+		t.baseURL, baseAPIPath, buildID, testConfig.TestReport.GetMaxFailedTests())
+	_, resp, err = http.Get(apiURL, opts...)
+	if err != nil {
+		logger.Error(err, "The HTTP request failed", "URL", apiURL)
+		return nil, err
+	}
+
+	var occurrences TestOccurrencesResponse
+	if err := xml.Unmarshal(resp, &occurrences); err != nil {
+		logger.Error(err, "cannot unmarshal request data")
+		return nil, err
+	}
+
+	for _, occurrence := range occurrences.TestOccurrences {
+		report.FailedTests = append(report.FailedTests, occurrence.Name)
+	}
+
+	return report, nil
+}
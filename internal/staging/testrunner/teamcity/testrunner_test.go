@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 )
 
@@ -250,4 +251,39 @@ var _ = Describe("Teamcity Test Runner", func() {
 			g.Expect(tcRunner.IsTriggered(&currentQueue)).To(BeFalse())
 		})
 	})
+
+	Describe("Fetch Test Report", func() {
+		It("should collect summary and failed tests from test occurrences", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				switch r.URL.Path {
+				case "/httpAuth/app/rest/builds/id:1234":
+					_, _ = w.Write([]byte(`<build><testOccurrences count="10" failed="2" ignored="1"/></build>`))
+				case "/httpAuth/app/rest/testOccurrences":
+					g.Expect(r.URL.Query().Get("locator")).To(Equal("build:(id:1234),status:FAILURE,count:5"))
+					_, _ = w.Write([]byte(`<testOccurrences>` +
+						`<testOccurrence name="suite: test a"/><testOccurrence name="suite: test b"/>` +
+						`</testOccurrences>`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			testConfig := mockTestConfig
+			testConfig.TestReport = &s2hv1.ConfigTestReport{Teamcity: true, MaxFailedTests: 5}
+			currentQueue := mockQueue
+
+			tcRunner := teamcity.New(nil, server.URL, "", "").(internal.StagingTestReportFetcher)
+			report, err := tcRunner.FetchTestReport(&testConfig, &currentQueue)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(report).To(Equal(&s2hv1.TestReport{
+				Total:       10,
+				Failed:      2,
+				Skipped:     1,
+				FailedTests: []string{"suite: test a", "suite: test b"},
+			}))
+		})
+	})
 })
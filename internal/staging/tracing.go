@@ -0,0 +1,155 @@
+package staging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/tracing"
+)
+
+// queueSpans holds spans of the current queue,
+// the queue span is the parent of the spans of each queue state
+type queueSpans struct {
+	mu        sync.Mutex
+	queueKey  string
+	queueCtx  context.Context
+	queueSpan trace.Span
+	state     s2hv1.QueueState
+	stateCtx  context.Context
+	stateSpan trace.Span
+}
+
+// traceQueueState starts a span of the current queue state, the span of the previous state is ended
+func (c *controller) traceQueueState(q *s2hv1.Queue) {
+	c.spans.mu.Lock()
+	defer c.spans.mu.Unlock()
+
+	// a retried queue is a new run of the queue
+	queueKey := string(q.UID)
+	if q.Status.CreatedAt != nil {
+		queueKey += q.Status.CreatedAt.String()
+	}
+
+	if c.spans.queueKey != queueKey {
+		c.endQueueSpansLocked()
+
+		c.spans.queueKey = queueKey
+		c.spans.queueCtx, c.spans.queueSpan = tracing.StartSpan(context.Background(), "staging.queue",
+			attribute.String("team", c.teamName),
+			attribute.String("queue", q.Name),
+			attribute.String("queueType", string(q.Spec.Type)),
+			attribute.Int("noOfRetry", q.Spec.NoOfRetry))
+	}
+
+	state := q.Status.State
+	if state == "" {
+		state = s2hv1.Waiting
+	}
+
+	if c.spans.stateSpan != nil && c.spans.state == state {
+		return
+	}
+
+	if c.spans.stateSpan != nil {
+		c.spans.stateSpan.End()
+	}
+
+	c.spans.state = state
+	c.spans.stateCtx, c.spans.stateSpan = tracing.StartSpan(c.spans.queueCtx, fmt.Sprintf("staging.%s", state),
+		attribute.String("queue", q.Name),
+		attribute.String("state", string(state)))
+}
+
+// recordTraceError records the error into the span of the current queue state
+func (c *controller) recordTraceError(err error) {
+	c.spans.mu.Lock()
+	defer c.spans.mu.Unlock()
+
+	if err != nil && c.spans.stateSpan != nil {
+		c.spans.stateSpan.RecordError(err)
+	}
+}
+
+// endQueueSpans ends spans of the finished queue
+func (c *controller) endQueueSpans() {
+	c.spans.mu.Lock()
+	defer c.spans.mu.Unlock()
+
+	c.endQueueSpansLocked()
+}
+
+func (c *controller) endQueueSpansLocked() {
+	if c.spans.stateSpan != nil {
+		c.spans.stateSpan.End()
+	}
+	if c.spans.queueSpan != nil {
+		c.spans.queueSpan.End()
+	}
+
+	c.spans.queueKey = ""
+	c.spans.queueCtx, c.spans.queueSpan = nil, nil
+	c.spans.state = ""
+	c.spans.stateCtx, c.spans.stateSpan = nil, nil
+}
+
+// getTraceContext returns a context containing the span of the current queue state
+func (c *controller) getTraceContext() context.Context {
+	c.spans.mu.Lock()
+	defer c.spans.mu.Unlock()
+
+	if c.spans.stateCtx == nil {
+		return context.TODO()
+	}
+
+	return c.spans.stateCtx
+}
+
+// createRelease creates the release of the component with a span of the deploy engine action
+func (c *controller) createRelease(
+	deployEngine internal.DeployEngine,
+	refName string,
+	comp *s2hv1.Component,
+	parentComp *s2hv1.Component,
+	values map[string]interface{},
+	deployTimeout *time.Duration,
+) error {
+	_, span := tracing.StartSpan(c.getTraceContext(), fmt.Sprintf("%s.create", deployEngine.GetName()),
+		attribute.String("release", refName),
+		attribute.String("chart", parentComp.Chart.Name),
+		attribute.String("chartVersion", parentComp.Chart.Version))
+
+	err := deployEngine.Create(refName, comp, parentComp, values, deployTimeout)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+// rollbackRelease rollbacks the release with a span of the deploy engine action
+func (c *controller) rollbackRelease(deployEngine internal.DeployEngine, refName string, revision int) error {
+	_, span := tracing.StartSpan(c.getTraceContext(), fmt.Sprintf("%s.rollback", deployEngine.GetName()),
+		attribute.String("release", refName),
+		attribute.Int("revision", revision))
+
+	err := deployEngine.Rollback(refName, revision)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+// deleteRelease deletes the release with a span of the deploy engine action
+func (c *controller) deleteRelease(deployEngine internal.DeployEngine, refName string) error {
+	_, span := tracing.StartSpan(c.getTraceContext(), fmt.Sprintf("%s.delete", deployEngine.GetName()),
+		attribute.String("release", refName))
+
+	err := deployEngine.Delete(refName)
+	tracing.EndSpan(span, err)
+
+	return err
+}
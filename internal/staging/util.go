@@ -170,27 +170,27 @@ func (c *controller) deleteQueue(q *s2hv1.Queue) error {
 	} else {
 		// Testing or deploying failed
 		// Retry this component
-		q.Spec.NoOfRetry++
-
-		maxNoOfRetry := 0
-
 		cfg, err := c.getConfiguration()
 		if err != nil {
 			return err
 		}
 
-		if cfg != nil && cfg.Staging != nil {
-			maxNoOfRetry = cfg.Staging.MaxRetry
+		var stagingConfig *s2hv1.ConfigStaging
+		if cfg != nil {
+			stagingConfig = cfg.Staging
 		}
 
-		if q.Spec.NoOfRetry > maxNoOfRetry {
+		canRetry, backoff := canRetryQueue(q, stagingConfig)
+		increaseQueueRetry(q)
+
+		if !canRetry {
 			// Retry reached maximum retry limit, we need to verify that is our system still ok?
 			if err := c.queueCtrl.SetReverifyQueueAtFirst(q); err != nil {
 				logger.Error(err, "cannot set reverify queue")
 				return err
 			}
 		} else {
-			if err := c.queueCtrl.SetRetryQueue(q, q.Spec.NoOfRetry, time.Now(),
+			if err := c.queueCtrl.SetRetryQueue(q, q.Spec.NoOfRetry, time.Now().Add(backoff),
 				nil, nil, nil); err != nil {
 				logger.Error(err, "cannot set retry queue")
 				return err
@@ -206,7 +206,7 @@ func (c *controller) deleteQueue(q *s2hv1.Queue) error {
 func (c *controller) updateQueueWithState(q *s2hv1.Queue, state s2hv1.QueueState) error {
 	headers := make(http.Header)
 	headers.Set(internal.SamsahaiAuthHeader, c.authToken)
-	ctx := context.TODO()
+	ctx := c.getTraceContext()
 	ctx, err := twirp.WithHTTPRequestHeaders(ctx, headers)
 	if err != nil {
 		return errors.Wrap(err, "cannot set request header")
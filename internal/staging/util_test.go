@@ -128,3 +128,11 @@ func (m mockGitlab) GetMRSourceBranch(repository, MRiid string) (string, error)
 	m.G.Expect(MRiid).To(Equal(m.ExpectedPRNumber))
 	return m.Branch, m.Error
 }
+
+func (m mockGitlab) GetMRState(repository, MRiid string) (gitlab.MRState, error) {
+	panic("expect not to invoke GetMRState")
+}
+
+func (m mockGitlab) CreateMRNote(repository, MRiid, body string) error {
+	panic("expect not to invoke CreateMRNote")
+}
@@ -0,0 +1,224 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/http"
+)
+
+var logger = s2hlog.S2HLog.WithName("Bitbucket-util")
+
+const requestTimeout = 5 * time.Second
+
+const buildStatusAPI = "%s/rest/build-status/1.0/commits/%s"                   // base url, commit SHA
+const pullRequestAPI = "%s/rest/api/1.0/projects/%s/repos/%s/pull-requests/%s" // base url, project, repo, pr id
+
+// BuildState represents a state of build status
+type BuildState string
+
+const (
+	// BuildStateSuccessful represents a success of build status
+	BuildStateSuccessful BuildState = "SUCCESSFUL"
+	// BuildStateFailed represents a failure of build status
+	BuildStateFailed BuildState = "FAILED"
+	// BuildStateInProgress represents a pending of build status
+	BuildStateInProgress BuildState = "INPROGRESS"
+)
+
+// PullRequestState represents a state of pull request
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen represents an open pull request
+	PullRequestStateOpen PullRequestState = "OPEN"
+	// PullRequestStateDeclined represents a pull request which has been declined
+	PullRequestStateDeclined PullRequestState = "DECLINED"
+	// PullRequestStateMerged represents a pull request which has been merged
+	PullRequestStateMerged PullRequestState = "MERGED"
+)
+
+// Bitbucket is the interface of Bitbucket Server using Bitbucket Server REST API
+type Bitbucket interface {
+	// PublishBuildStatus publishes a build status for a given SHA
+	PublishBuildStatus(commitSHA, key, targetURL, description string, state BuildState) error
+	// GetPullRequestState returns a state of the pull request,
+	// repository is in the format "<project key>/<repository slug>"
+	GetPullRequestState(repository, prID string) (PullRequestState, error)
+}
+
+var _ Bitbucket = &Client{}
+
+// Client manages client side of Bitbucket Server REST API
+type Client struct {
+	baseURL string // e.g., https://bitbucket.example.com
+	token   string
+}
+
+// NewClient creates a new client of Bitbucket Server
+func NewClient(baseURL, token string) *Client {
+	client := &Client{
+		baseURL: baseURL,
+		token:   token,
+	}
+
+	return client
+}
+
+type bodyReq struct {
+	State       string `json:"state"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+// based on the json returned by getting a pull request
+// ref: https://docs.atlassian.com/bitbucket-server/rest/latest/bitbucket-rest.html
+type bitbucketPR struct {
+	ID    int    `json:"id"`
+	State string `json:"state"`
+}
+
+// PublishBuildStatus publishes a build status for a given SHA
+func (c *Client) PublishBuildStatus(commitSHA, key, targetURL, description string, state BuildState) error {
+	logger.Debug("publishing a build status",
+		"commitSHA", commitSHA, "key", key, "state", state)
+
+	buildStatusAPI := fmt.Sprintf(buildStatusAPI, c.baseURL, url.PathEscape(commitSHA))
+
+	resCh := make(chan []byte, 1)
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		reqJSON := bodyReq{
+			State:       string(state),
+			Key:         key,
+			Name:        key,
+			URL:         targetURL,
+			Description: description,
+		}
+
+		reqBody, err := json.Marshal(reqJSON)
+		if err != nil {
+			logger.Error(err, "cannot marshal request data", "data", reqBody)
+			errCh <- err
+			return
+		}
+
+		_, res, err := postRequest(buildStatusAPI, reqBody, c.getRequestOptions(ctx)...)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		resCh <- res
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("publishing build status to bitbucket commitSHA: %s took longer than %v",
+				commitSHA, requestTimeout))
+		return s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		logger.Error(err, "cannot publish build status",
+			"commitSHA", commitSHA, "key", key, "state", state)
+		return err
+	case <-resCh:
+		logger.Info("build status successfully published to bitbucket",
+			"commitSHA", commitSHA, "key", key, "state", state)
+		return nil
+	}
+}
+
+// GetPullRequestState returns a state of the pull request
+func (c *Client) GetPullRequestState(repository, prID string) (PullRequestState, error) {
+	logger.Debug("getting bitbucket pull request state",
+		"repository", repository, "prID", prID)
+
+	project, repo, err := splitRepository(repository)
+	if err != nil {
+		return "", err
+	}
+
+	pullRequestAPI := fmt.Sprintf(pullRequestAPI, c.baseURL, url.PathEscape(project), url.PathEscape(repo),
+		url.PathEscape(prID))
+
+	resCh := make(chan []byte, 1)
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		_, res, err := getRequest(pullRequestAPI, c.getRequestOptions(ctx)...)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		resCh <- res
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("get pull request from bitbucket repository: %s, prID: %s took longer than %v",
+				repository, prID, requestTimeout))
+		return "", s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		logger.Error(err, "cannot get pull request state",
+			"repository", repository, "prID", prID)
+		return "", err
+	case res := <-resCh:
+		var pr bitbucketPR
+		if err := json.Unmarshal(res, &pr); err != nil {
+			logger.Error(err, "cannot unmarshal pull request data", "data", string(res))
+			return "", err
+		}
+
+		return PullRequestState(pr.State), nil
+	}
+}
+
+func (c *Client) getRequestOptions(ctx context.Context) []http.Option {
+	return []http.Option{
+		http.WithTimeout(requestTimeout),
+		http.WithContext(ctx),
+		http.WithHeader("Authorization", fmt.Sprintf("Bearer %s", c.token)),
+	}
+}
+
+func splitRepository(repository string) (string, string, error) {
+	parts := strings.Split(repository, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", s2herrors.New(fmt.Sprintf("invalid bitbucket repository %q, "+
+			"expected <project key>/<repository slug>", repository))
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func getRequest(reqURL string, opts ...http.Option) (int, []byte, error) {
+	respCode, res, err := http.Get(reqURL, opts...)
+	if err != nil {
+		return respCode, []byte{}, err
+	}
+
+	return respCode, res, nil
+}
+
+func postRequest(reqURL string, body []byte, opts ...http.Option) (int, []byte, error) {
+	respCode, res, err := http.Post(reqURL, body, opts...)
+	if err != nil {
+		return respCode, []byte{}, err
+	}
+
+	return respCode, res, nil
+}
@@ -0,0 +1,94 @@
+package bitbucket_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/agoda-com/samsahai/internal/util/bitbucket"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestBitbucket(t *testing.T) {
+	unittest.InitGinkgo(t, "Bitbucket Util")
+}
+
+var _ = Describe("Bitbucket Server REST API", func() {
+	g := NewWithT(GinkgoT())
+
+	var server *httptest.Server
+
+	const (
+		token     = "sometoken"
+		commitSHA = "3bb4cd1d909cdfa804de5bde2defa144b066d36c"
+	)
+
+	Describe("PublishBuildStatus", func() {
+		It("should successfully publish build status for a given SHA", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.URL.Path).To(Equal("/rest/build-status/1.0/commits/" + commitSHA))
+				g.Expect(r.Header.Get("Authorization")).To(Equal("Bearer " + token))
+
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).NotTo(HaveOccurred())
+
+				status := map[string]string{}
+				g.Expect(json.Unmarshal(body, &status)).To(Succeed())
+				g.Expect(status["state"]).To(Equal("SUCCESSFUL"))
+				g.Expect(status["key"]).To(Equal("test"))
+				g.Expect(status["url"]).To(Equal("url"))
+
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client := bitbucket.NewClient(server.URL, token)
+			err := client.PublishBuildStatus(commitSHA, "test", "url", "description", bitbucket.BuildStateSuccessful)
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+
+		Specify("Bad request response", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(400)
+			}))
+			defer server.Close()
+
+			client := bitbucket.NewClient(server.URL, token)
+			err := client.PublishBuildStatus("", "", "", "", "")
+			g.Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetPullRequestState", func() {
+		It("should successfully query pull request state", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.URL.Path).To(Equal("/rest/api/1.0/projects/S2H/repos/samsahai/pull-requests/15"))
+
+				_, err := w.Write([]byte(`{"id": 15, "state": "DECLINED"}`))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+			defer server.Close()
+
+			client := bitbucket.NewClient(server.URL, token)
+			state, err := client.GetPullRequestState("S2H/samsahai", "15")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(state).To(Equal(bitbucket.PullRequestStateDeclined))
+		})
+
+		Specify("Invalid repository", func() {
+			client := bitbucket.NewClient("", token)
+			_, err := client.GetPullRequestState("samsahai", "15")
+			g.Expect(err).NotTo(BeNil())
+		})
+	})
+})
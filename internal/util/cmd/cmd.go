@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
 
 	"github.com/pkg/errors"
@@ -18,7 +19,20 @@ func ExecuteCommand(ctx context.Context, exePath string, cmdObj *s2hv1.CommandAn
 		return []byte{}, err
 	}
 
-	return execute(ctx, exePath, command, args...)
+	return execute(ctx, exePath, command, nil, args...)
+}
+
+// ExecuteCommandWithEnv executes command at defined executed path with additional environment variables,
+// env is a list of `key=value`
+func ExecuteCommandWithEnv(ctx context.Context, exePath string, cmdObj *s2hv1.CommandAndArgs, env []string) (
+	[]byte, error) {
+
+	command, args, err := parseCommand(cmdObj)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return execute(ctx, exePath, command, env, args...)
 }
 
 func RenderTemplate(commands, args []string, obj interface{}) *s2hv1.CommandAndArgs {
@@ -53,9 +67,12 @@ func parseCommand(cmdObj *s2hv1.CommandAndArgs) (command string, args []string,
 	return
 }
 
-func execute(ctx context.Context, exePath, command string, args ...string) ([]byte, error) {
+func execute(ctx context.Context, exePath, command string, env []string, args ...string) ([]byte, error) {
 	cmd := exec.CommandContext(ctx, command, args...)
 	cmd.Dir = exePath
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
 	out, err := cmd.Output()
 	if err != nil {
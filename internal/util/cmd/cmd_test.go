@@ -75,6 +75,20 @@ var _ = Describe("shell command", func() {
 			g.Expect(string(out)).To(Equal("hello\nworld"))
 		})
 
+		It("should execute command with environment variables correctly", func() {
+			cmdObj := &s2hv1.CommandAndArgs{
+				Command: []string{"/bin/sh", "-c"},
+				Args:    []string{"/bin/echo -n $S2H_TEST_ENV"},
+			}
+
+			pwd, err := os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := ExecuteCommandWithEnv(context.TODO(), pwd, cmdObj, []string{"S2H_TEST_ENV=hello"})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(out)).To(Equal("hello"))
+		})
+
 		It("should execute command from file correctly", func() {
 			cmdObj := &s2hv1.CommandAndArgs{
 				Command: []string{"/bin/sh", "./testdata/test.sh"},
@@ -0,0 +1,210 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/http"
+)
+
+var logger = s2hlog.S2HLog.WithName("Gitea-util")
+
+const requestTimeout = 5 * time.Second
+
+const commitStatusAPI = "%s/api/v1/repos/%s/statuses/%s" // base url, repository, commit SHA
+const pullRequestAPI = "%s/api/v1/repos/%s/pulls/%s"     // base url, repository, pull request index
+
+// CommitStatus represents a commit status
+type CommitStatus string
+
+const (
+	// CommitStatusSuccess represents a success of commit status
+	CommitStatusSuccess CommitStatus = "success"
+	// CommitStatusFailure represents a failure of commit status
+	CommitStatusFailure CommitStatus = "failure"
+	// CommitStatusPending represents a pending of commit status
+	CommitStatusPending CommitStatus = "pending"
+)
+
+// PullRequestState represents a state of pull request
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen represents an open pull request
+	PullRequestStateOpen PullRequestState = "open"
+	// PullRequestStateClosed represents a pull request which has been closed without merging
+	PullRequestStateClosed PullRequestState = "closed"
+	// PullRequestStateMerged represents a pull request which has been merged
+	PullRequestStateMerged PullRequestState = "merged"
+)
+
+// Gitea is the interface of Gitea using Gitea REST API
+type Gitea interface {
+	// PublishCommitStatus publishes a commit status for a given SHA
+	PublishCommitStatus(repository, commitSHA, labelName, targetURL, description string, status CommitStatus) error
+	// GetPullRequestState returns a state of the pull request
+	GetPullRequestState(repository, prNumber string) (PullRequestState, error)
+}
+
+var _ Gitea = &Client{}
+
+// Client manages client side of Gitea REST API
+type Client struct {
+	baseURL string // e.g., https://gitea.com
+	token   string
+}
+
+// NewClient creates a new client of Gitea
+func NewClient(baseURL, token string) *Client {
+	client := &Client{
+		baseURL: baseURL,
+		token:   token,
+	}
+
+	return client
+}
+
+type bodyReq struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+// based on the json returned by getting a pull request
+// ref: https://try.gitea.io/api/swagger#/repository/repoGetPullRequest
+type giteaPR struct {
+	Number int    `json:"number"`
+	State  string `json:"state"`
+	Merged bool   `json:"merged"`
+}
+
+// PublishCommitStatus publishes a commit status for a given SHA
+func (c *Client) PublishCommitStatus(repository, commitSHA, labelName, targetURL, description string,
+	status CommitStatus) error {
+
+	logger.Debug("committing a status check",
+		"repository", repository, "commitSHA", commitSHA, "status", status)
+
+	commitStatusAPI := fmt.Sprintf(commitStatusAPI, c.baseURL, repository, url.PathEscape(commitSHA))
+
+	resCh := make(chan []byte, 1)
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		reqJSON := bodyReq{
+			State:       string(status),
+			TargetURL:   targetURL,
+			Description: description,
+			Context:     labelName,
+		}
+
+		reqBody, err := json.Marshal(reqJSON)
+		if err != nil {
+			logger.Error(err, "cannot marshal request data", "data", reqBody)
+			errCh <- err
+			return
+		}
+
+		_, res, err := postRequest(commitStatusAPI, reqBody, c.getRequestOptions(ctx)...)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		resCh <- res
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("publishing commit status to gitea repository: %s, commitSHA: %s took longer than %v",
+				repository, commitSHA, requestTimeout))
+		return s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		logger.Error(err, "cannot publish commit status",
+			"repository", repository, "commitSHA", commitSHA, "status", status)
+		return err
+	case <-resCh:
+		logger.Info("commit status successfully published to gitea",
+			"repository", repository, "commitSHA", commitSHA, "status", status)
+		return nil
+	}
+}
+
+// GetPullRequestState returns a state of the pull request
+func (c *Client) GetPullRequestState(repository, prNumber string) (PullRequestState, error) {
+	logger.Debug("getting gitea pull request state",
+		"repository", repository, "prNumber", prNumber)
+
+	pullRequestAPI := fmt.Sprintf(pullRequestAPI, c.baseURL, repository, url.PathEscape(prNumber))
+
+	resCh := make(chan []byte, 1)
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		_, res, err := getRequest(pullRequestAPI, c.getRequestOptions(ctx)...)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		resCh <- res
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("get pull request from gitea repository: %s, prNumber: %s took longer than %v",
+				repository, prNumber, requestTimeout))
+		return "", s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		logger.Error(err, "cannot get pull request state",
+			"repository", repository, "prNumber", prNumber)
+		return "", err
+	case res := <-resCh:
+		var pr giteaPR
+		if err := json.Unmarshal(res, &pr); err != nil {
+			logger.Error(err, "cannot unmarshal pull request data", "data", string(res))
+			return "", err
+		}
+
+		if pr.Merged {
+			return PullRequestStateMerged, nil
+		}
+		return PullRequestState(pr.State), nil
+	}
+}
+
+func (c *Client) getRequestOptions(ctx context.Context) []http.Option {
+	return []http.Option{
+		http.WithTimeout(requestTimeout),
+		http.WithContext(ctx),
+		http.WithHeader("Authorization", fmt.Sprintf("token %s", c.token)),
+	}
+}
+
+func getRequest(reqURL string, opts ...http.Option) (int, []byte, error) {
+	respCode, res, err := http.Get(reqURL, opts...)
+	if err != nil {
+		return respCode, []byte{}, err
+	}
+
+	return respCode, res, nil
+}
+
+func postRequest(reqURL string, body []byte, opts ...http.Option) (int, []byte, error) {
+	respCode, res, err := http.Post(reqURL, body, opts...)
+	if err != nil {
+		return respCode, []byte{}, err
+	}
+
+	return respCode, res, nil
+}
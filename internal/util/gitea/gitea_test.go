@@ -0,0 +1,104 @@
+package gitea_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/agoda-com/samsahai/internal/util/gitea"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestGitea(t *testing.T) {
+	unittest.InitGinkgo(t, "Gitea Util")
+}
+
+var _ = Describe("Gitea REST API", func() {
+	g := NewWithT(GinkgoT())
+
+	var server *httptest.Server
+
+	const (
+		token      = "sometoken"
+		repository = "agoda-com/samsahai"
+		commitSHA  = "3bb4cd1d909cdfa804de5bde2defa144b066d36c"
+	)
+
+	Describe("PublishCommitStatus", func() {
+		It("should successfully publish commit status for a given SHA", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.URL.Path).To(Equal("/api/v1/repos/agoda-com/samsahai/statuses/" + commitSHA))
+				g.Expect(r.Header.Get("Authorization")).To(Equal("token " + token))
+
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).NotTo(HaveOccurred())
+
+				status := map[string]string{}
+				g.Expect(json.Unmarshal(body, &status)).To(Succeed())
+				g.Expect(status["state"]).To(Equal("pending"))
+				g.Expect(status["context"]).To(Equal("test"))
+
+				w.WriteHeader(http.StatusCreated)
+				_, err = w.Write([]byte(`{"id": 1, "status": "pending", "context": "test"}`))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+			defer server.Close()
+
+			client := gitea.NewClient(server.URL, token)
+			err := client.PublishCommitStatus(repository, commitSHA, "test", "url", "description",
+				gitea.CommitStatusPending)
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+
+		Specify("Bad request response", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(400)
+			}))
+			defer server.Close()
+
+			client := gitea.NewClient(server.URL, token)
+			err := client.PublishCommitStatus("", "", "", "", "", "")
+			g.Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetPullRequestState", func() {
+		It("should successfully query pull request state", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.URL.Path).To(Equal("/api/v1/repos/agoda-com/samsahai/pulls/15"))
+
+				_, err := w.Write([]byte(`{"number": 15, "state": "closed", "merged": true}`))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+			defer server.Close()
+
+			client := gitea.NewClient(server.URL, token)
+			state, err := client.GetPullRequestState(repository, "15")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(state).To(Equal(gitea.PullRequestStateMerged))
+		})
+
+		Specify("Not found response", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(404)
+			}))
+			defer server.Close()
+
+			client := gitea.NewClient(server.URL, token)
+			state, err := client.GetPullRequestState(repository, "15")
+			g.Expect(err).NotTo(BeNil())
+			g.Expect(state).To(BeEmpty())
+		})
+	})
+})
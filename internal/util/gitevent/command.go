@@ -0,0 +1,121 @@
+package gitevent
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CommandPrefix is a prefix of slash commands in pull request comments
+const CommandPrefix = "/s2h"
+
+// CommandName represents a slash command which controls the pull request environment
+type CommandName string
+
+const (
+	// CommandRedeploy redeploys the latest commit of the pull request
+	CommandRedeploy CommandName = "redeploy"
+	// CommandRetest re-runs the testing on the deployed pull request environment
+	CommandRetest CommandName = "retest"
+	// CommandExtend postpones the destroyed time of the pull request environment, e.g. `/s2h extend 4h`
+	CommandExtend CommandName = "extend"
+	// CommandDestroy destroys the pull request environment
+	CommandDestroy CommandName = "destroy"
+	// CommandUse redeploys the pull request with overridden component versions, e.g. `/s2h use svc-a=1.2.3`
+	CommandUse CommandName = "use"
+)
+
+// CommandUsage describes available slash commands
+const CommandUsage = "available commands: `/s2h redeploy`, `/s2h retest`, `/s2h extend <duration>`, " +
+	"`/s2h destroy`, `/s2h use <component>=<version> ...`"
+
+// Command represents a slash command in the pull request comment
+type Command struct {
+	Name CommandName
+	// Duration is only available on CommandExtend
+	Duration time.Duration
+	// Components maps component names to versions, only available on CommandUse
+	Components map[string]string
+}
+
+// IsRestricted returns true if the command is allowed only for collaborators of the repository
+// and allowed users
+func (n CommandName) IsRestricted() bool {
+	switch n {
+	case CommandDestroy, CommandUse, CommandExtend:
+		return true
+	}
+
+	return false
+}
+
+// HasCommand returns true if the comment contains a slash command
+func HasCommand(comment string) bool {
+	return findCommandLine(comment) != ""
+}
+
+// ParseCommand parses the first slash command in the comment,
+// nil is returned if there is no slash command
+func ParseCommand(comment string) (*Command, error) {
+	line := findCommandLine(comment)
+	if line == "" {
+		return nil, nil
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return nil, errors.New("missing command")
+	}
+
+	cmd := &Command{Name: CommandName(strings.ToLower(fields[1]))}
+	args := fields[2:]
+	switch cmd.Name {
+	case CommandRedeploy, CommandRetest, CommandDestroy:
+		if len(args) > 0 {
+			return nil, errors.Errorf("`%s` does not accept any arguments", cmd.Name)
+		}
+
+	case CommandExtend:
+		if len(args) != 1 {
+			return nil, errors.Errorf("`%s` requires a duration, e.g. `%s %s 4h`", cmd.Name, CommandPrefix, cmd.Name)
+		}
+
+		d, err := time.ParseDuration(args[0])
+		if err != nil || d <= 0 {
+			return nil, errors.Errorf("invalid duration %q", args[0])
+		}
+		cmd.Duration = d
+
+	case CommandUse:
+		if len(args) == 0 {
+			return nil, errors.Errorf("`%s` requires component versions, e.g. `%s %s svc-a=1.2.3`",
+				cmd.Name, CommandPrefix, cmd.Name)
+		}
+
+		cmd.Components = make(map[string]string)
+		for _, arg := range args {
+			kv := strings.SplitN(arg, "=", 2)
+			if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+				return nil, errors.Errorf("invalid component version %q, expected `<component>=<version>`", arg)
+			}
+			cmd.Components[kv[0]] = kv[1]
+		}
+
+	default:
+		return nil, errors.Errorf("unknown command %q", fields[1])
+	}
+
+	return cmd, nil
+}
+
+func findCommandLine(comment string) string {
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		if line == CommandPrefix || strings.HasPrefix(line, CommandPrefix+" ") {
+			return line
+		}
+	}
+
+	return ""
+}
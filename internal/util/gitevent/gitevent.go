@@ -0,0 +1,334 @@
+package gitevent
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// GithubEventHeader is a header of github webhooks which contains the event type
+	GithubEventHeader = "X-GitHub-Event"
+	// GitlabEventHeader is a header of gitlab webhooks which contains the event type
+	GitlabEventHeader = "X-Gitlab-Event"
+	// BitbucketEventHeader is a header of bitbucket server webhooks which contains the event type
+	BitbucketEventHeader = "X-Event-Key"
+	// GiteaEventHeader is a header of gitea webhooks which contains the event type
+	GiteaEventHeader = "X-Gitea-Event"
+
+	githubPullRequestEvent  = "pull_request"
+	githubIssueCommentEvent = "issue_comment"
+	gitlabMergeRequestEvent = "Merge Request Hook"
+	gitlabNoteEvent         = "Note Hook"
+	giteaPullRequestEvent   = "pull_request"
+)
+
+// Action represents what should be done to the pull request environment
+type Action string
+
+const (
+	// ActionSync means the pull request has been opened or changed, the latest commit should be deployed
+	ActionSync Action = "sync"
+	// ActionClose means the pull request has been closed or merged, the environment should be destroyed
+	ActionClose Action = "close"
+	// ActionComment means the pull request has been commented with a slash command
+	ActionComment Action = "comment"
+)
+
+// PullRequestEvent represents a pull request event from git providers
+type PullRequestEvent struct {
+	// Repository is a full name of the repository "<owner>/<repository>",
+	// "<project key>/<repository slug>" on bitbucket server
+	Repository string
+	// ProjectID is a project id of the repository, only available on gitlab
+	ProjectID string
+	PRNumber  string
+	CommitSHA string
+	Action    Action
+	// Comment is a body of the comment, only available on ActionComment
+	Comment string
+	// Author is a user name who commented, only available on ActionComment
+	Author string
+	// IsCollaborator is true if the author is an owner, member or collaborator of the repository,
+	// only available on ActionComment of github
+	IsCollaborator bool
+}
+
+type githubPullRequest struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Head struct {
+			SHA string `json:"sha"`
+		} `json:"head"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+type githubIssueComment struct {
+	Action string `json:"action"`
+	Issue  struct {
+		Number      int              `json:"number"`
+		PullRequest *json.RawMessage `json:"pull_request"`
+	} `json:"issue"`
+	Comment struct {
+		Body string `json:"body"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		AuthorAssociation string `json:"author_association"`
+	} `json:"comment"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseGithub parses github webhook payload to pull request event,
+// nil is returned if the event is not related to pull request deployment
+func ParseGithub(eventType string, data []byte) (*PullRequestEvent, error) {
+	if eventType == githubIssueCommentEvent {
+		return parseGithubComment(data)
+	}
+
+	if eventType != githubPullRequestEvent {
+		return nil, nil
+	}
+
+	payload := githubPullRequest{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.Wrap(err, "cannot parse github pull request event")
+	}
+
+	var action Action
+	switch payload.Action {
+	case "opened", "synchronize", "reopened", "labeled":
+		action = ActionSync
+	case "closed":
+		action = ActionClose
+	default:
+		return nil, nil
+	}
+
+	return &PullRequestEvent{
+		Repository: payload.Repository.FullName,
+		PRNumber:   strconv.Itoa(payload.Number),
+		CommitSHA:  payload.PullRequest.Head.SHA,
+		Action:     action,
+	}, nil
+}
+
+// parseGithubComment parses github issue comment payload,
+// only new comments of pull requests which contain a slash command are returned
+func parseGithubComment(data []byte) (*PullRequestEvent, error) {
+	payload := githubIssueComment{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.Wrap(err, "cannot parse github issue comment event")
+	}
+
+	if payload.Action != "created" || payload.Issue.PullRequest == nil || !HasCommand(payload.Comment.Body) {
+		return nil, nil
+	}
+
+	return &PullRequestEvent{
+		Repository: payload.Repository.FullName,
+		PRNumber:   strconv.Itoa(payload.Issue.Number),
+		Action:     ActionComment,
+		Comment:    payload.Comment.Body,
+		Author:     payload.Comment.User.Login,
+
+		IsCollaborator: isGithubCollaborator(payload.Comment.AuthorAssociation),
+	}, nil
+}
+
+// isGithubCollaborator returns true if the author association grants access to the repository
+func isGithubCollaborator(association string) bool {
+	switch association {
+	case "OWNER", "MEMBER", "COLLABORATOR":
+		return true
+	}
+
+	return false
+}
+
+type gitlabMergeRequest struct {
+	Project struct {
+		ID                int    `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID        int    `json:"iid"`
+		Action     string `json:"action"`
+		OldRev     string `json:"oldrev"`
+		LastCommit struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Labels *json.RawMessage `json:"labels"`
+	} `json:"changes"`
+}
+
+type gitlabNote struct {
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		ID                int    `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Note         string `json:"note"`
+		NoteableType string `json:"noteable_type"`
+	} `json:"object_attributes"`
+	MergeRequest struct {
+		IID        int `json:"iid"`
+		LastCommit struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"merge_request"`
+}
+
+// ParseGitlab parses gitlab webhook payload to pull request event,
+// nil is returned if the event is not related to pull request deployment
+func ParseGitlab(eventType string, data []byte) (*PullRequestEvent, error) {
+	if eventType == gitlabNoteEvent {
+		return parseGitlabNote(data)
+	}
+
+	if eventType != gitlabMergeRequestEvent {
+		return nil, nil
+	}
+
+	payload := gitlabMergeRequest{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.Wrap(err, "cannot parse gitlab merge request event")
+	}
+
+	attrs := payload.ObjectAttributes
+	var action Action
+	switch attrs.Action {
+	case "open", "reopen":
+		action = ActionSync
+	case "update":
+		// only new commits or changed labels are deployed
+		if attrs.OldRev == "" && payload.Changes.Labels == nil {
+			return nil, nil
+		}
+		action = ActionSync
+	case "close", "merge":
+		action = ActionClose
+	default:
+		return nil, nil
+	}
+
+	return &PullRequestEvent{
+		Repository: payload.Project.PathWithNamespace,
+		ProjectID:  strconv.Itoa(payload.Project.ID),
+		PRNumber:   strconv.Itoa(attrs.IID),
+		CommitSHA:  attrs.LastCommit.ID,
+		Action:     action,
+	}, nil
+}
+
+// parseGitlabNote parses gitlab note payload,
+// only comments of merge requests which contain a slash command are returned
+func parseGitlabNote(data []byte) (*PullRequestEvent, error) {
+	payload := gitlabNote{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.Wrap(err, "cannot parse gitlab note event")
+	}
+
+	attrs := payload.ObjectAttributes
+	if attrs.NoteableType != "MergeRequest" || !HasCommand(attrs.Note) {
+		return nil, nil
+	}
+
+	return &PullRequestEvent{
+		Repository: payload.Project.PathWithNamespace,
+		ProjectID:  strconv.Itoa(payload.Project.ID),
+		PRNumber:   strconv.Itoa(payload.MergeRequest.IID),
+		CommitSHA:  payload.MergeRequest.LastCommit.ID,
+		Action:     ActionComment,
+		Comment:    attrs.Note,
+		Author:     payload.User.Username,
+	}, nil
+}
+
+type bitbucketPullRequest struct {
+	PullRequest struct {
+		ID      int `json:"id"`
+		FromRef struct {
+			LatestCommit string `json:"latestCommit"`
+		} `json:"fromRef"`
+		ToRef struct {
+			Repository struct {
+				Slug    string `json:"slug"`
+				Project struct {
+					Key string `json:"key"`
+				} `json:"project"`
+			} `json:"repository"`
+		} `json:"toRef"`
+	} `json:"pullRequest"`
+}
+
+// ParseBitbucket parses bitbucket server webhook payload to pull request event,
+// nil is returned if the event is not related to pull request deployment
+func ParseBitbucket(eventType string, data []byte) (*PullRequestEvent, error) {
+	var action Action
+	switch eventType {
+	case "pr:opened", "pr:from_ref_updated":
+		action = ActionSync
+	case "pr:merged", "pr:declined", "pr:deleted":
+		action = ActionClose
+	default:
+		return nil, nil
+	}
+
+	payload := bitbucketPullRequest{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.Wrap(err, "cannot parse bitbucket pull request event")
+	}
+
+	pr := payload.PullRequest
+	repo := pr.ToRef.Repository
+	return &PullRequestEvent{
+		Repository: repo.Project.Key + "/" + repo.Slug,
+		PRNumber:   strconv.Itoa(pr.ID),
+		CommitSHA:  pr.FromRef.LatestCommit,
+		Action:     action,
+	}, nil
+}
+
+// ParseGitea parses gitea webhook payload to pull request event,
+// nil is returned if the event is not related to pull request deployment
+func ParseGitea(eventType string, data []byte) (*PullRequestEvent, error) {
+	if eventType != giteaPullRequestEvent {
+		return nil, nil
+	}
+
+	// gitea payload is compatible with github
+	payload := githubPullRequest{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, errors.Wrap(err, "cannot parse gitea pull request event")
+	}
+
+	var action Action
+	switch payload.Action {
+	case "opened", "reopened", "synchronized", "label_updated":
+		action = ActionSync
+	case "closed":
+		action = ActionClose
+	default:
+		return nil, nil
+	}
+
+	return &PullRequestEvent{
+		Repository: payload.Repository.FullName,
+		PRNumber:   strconv.Itoa(payload.Number),
+		CommitSHA:  payload.PullRequest.Head.SHA,
+		Action:     action,
+	}, nil
+}
@@ -0,0 +1,266 @@
+package gitevent_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/agoda-com/samsahai/internal/util/gitevent"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestUnit(t *testing.T) {
+	unittest.InitGinkgo(t, "Git event utils")
+}
+
+var _ = Describe("parse git events", func() {
+	g := NewGomegaWithT(GinkgoT())
+
+	It("should correctly parse github pull request event", func() {
+		data := []byte(`{
+  "action": "synchronize",
+  "number": 12,
+  "pull_request": {"head": {"sha": "abc123"}},
+  "repository": {"full_name": "agoda-com/samsahai"}
+}`)
+
+		event, err := gitevent.ParseGithub("pull_request", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(Equal(&gitevent.PullRequestEvent{
+			Repository: "agoda-com/samsahai",
+			PRNumber:   "12",
+			CommitSHA:  "abc123",
+			Action:     gitevent.ActionSync,
+		}))
+
+		event, err = gitevent.ParseGithub("pull_request", []byte(`{"action": "closed", "number": 12}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event.Action).To(Equal(gitevent.ActionClose))
+	})
+
+	It("should ignore unrelated github events", func() {
+		event, err := gitevent.ParseGithub("push", []byte(`{}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+
+		event, err = gitevent.ParseGithub("pull_request", []byte(`{"action": "assigned", "number": 12}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+
+		_, err = gitevent.ParseGithub("pull_request", []byte(`not json`))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should correctly parse gitlab merge request event", func() {
+		data := []byte(`{
+  "object_kind": "merge_request",
+  "project": {"id": 15, "path_with_namespace": "samsahai/samsahai"},
+  "object_attributes": {"iid": 7, "action": "update", "oldrev": "old123", "last_commit": {"id": "new456"}}
+}`)
+
+		event, err := gitevent.ParseGitlab("Merge Request Hook", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(Equal(&gitevent.PullRequestEvent{
+			Repository: "samsahai/samsahai",
+			ProjectID:  "15",
+			PRNumber:   "7",
+			CommitSHA:  "new456",
+			Action:     gitevent.ActionSync,
+		}))
+
+		event, err = gitevent.ParseGitlab("Merge Request Hook",
+			[]byte(`{"object_attributes": {"iid": 7, "action": "update"}, "changes": {"labels": {}}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event.Action).To(Equal(gitevent.ActionSync))
+
+		event, err = gitevent.ParseGitlab("Merge Request Hook",
+			[]byte(`{"object_attributes": {"iid": 7, "action": "merge"}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event.Action).To(Equal(gitevent.ActionClose))
+	})
+
+	It("should ignore unrelated gitlab events", func() {
+		event, err := gitevent.ParseGitlab("Push Hook", []byte(`{}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+
+		event, err = gitevent.ParseGitlab("Merge Request Hook",
+			[]byte(`{"object_attributes": {"iid": 7, "action": "update"}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+	})
+
+	It("should correctly parse bitbucket server pull request event", func() {
+		data := []byte(`{
+  "eventKey": "pr:from_ref_updated",
+  "pullRequest": {
+    "id": 3,
+    "fromRef": {"latestCommit": "abc123"},
+    "toRef": {"repository": {"slug": "samsahai", "project": {"key": "S2H"}}}
+  }
+}`)
+
+		event, err := gitevent.ParseBitbucket("pr:from_ref_updated", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(Equal(&gitevent.PullRequestEvent{
+			Repository: "S2H/samsahai",
+			PRNumber:   "3",
+			CommitSHA:  "abc123",
+			Action:     gitevent.ActionSync,
+		}))
+
+		event, err = gitevent.ParseBitbucket("pr:declined", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event.Action).To(Equal(gitevent.ActionClose))
+
+		event, err = gitevent.ParseBitbucket("diagnostics:ping", []byte(`{}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+	})
+
+	It("should correctly parse gitea pull request event", func() {
+		data := []byte(`{
+  "action": "synchronized",
+  "number": 5,
+  "pull_request": {"head": {"sha": "abc123"}},
+  "repository": {"full_name": "agoda-com/samsahai"}
+}`)
+
+		event, err := gitevent.ParseGitea("pull_request", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(Equal(&gitevent.PullRequestEvent{
+			Repository: "agoda-com/samsahai",
+			PRNumber:   "5",
+			CommitSHA:  "abc123",
+			Action:     gitevent.ActionSync,
+		}))
+
+		event, err = gitevent.ParseGitea("pull_request", []byte(`{"action": "closed", "number": 5}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event.Action).To(Equal(gitevent.ActionClose))
+
+		event, err = gitevent.ParseGitea("push", []byte(`{}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+	})
+
+	It("should correctly parse github pull request comment event", func() {
+		data := []byte(`{
+  "action": "created",
+  "issue": {"number": 12, "pull_request": {}},
+  "comment": {"body": "looks good\n/s2h retest", "user": {"login": "octocat"}, "author_association": "MEMBER"},
+  "repository": {"full_name": "agoda-com/samsahai"}
+}`)
+
+		event, err := gitevent.ParseGithub("issue_comment", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(Equal(&gitevent.PullRequestEvent{
+			Repository: "agoda-com/samsahai",
+			PRNumber:   "12",
+			Action:     gitevent.ActionComment,
+			Comment:    "looks good\n/s2h retest",
+			Author:     "octocat",
+
+			IsCollaborator: true,
+		}))
+
+		By("Author is not a collaborator")
+		event, err = gitevent.ParseGithub("issue_comment", []byte(`{"action": "created",
+  "issue": {"number": 12, "pull_request": {}},
+  "comment": {"body": "/s2h destroy", "user": {"login": "ghost"}, "author_association": "CONTRIBUTOR"}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event.IsCollaborator).To(BeFalse())
+
+		By("Ignoring issue comment")
+		event, err = gitevent.ParseGithub("issue_comment",
+			[]byte(`{"action": "created", "issue": {"number": 12}, "comment": {"body": "/s2h retest"}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+
+		By("Ignoring comment without command")
+		event, err = gitevent.ParseGithub("issue_comment",
+			[]byte(`{"action": "created", "issue": {"number": 12, "pull_request": {}}, "comment": {"body": "/s2hx"}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+	})
+
+	It("should correctly parse gitlab merge request note event", func() {
+		data := []byte(`{
+  "object_kind": "note",
+  "user": {"username": "tanuki"},
+  "project": {"id": 15, "path_with_namespace": "samsahai/samsahai"},
+  "object_attributes": {"note": "/s2h extend 4h", "noteable_type": "MergeRequest"},
+  "merge_request": {"iid": 7, "last_commit": {"id": "new456"}}
+}`)
+
+		event, err := gitevent.ParseGitlab("Note Hook", data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(Equal(&gitevent.PullRequestEvent{
+			Repository: "samsahai/samsahai",
+			ProjectID:  "15",
+			PRNumber:   "7",
+			CommitSHA:  "new456",
+			Action:     gitevent.ActionComment,
+			Comment:    "/s2h extend 4h",
+			Author:     "tanuki",
+		}))
+
+		event, err = gitevent.ParseGitlab("Note Hook",
+			[]byte(`{"object_attributes": {"note": "/s2h retest", "noteable_type": "Issue"}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(event).To(BeNil())
+	})
+})
+
+var _ = Describe("parse slash commands", func() {
+	g := NewGomegaWithT(GinkgoT())
+
+	It("should correctly parse commands", func() {
+		cmd, err := gitevent.ParseCommand("please\n /s2h redeploy ")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(cmd).To(Equal(&gitevent.Command{Name: gitevent.CommandRedeploy}))
+
+		cmd, err = gitevent.ParseCommand("/s2h extend 4h")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(cmd).To(Equal(&gitevent.Command{Name: gitevent.CommandExtend, Duration: 4 * time.Hour}))
+
+		cmd, err = gitevent.ParseCommand("/s2h use svc-a=1.2.3 svc-b=2.0.0")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(cmd).To(Equal(&gitevent.Command{
+			Name:       gitevent.CommandUse,
+			Components: map[string]string{"svc-a": "1.2.3", "svc-b": "2.0.0"},
+		}))
+
+		cmd, err = gitevent.ParseCommand("no command here")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(cmd).To(BeNil())
+	})
+
+	It("should restrict only destructive commands", func() {
+		g.Expect(gitevent.CommandDestroy.IsRestricted()).To(BeTrue())
+		g.Expect(gitevent.CommandUse.IsRestricted()).To(BeTrue())
+		g.Expect(gitevent.CommandExtend.IsRestricted()).To(BeTrue())
+		g.Expect(gitevent.CommandRedeploy.IsRestricted()).To(BeFalse())
+		g.Expect(gitevent.CommandRetest.IsRestricted()).To(BeFalse())
+	})
+
+	It("should fail to parse invalid commands", func() {
+		invalidComments := []string{
+			"/s2h",
+			"/s2h deploy",
+			"/s2h retest now",
+			"/s2h extend",
+			"/s2h extend forever",
+			"/s2h extend -1h",
+			"/s2h use",
+			"/s2h use svc-a",
+			"/s2h use =1.2.3",
+		}
+		for _, comment := range invalidComments {
+			_, err := gitevent.ParseCommand(comment)
+			g.Expect(err).To(HaveOccurred(), comment)
+		}
+	})
+})
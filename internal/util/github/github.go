@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
@@ -15,7 +16,9 @@ var logger = s2hlog.S2HLog.WithName("Github-util")
 
 const requestTimeout = 5 * time.Second
 
-const commitStatusAPI = "%s/api/v3/repos/%s/statuses/%s" // base url, repository, commit SHA
+const commitStatusAPI = "%s/api/v3/repos/%s/statuses/%s"        // base url, repository, commit SHA
+const pullRequestAPI = "%s/api/v3/repos/%s/pulls/%s"            // base url, repository, pull request number
+const issueCommentAPI = "%s/api/v3/repos/%s/issues/%s/comments" // base url, repository, pull request number
 
 // CommitStatus represents a commit status
 type CommitStatus string
@@ -27,10 +30,26 @@ const (
 	CommitStatusFailure CommitStatus = "failure"
 )
 
+// PullRequestState represents a state of pull request
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen represents an open pull request
+	PullRequestStateOpen PullRequestState = "open"
+	// PullRequestStateClosed represents a pull request which has been closed without merging
+	PullRequestStateClosed PullRequestState = "closed"
+	// PullRequestStateMerged represents a pull request which has been merged
+	PullRequestStateMerged PullRequestState = "merged"
+)
+
 // Github is the interface of Github using Github REST API
 type Github interface {
 	// PublishCommitStatus publishes a commit status for a given SHA
 	PublishCommitStatus(repository, commitSHA, labelName, targetURL, description string, status CommitStatus) error
+	// GetPullRequestState returns a state of the pull request
+	GetPullRequestState(repository, prNumber string) (PullRequestState, error)
+	// CreatePullRequestComment creates a comment on the pull request
+	CreatePullRequestComment(repository, prNumber, body string) error
 }
 
 var _ Github = &Client{}
@@ -58,6 +77,14 @@ type bodyReq struct {
 	Context     string `json:"context"`
 }
 
+// based on the json returned by getting a pull request
+// ref: https://docs.github.com/en/rest/pulls/pulls#get-a-pull-request
+type githubPR struct {
+	Number int    `json:"number"`
+	State  string `json:"state"`
+	Merged bool   `json:"merged"`
+}
+
 // PublishCommitStatus publishes a commit status for a given SHA
 func (c *Client) PublishCommitStatus(repository, commitSHA, labelName, targetURL, description string,
 	status CommitStatus) error {
@@ -120,6 +147,114 @@ func (c *Client) PublishCommitStatus(repository, commitSHA, labelName, targetURL
 	}
 }
 
+// GetPullRequestState returns a state of the pull request
+func (c *Client) GetPullRequestState(repository, prNumber string) (PullRequestState, error) {
+	logger.Debug("getting github pull request state",
+		"repository", repository, "prNumber", prNumber)
+
+	pullRequestAPI := fmt.Sprintf(pullRequestAPI, c.baseURL, repository, url.PathEscape(prNumber))
+
+	resCh := make(chan []byte, 1)
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		gitToken := fmt.Sprintf("token %s", c.token)
+
+		opts := []http.Option{
+			http.WithTimeout(requestTimeout),
+			http.WithContext(ctx),
+			http.WithHeader("Authorization", gitToken),
+		}
+
+		_, res, err := getRequest(pullRequestAPI, opts...)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		resCh <- res
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("get pull request from github repository: %s, prNumber: %s took longer than %v",
+				repository, prNumber, requestTimeout))
+		return "", s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		logger.Error(err, "cannot get pull request state",
+			"repository", repository, "prNumber", prNumber)
+		return "", err
+	case res := <-resCh:
+		var pr githubPR
+		if err := json.Unmarshal(res, &pr); err != nil {
+			logger.Error(err, "cannot unmarshal pull request data", "data", string(res))
+			return "", err
+		}
+
+		if pr.Merged {
+			return PullRequestStateMerged, nil
+		}
+		return PullRequestState(pr.State), nil
+	}
+}
+
+// CreatePullRequestComment creates a comment on the pull request
+func (c *Client) CreatePullRequestComment(repository, prNumber, body string) error {
+	logger.Debug("creating github pull request comment",
+		"repository", repository, "prNumber", prNumber)
+
+	issueCommentAPI := fmt.Sprintf(issueCommentAPI, c.baseURL, repository, url.PathEscape(prNumber))
+
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		gitToken := fmt.Sprintf("token %s", c.token)
+
+		opts := []http.Option{
+			http.WithTimeout(requestTimeout),
+			http.WithContext(ctx),
+			http.WithHeader("Authorization", gitToken),
+		}
+
+		reqBody, err := json.Marshal(map[string]string{"body": body})
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		_, _, err = postRequest(issueCommentAPI, reqBody, opts...)
+		errCh <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("creating comment to github repository: %s, prNumber: %s took longer than %v",
+				repository, prNumber, requestTimeout))
+		return s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		if err != nil {
+			logger.Error(err, "cannot create pull request comment",
+				"repository", repository, "prNumber", prNumber)
+			return err
+		}
+
+		return nil
+	}
+}
+
+func getRequest(reqURL string, opts ...http.Option) (int, []byte, error) {
+	respCode, res, err := http.Get(reqURL, opts...)
+	if err != nil {
+		return respCode, []byte{}, err
+	}
+
+	return respCode, res, nil
+}
+
 func postRequest(reqURL string, body []byte, opts ...http.Option) (int, []byte, error) {
 	respCode, res, err := http.Post(reqURL, body, opts...)
 	if err != nil {
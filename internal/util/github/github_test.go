@@ -107,4 +107,75 @@ var _ = Describe("Github REST API", func() {
 			g.Expect(err).NotTo(BeNil())
 		})
 	})
+
+	Describe("GetPullRequestState", func() {
+		const prNumber = "10"
+
+		It("should successfully get open pull request state", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.URL.Path).To(Equal("/api/v3/repos/" + repository + "/pulls/" + prNumber))
+
+				_, err := w.Write([]byte(`{"number": 10, "state": "open", "merged": false}`))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+			defer server.Close()
+
+			githubClient = github.NewClient(server.URL, token)
+			state, err := githubClient.GetPullRequestState(repository, prNumber)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(state).To(Equal(github.PullRequestStateOpen))
+		})
+
+		It("should return merged state for merged pull request", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				_, err := w.Write([]byte(`{"number": 10, "state": "closed", "merged": true}`))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+			defer server.Close()
+
+			githubClient = github.NewClient(server.URL, token)
+			state, err := githubClient.GetPullRequestState(repository, prNumber)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(state).To(Equal(github.PullRequestStateMerged))
+		})
+
+		Specify("Not found response", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(404)
+			}))
+			defer server.Close()
+
+			githubClient = github.NewClient(server.URL, token)
+			state, err := githubClient.GetPullRequestState(repository, prNumber)
+			g.Expect(err).NotTo(BeNil())
+			g.Expect(state).To(BeEmpty())
+		})
+	})
+
+	Describe("CreatePullRequestComment", func() {
+		It("should successfully create pull request comment", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.URL.Path).To(Equal("/api/v3/repos/" + repository + "/issues/10/comments"))
+
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(body).To(MatchJSON(`{"body": "done"}`))
+
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			githubClient = github.NewClient(server.URL, token)
+			err := githubClient.CreatePullRequestComment(repository, "10", "done")
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -16,8 +16,9 @@ var logger = s2hlog.S2HLog.WithName("Gitlab-util")
 
 const requestTimeout = 5 * time.Second
 
-const commitStatusAPI = "%s/api/v4/projects/%s/statuses/%s"            // base url, repository, commit SHA
-const getMRSourceBranchAPI = "%s/api/v4/projects/%s/merge_requests/%s" // base url, repository, iid
+const commitStatusAPI = "%s/api/v4/projects/%s/statuses/%s"       // base url, repository, commit SHA
+const getMRAPI = "%s/api/v4/projects/%s/merge_requests/%s"        // base url, repository, iid
+const mrNoteAPI = "%s/api/v4/projects/%s/merge_requests/%s/notes" // base url, repository, iid
 
 // CommitStatus represents a commit status
 type CommitStatus string
@@ -31,11 +32,29 @@ const (
 	CommitStatusPending CommitStatus = "pending"
 )
 
+// MRState represents a state of merge request
+type MRState string
+
+const (
+	// MRStateOpened represents an opened merge request
+	MRStateOpened MRState = "opened"
+	// MRStateClosed represents a merge request which has been closed without merging
+	MRStateClosed MRState = "closed"
+	// MRStateMerged represents a merge request which has been merged
+	MRStateMerged MRState = "merged"
+	// MRStateLocked represents a merge request which is being merged
+	MRStateLocked MRState = "locked"
+)
+
 // Gitlab is the interface of Gitlab using Gitlab REST API
 type Gitlab interface {
 	// PublishCommitStatus publishes a commit status for a given SHA
 	PublishCommitStatus(repository, commitSHA, labelName, targetURL, description string, status CommitStatus) error
 	GetMRSourceBranch(repository, MRiid string) (string, error)
+	// GetMRState returns a state of the merge request
+	GetMRState(repository, MRiid string) (MRState, error)
+	// CreateMRNote creates a comment on the merge request
+	CreateMRNote(repository, MRiid, body string) error
 }
 
 var _ Gitlab = &Client{}
@@ -70,6 +89,7 @@ type gitlabMR struct {
 	ID           int    `json:"id"`
 	IID          int    `json:"iid"`
 	SourceBranch string `json:"source_branch"`
+	State        string `json:"state"`
 }
 
 // PublishCommitStatus publishes a commit status for a given SHA
@@ -136,14 +156,86 @@ func (c *Client) PublishCommitStatus(repository, commitSHA, labelName, targetURL
 	}
 }
 
+// GetMRSourceBranch returns a source branch of the merge request
 func (c *Client) GetMRSourceBranch(repository, MRiid string) (string, error) {
 	logger.Debug("getting gitlab mr source branch",
 		"repository", repository, "MRiid", MRiid)
 
+	MR, err := c.getMR(repository, MRiid)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("get MR source branch successfully ",
+		"repository", repository, "iid", MRiid, "branch", MR.SourceBranch)
+	return MR.SourceBranch, nil
+}
+
+// GetMRState returns a state of the merge request
+func (c *Client) GetMRState(repository, MRiid string) (MRState, error) {
+	logger.Debug("getting gitlab mr state",
+		"repository", repository, "MRiid", MRiid)
+
+	MR, err := c.getMR(repository, MRiid)
+	if err != nil {
+		return "", err
+	}
+
+	return MRState(MR.State), nil
+}
+
+// CreateMRNote creates a comment on the merge request
+func (c *Client) CreateMRNote(repository, MRiid, body string) error {
+	logger.Debug("creating gitlab mr note",
+		"repository", repository, "MRiid", MRiid)
+
 	repoEncoded := url.QueryEscape(repository)
 	iidEncoded := url.QueryEscape(MRiid)
 
-	getMRSourceBranchAPI := fmt.Sprintf(getMRSourceBranchAPI, c.baseURL, repoEncoded, iidEncoded)
+	mrNoteAPI := fmt.Sprintf(mrNoteAPI, c.baseURL, repoEncoded, iidEncoded)
+
+	errCh := make(chan error, 1)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancelFunc()
+	go func() {
+		opts := []http.Option{
+			http.WithTimeout(requestTimeout),
+			http.WithContext(ctx),
+			http.WithHeader("PRIVATE-TOKEN", c.token),
+		}
+
+		reqBody, err := json.Marshal(map[string]string{"body": body})
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		_, _, err = postRequest(mrNoteAPI, reqBody, opts...)
+		errCh <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout,
+			fmt.Sprintf("creating MR note to gitlab repository: %s, iid: %s took longer than %v",
+				repository, MRiid, requestTimeout))
+		return s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		if err != nil {
+			logger.Error(err, "cannot create MR note",
+				"repository", repository, "iid", MRiid)
+			return err
+		}
+
+		return nil
+	}
+}
+
+func (c *Client) getMR(repository, MRiid string) (*gitlabMR, error) {
+	repoEncoded := url.QueryEscape(repository)
+	iidEncoded := url.QueryEscape(MRiid)
+
+	getMRAPI := fmt.Sprintf(getMRAPI, c.baseURL, repoEncoded, iidEncoded)
 
 	resCh := make(chan []byte, 1)
 	errCh := make(chan error, 1)
@@ -158,7 +250,7 @@ func (c *Client) GetMRSourceBranch(repository, MRiid string) (string, error) {
 			http.WithHeader("PRIVATE-TOKEN", gitToken),
 		}
 
-		_, res, err := getRequest(getMRSourceBranchAPI, opts...)
+		_, res, err := getRequest(getMRAPI, opts...)
 		if err != nil {
 			errCh <- err
 			return
@@ -170,23 +262,21 @@ func (c *Client) GetMRSourceBranch(repository, MRiid string) (string, error) {
 	select {
 	case <-ctx.Done():
 		logger.Error(s2herrors.ErrRequestTimeout,
-			fmt.Sprintf("get MR source branch from gitlab repository: %s, iid: %s took longer than %v",
+			fmt.Sprintf("get MR from gitlab repository: %s, iid: %s took longer than %v",
 				repository, MRiid, requestTimeout))
-		return "", s2herrors.ErrRequestTimeout
+		return nil, s2herrors.ErrRequestTimeout
 	case err := <-errCh:
-		logger.Error(err, "cannot get MR source branch",
+		logger.Error(err, "cannot get MR",
 			"repository", repository, "iid", MRiid)
-		return "", err
+		return nil, err
 	case res := <-resCh:
 		var MR gitlabMR
 		if err := json.Unmarshal(res, &MR); err != nil {
 			logger.Error(err, "cannot unmarshal MR data", "data", string(res))
-			return "", err
+			return nil, err
 		}
 
-		logger.Info("get MR source branch successfully ",
-			"repository", repository, "iid", MRiid, "branch", MR.SourceBranch)
-		return MR.SourceBranch, nil
+		return &MR, nil
 	}
 }
 
@@ -156,4 +156,62 @@ var _ = Describe("Gitlab REST API", func() {
 			g.Expect(branch).To(BeEmpty())
 		})
 	})
+
+	Describe("GetMRState", func() {
+		const (
+			repoID = "3"
+			mrIID  = "15"
+		)
+
+		It("should successfully query mr state", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				_, err := w.Write([]byte(fmt.Sprintf(`{"iid": %s, "project_id": %s, "state": "closed"}`,
+					mrIID, repoID)))
+				g.Expect(err).NotTo(HaveOccurred())
+			}))
+			defer server.Close()
+
+			gitlabClient = gitlab.NewClient(server.URL, token)
+			state, err := gitlabClient.GetMRState(repoID, mrIID)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(state).To(Equal(gitlab.MRStateClosed))
+		})
+
+		Specify("Not found response", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(404)
+			}))
+			defer server.Close()
+
+			gitlabClient = gitlab.NewClient(server.URL, token)
+			state, err := gitlabClient.GetMRState(repoID, mrIID)
+			g.Expect(err).NotTo(BeNil())
+			g.Expect(state).To(BeEmpty())
+		})
+	})
+
+	Describe("CreateMRNote", func() {
+		It("should successfully create mr note", func(done Done) {
+			defer close(done)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.URL.Path).To(Equal("/api/v4/projects/3/merge_requests/15/notes"))
+
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(body).To(MatchJSON(`{"body": "done"}`))
+
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			gitlabClient = gitlab.NewClient(server.URL, token)
+			err := gitlabClient.CreateMRNote("3", "15", "done")
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
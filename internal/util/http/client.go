@@ -20,6 +20,8 @@ type Client struct {
 	req      *http.Request
 	username string
 	password string
+	// respHeader stores headers of the response if specified
+	respHeader *http.Header
 }
 
 type Option func(client *Client)
@@ -65,6 +67,13 @@ func WithBasicAuth(username, password string) Option {
 	}
 }
 
+// WithResponseHeader stores headers of the response into the header
+func WithResponseHeader(header *http.Header) Option {
+	return func(c *Client) {
+		c.respHeader = header
+	}
+}
+
 // NewClient creates http client
 func NewClient(baseURL string, opts ...Option) *Client {
 	var err error
@@ -211,6 +220,26 @@ func Delete(reqURI string, opts ...Option) (int, []byte, error) {
 	return client.request(client.req)
 }
 
+// Do sends http request with the method
+func Do(method, reqURI string, data []byte, opts ...Option) (int, []byte, error) {
+	var err error
+	var client = Client{
+		client: &http.Client{},
+	}
+	reqURL, err := url.Parse(reqURI)
+	if err != nil {
+		return 0, nil, err
+	}
+	client.req, err = http.NewRequest(method, reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, opt := range opts {
+		opt(&client)
+	}
+	return client.request(client.req)
+}
+
 func (c *Client) request(req *http.Request) (int, []byte, error) {
 	if req.Header.Get("Content-Type") == "" {
 		req.Header.Set("Content-Type", "application/json")
@@ -225,6 +254,10 @@ func (c *Client) request(req *http.Request) (int, []byte, error) {
 	}
 	defer resp.Body.Close()
 
+	if c.respHeader != nil {
+		*c.respHeader = resp.Header.Clone()
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return resp.StatusCode, nil, err
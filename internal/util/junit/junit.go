@@ -0,0 +1,89 @@
+package junit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+
+	"github.com/pkg/errors"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+type testSuite struct {
+	Suites    []testSuite `xml:"testsuite"`
+	TestCases []testCase  `xml:"testcase"`
+}
+
+type testCase struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	Failure   *struct{} `xml:"failure"`
+	Error     *struct{} `xml:"error"`
+	Skipped   *struct{} `xml:"skipped"`
+}
+
+// Parse parses JUnit XML report to summary of test results,
+// the root element can be either `testsuites` or `testsuite`
+func Parse(data []byte) (*s2hv1.TestReport, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, errors.New("no test suite found in junit report")
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse junit report")
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		suite := testSuite{}
+		switch start.Name.Local {
+		case "testsuites":
+			if err := decoder.DecodeElement(&suite, &start); err != nil {
+				return nil, errors.Wrap(err, "cannot parse junit report")
+			}
+		case "testsuite":
+			root := testSuite{}
+			if err := decoder.DecodeElement(&root, &start); err != nil {
+				return nil, errors.Wrap(err, "cannot parse junit report")
+			}
+			suite.Suites = []testSuite{root}
+		default:
+			return nil, errors.Errorf("unknown root element of junit report: %s", start.Name.Local)
+		}
+
+		report := &s2hv1.TestReport{}
+		appendTestSuite(report, suite)
+		return report, nil
+	}
+}
+
+func appendTestSuite(report *s2hv1.TestReport, suite testSuite) {
+	for _, tc := range suite.TestCases {
+		report.Total++
+		switch {
+		case tc.Failure != nil || tc.Error != nil:
+			report.Failed++
+			report.FailedTests = append(report.FailedTests, tc.fullName())
+		case tc.Skipped != nil:
+			report.Skipped++
+		}
+	}
+
+	for _, s := range suite.Suites {
+		appendTestSuite(report, s)
+	}
+}
+
+func (tc testCase) fullName() string {
+	if tc.ClassName == "" {
+		return tc.Name
+	}
+	return tc.ClassName + "." + tc.Name
+}
@@ -0,0 +1,69 @@
+package junit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/util/junit"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestUnit(t *testing.T) {
+	unittest.InitGinkgo(t, "JUnit utils")
+}
+
+var _ = Describe("parse junit report", func() {
+	g := NewGomegaWithT(GinkgoT())
+
+	It("should correctly parse test suites", func() {
+		data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="smoke" tests="3">
+    <testcase classname="smoke.Login" name="should login"/>
+    <testcase classname="smoke.Login" name="should logout">
+      <failure message="timeout">timeout</failure>
+    </testcase>
+    <testcase classname="smoke.Search" name="should search">
+      <skipped/>
+    </testcase>
+  </testsuite>
+  <testsuite name="regression">
+    <testsuite name="nested">
+      <testcase name="should pay">
+        <error message="panic"/>
+      </testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`)
+
+		report, err := junit.Parse(data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(report).To(Equal(&s2hv1.TestReport{
+			Total:       4,
+			Failed:      2,
+			Skipped:     1,
+			FailedTests: []string{"smoke.Login.should logout", "should pay"},
+		}))
+	})
+
+	It("should correctly parse single test suite", func() {
+		data := []byte(`<testsuite name="smoke"><testcase name="a"/><testcase name="b"/></testsuite>`)
+
+		report, err := junit.Parse(data)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(report.Total).To(Equal(2))
+		g.Expect(report.Failed).To(Equal(0))
+		g.Expect(report.FailedTests).To(BeEmpty())
+	})
+
+	It("should fail to parse invalid report", func() {
+		_, err := junit.Parse([]byte(`<html></html>`))
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = junit.Parse([]byte(`not xml`))
+		g.Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,44 @@
+package prometheus
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// QueryValue queries the instant value of the PromQL query,
+// the maximum value is returned if the query result contains multiple series
+func QueryValue(ctx context.Context, address, query string) (float64, error) {
+	client, err := api.NewClient(api.Config{Address: address})
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot create prometheus client of %s", address)
+	}
+
+	result, _, err := promv1.NewAPI(client).Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot query %q from prometheus", query)
+	}
+
+	switch v := result.(type) {
+	case *model.Scalar:
+		return float64(v.Value), nil
+	case model.Vector:
+		if len(v) == 0 {
+			return 0, errors.Errorf("query %q returns no data", query)
+		}
+
+		value := math.Inf(-1)
+		for _, sample := range v {
+			value = math.Max(value, float64(sample.Value))
+		}
+
+		return value, nil
+	default:
+		return 0, errors.Errorf("unsupported result type %s of query %q", result.Type(), query)
+	}
+}
@@ -0,0 +1,62 @@
+package prometheus_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/agoda-com/samsahai/internal/util/prometheus"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestPrometheus(t *testing.T) {
+	unittest.InitGinkgo(t, "Prometheus Util")
+}
+
+var _ = Describe("Prometheus query", func() {
+	g := NewWithT(GinkgoT())
+
+	newServer := func(resp string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			g.Expect(r.URL.Path).To(Equal("/api/v1/query"))
+			g.Expect(r.FormValue("query")).To(Equal("error_rate"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(resp))
+			g.Expect(err).NotTo(HaveOccurred())
+		}))
+	}
+
+	It("should return maximum value of vector result", func() {
+		server := newServer(`{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{"pod":"a"},"value":[1609459200,"0.01"]},` +
+			`{"metric":{"pod":"b"},"value":[1609459200,"0.2"]}]}}`)
+		defer server.Close()
+
+		value, err := prometheus.QueryValue(context.Background(), server.URL, "error_rate")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(value).To(Equal(0.2))
+	})
+
+	It("should return value of scalar result", func() {
+		server := newServer(`{"status":"success","data":{"resultType":"scalar","result":[1609459200,"0.5"]}}`)
+		defer server.Close()
+
+		value, err := prometheus.QueryValue(context.Background(), server.URL, "error_rate")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(value).To(Equal(0.5))
+	})
+
+	It("should fail if query returns no data", func() {
+		server := newServer(`{"status":"success","data":{"resultType":"vector","result":[]}}`)
+		defer server.Close()
+
+		_, err := prometheus.QueryValue(context.Background(), server.URL, "error_rate")
+		g.Expect(err).To(HaveOccurred())
+	})
+})
@@ -3,6 +3,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	htmltemplate "html/template"
 	"regexp"
 	"strings"
 	"text/template"
@@ -24,19 +25,41 @@ const (
 	endValTemplateSign   = "}}"
 )
 
+var funcMap = map[string]interface{}{
+	"ToLower":             strings.ToLower,
+	"ToUpper":             strings.ToUpper,
+	"FmtDurationToStr":    fmtDurationToStr,
+	"ConcatHTTPStr":       concatHTTPStr,
+	"JoinStringWithComma": joinStringWithComma,
+	"TimeFormat":          timeFormat,
+}
+
 // TextRender creates output string from the template
 func TextRender(name, tmpl string, data interface{}) string {
-	var engine *template.Template
-	var err error
+	return render(name, tmpl, func(tmpl string, output *bytes.Buffer) error {
+		engine, err := template.New(name).Option("missingkey=error").Funcs(funcMap).Parse(tmpl)
+		if err != nil {
+			return err
+		}
 
-	funcMap := template.FuncMap{
-		"ToLower":             strings.ToLower,
-		"ToUpper":             strings.ToUpper,
-		"FmtDurationToStr":    fmtDurationToStr,
-		"ConcatHTTPStr":       concatHTTPStr,
-		"JoinStringWithComma": joinStringWithComma,
-		"TimeFormat":          timeFormat,
-	}
+		return engine.Execute(output, data)
+	})
+}
+
+// HTMLRender creates output string from the html template, values are escaped according to the html context
+func HTMLRender(name, tmpl string, data interface{}) string {
+	return render(name, tmpl, func(tmpl string, output *bytes.Buffer) error {
+		engine, err := htmltemplate.New(name).Option("missingkey=error").Funcs(funcMap).Parse(tmpl)
+		if err != nil {
+			return err
+		}
+
+		return engine.Execute(output, data)
+	})
+}
+
+func render(name, tmpl string, execute func(tmpl string, output *bytes.Buffer) error) string {
+	var err error
 
 	defer func() {
 		if err != nil {
@@ -53,15 +76,7 @@ func TextRender(name, tmpl string, data interface{}) string {
 	go func() {
 		for {
 			output.Reset()
-			if engine, err = template.New(name).Option("missingkey=error").Funcs(funcMap).Parse(tmpl); err != nil {
-				tmpl, ok := replaceMissingValuesFromError(tmpl, err)
-				if !ok {
-					outCh <- tmpl
-					break
-				}
-			}
-
-			if err = engine.Execute(&output, data); err != nil {
+			if err = execute(tmpl, &output); err != nil {
 				var ok bool
 				tmpl, ok = replaceMissingValuesFromError(tmpl, err)
 				if !ok {
@@ -0,0 +1,155 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ExporterType represents a type of trace exporter
+type ExporterType string
+
+const (
+	// ExporterOTLP exports traces to an OTLP collector over http
+	ExporterOTLP ExporterType = "otlp"
+	// ExporterStdout writes traces to stdout
+	ExporterStdout ExporterType = "stdout"
+
+	tracerName = "github.com/agoda-com/samsahai"
+)
+
+// ShutdownFunc flushes and stops exporting traces
+type ShutdownFunc func(ctx context.Context) error
+
+type config struct {
+	exporter     ExporterType
+	otlpEndpoint string
+	otlpInsecure bool
+	writer       io.Writer
+}
+
+// Option allows specifying various configuration
+type Option func(*config)
+
+// WithExporter specifies the trace exporter, tracing is disabled if exporter is empty
+func WithExporter(exporter ExporterType) Option {
+	return func(c *config) {
+		c.exporter = exporter
+	}
+}
+
+// WithOTLPEndpoint specifies host and port of the OTLP collector e.g. localhost:4318
+func WithOTLPEndpoint(endpoint string, insecure bool) Option {
+	return func(c *config) {
+		c.otlpEndpoint = endpoint
+		c.otlpInsecure = insecure
+	}
+}
+
+// WithWriter specifies the writer of stdout exporter
+func WithWriter(w io.Writer) Option {
+	return func(c *config) {
+		c.writer = w
+	}
+}
+
+// Setup registers the global tracer provider and the trace context propagator of the service
+func Setup(ctx context.Context, serviceName string, opts ...Option) (ShutdownFunc, error) {
+	cfg := &config{writer: os.Stdout}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.exporter {
+	case "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		otlpOpts := make([]otlptracehttp.Option, 0)
+		if cfg.otlpEndpoint != "" {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithEndpoint(cfg.otlpEndpoint))
+		}
+		if cfg.otlpInsecure {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, otlpOpts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(cfg.writer))
+	default:
+		return nil, errors.Errorf("unknown trace exporter %q", cfg.exporter)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot create %s trace exporter", cfg.exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// StartSpan starts a span as a child of the span in the context
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error if any and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// NewTransport returns a http.RoundTripper which injects the span context into the request headers
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+
+	return t.base.RoundTrip(req)
+}
+
+// ExtractContext returns a context containing the span context from the request headers
+func ExtractContext(req *http.Request) context.Context {
+	return otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+}
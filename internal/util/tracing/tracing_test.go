@@ -0,0 +1,66 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/agoda-com/samsahai/internal/util/tracing"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestTracing(t *testing.T) {
+	unittest.InitGinkgo(t, "Tracing Util")
+}
+
+var _ = Describe("Tracing", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should not export traces if exporter is not specified", func() {
+		shutdown, err := tracing.Setup(context.Background(), "samsahai")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(shutdown(context.Background())).To(Succeed())
+	})
+
+	It("should fail with unknown exporter", func() {
+		_, err := tracing.Setup(context.Background(), "samsahai", tracing.WithExporter("unknown"))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should propagate span context through http request headers", func() {
+		buf := &bytes.Buffer{}
+		shutdown, err := tracing.Setup(context.Background(), "samsahai",
+			tracing.WithExporter(tracing.ExporterStdout),
+			tracing.WithWriter(buf))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		var remoteSpanCtx trace.SpanContext
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remoteSpanCtx = trace.SpanContextFromContext(tracing.ExtractContext(r))
+		}))
+		defer server.Close()
+
+		ctx, span := tracing.StartSpan(context.Background(), "staging.Testing")
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		client := &http.Client{Transport: tracing.NewTransport(nil)}
+		resp, err := client.Do(req)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(resp.Body.Close()).To(Succeed())
+		tracing.EndSpan(span, nil)
+
+		g.Expect(remoteSpanCtx.IsRemote()).To(BeTrue())
+		g.Expect(remoteSpanCtx.TraceID()).To(Equal(span.SpanContext().TraceID()))
+		g.Expect(remoteSpanCtx.SpanID()).To(Equal(span.SpanContext().SpanID()))
+
+		g.Expect(shutdown(context.Background())).To(Succeed())
+		g.Expect(buf.String()).To(ContainSubstring("staging.Testing"))
+	})
+})
@@ -0,0 +1,230 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+// ImageVersion represents a tag of image with its created time
+type ImageVersion struct {
+	Tag string
+	// CreatedTime is a created time of image, zero if the checker does not provide it
+	CreatedTime time.Time
+}
+
+// VersionSelector filters tags by pattern and constraint and chooses the latest one by ordering strategy
+type VersionSelector struct {
+	matcher    *regexp.Regexp
+	constraint *semver.Constraints
+	strategy   s2hv1.ImageOrderingStrategy
+	groups     []int
+}
+
+// NewVersionSelector creates a new version selector from regex pattern and checking options
+func NewVersionSelector(pattern string, opts ...CheckOption) (*VersionSelector, error) {
+	if pattern == "" {
+		pattern = ".*"
+	}
+
+	matcher, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	o := NewCheckOptions(opts...)
+	s := &VersionSelector{matcher: matcher}
+
+	if o.Ordering != nil {
+		s.strategy = o.Ordering.Strategy
+	}
+
+	if o.Constraint != "" {
+		s.constraint, err = semver.NewConstraint(o.Constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %v", o.Constraint, err)
+		}
+
+		// tags satisfied the constraint are always semantic versions
+		if s.strategy == s2hv1.ImageOrderingDefault {
+			s.strategy = s2hv1.ImageOrderingSemver
+		}
+	}
+
+	switch s.strategy {
+	case s2hv1.ImageOrderingDefault, s2hv1.ImageOrderingSemver, s2hv1.ImageOrderingSemverWithPrerelease,
+		s2hv1.ImageOrderingLexical, s2hv1.ImageOrderingCreatedTime:
+	case s2hv1.ImageOrderingCaptureGroup:
+		s.groups, err = captureGroupIndexes(matcher, o.Ordering.CaptureGroups)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown ordering strategy %q", s.strategy)
+	}
+
+	return s, nil
+}
+
+// IsDefaultOrdering returns true if tags are ordered by default strategy without constraint
+func (s *VersionSelector) IsDefaultOrdering() bool {
+	return s.strategy == s2hv1.ImageOrderingDefault && s.constraint == nil
+}
+
+// Strategy returns ordering strategy of the selector
+func (s *VersionSelector) Strategy() s2hv1.ImageOrderingStrategy {
+	return s.strategy
+}
+
+// String returns the pattern of the selector
+func (s *VersionSelector) String() string {
+	return s.matcher.String()
+}
+
+// Match returns true if the tag matches the pattern and satisfies the constraint
+func (s *VersionSelector) Match(tag string) bool {
+	if !s.matcher.MatchString(tag) {
+		return false
+	}
+
+	// tags must be semantic versions if they are ordered by semver or the constraint is set
+	isSemverOrdering := s.strategy == s2hv1.ImageOrderingSemver || s.strategy == s2hv1.ImageOrderingSemverWithPrerelease
+	if !isSemverOrdering && s.constraint == nil {
+		return true
+	}
+
+	v, err := semver.NewVersion(tag)
+	if err != nil {
+		return false
+	}
+
+	if s.strategy == s2hv1.ImageOrderingSemver && v.Prerelease() != "" {
+		return false
+	}
+
+	if s.constraint == nil {
+		return true
+	}
+
+	// pre-release version satisfies only the constraint which includes pre-release, e.g. `>=2.3.0-0`
+	return s.constraint.Check(v)
+}
+
+// Latest returns the latest tag of matched versions, false if there is no matched version
+func (s *VersionSelector) Latest(versions []ImageVersion) (string, bool) {
+	matched := make([]ImageVersion, 0)
+	for _, v := range versions {
+		if s.Match(v.Tag) {
+			matched = append(matched, v)
+		}
+	}
+
+	if len(matched) == 0 {
+		return "", false
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return s.less(matched[i], matched[j])
+	})
+
+	return matched[len(matched)-1].Tag, true
+}
+
+// LatestTag returns the latest tag of matched tags, false if there is no matched tag
+func (s *VersionSelector) LatestTag(tags []string) (string, bool) {
+	versions := make([]ImageVersion, len(tags))
+	for i := range tags {
+		versions[i] = ImageVersion{Tag: tags[i]}
+	}
+	return s.Latest(versions)
+}
+
+func (s *VersionSelector) less(i, j ImageVersion) bool {
+	switch s.strategy {
+	case s2hv1.ImageOrderingSemver, s2hv1.ImageOrderingSemverWithPrerelease:
+		iv, _ := semver.NewVersion(i.Tag)
+		jv, _ := semver.NewVersion(j.Tag)
+		if c := iv.Compare(jv); c != 0 {
+			return c < 0
+		}
+	case s2hv1.ImageOrderingLexical:
+		return i.Tag < j.Tag
+	case s2hv1.ImageOrderingCreatedTime:
+		if !i.CreatedTime.Equal(j.CreatedTime) {
+			return i.CreatedTime.Before(j.CreatedTime)
+		}
+	case s2hv1.ImageOrderingCaptureGroup:
+		if c := s.compareCaptureGroups(i.Tag, j.Tag); c != 0 {
+			return c < 0
+		}
+	}
+
+	return SortableVersion{i.Tag, j.Tag}.Less(0, 1)
+}
+
+// compareCaptureGroups compares captured values in order,
+// numbers are compared numerically and the others are compared alphabetically
+func (s *VersionSelector) compareCaptureGroups(i, j string) int {
+	im := s.matcher.FindStringSubmatch(i)
+	jm := s.matcher.FindStringSubmatch(j)
+
+	for _, g := range s.groups {
+		if g >= len(im) || g >= len(jm) {
+			break
+		}
+
+		iv, iErr := strconv.Atoi(im[g])
+		jv, jErr := strconv.Atoi(jm[g])
+		if iErr == nil && jErr == nil {
+			if iv != jv {
+				if iv < jv {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+
+		if c := strings.Compare(im[g], jm[g]); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// captureGroupIndexes returns indexes of capture groups from the names or indexes,
+// all capture groups are used if there is no group defined
+func captureGroupIndexes(matcher *regexp.Regexp, groups []string) ([]int, error) {
+	if matcher.NumSubexp() == 0 {
+		return nil, fmt.Errorf("pattern %q has no capture group", matcher)
+	}
+
+	indexes := make([]int, 0)
+	if len(groups) == 0 {
+		for i := 1; i <= matcher.NumSubexp(); i++ {
+			indexes = append(indexes, i)
+		}
+		return indexes, nil
+	}
+
+	for _, g := range groups {
+		idx, err := strconv.Atoi(g)
+		if err != nil {
+			idx = matcher.SubexpIndex(g)
+		}
+		if idx < 1 || idx > matcher.NumSubexp() {
+			return nil, fmt.Errorf("capture group %q not found in pattern %q", g, matcher)
+		}
+		indexes = append(indexes, idx)
+	}
+
+	return indexes, nil
+}
@@ -0,0 +1,151 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestVersionSelector(t *testing.T) {
+	unittest.InitGinkgo(t, "Version Selector")
+}
+
+var _ = Describe("Version Selector", func() {
+	g := NewWithT(GinkgoT())
+
+	latest := func(pattern string, tags []string, opts ...internal.CheckOption) string {
+		selector, err := internal.NewVersionSelector(pattern, opts...)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		tag, ok := selector.LatestTag(tags)
+		g.Expect(ok).To(BeTrue())
+		return tag
+	}
+
+	It("should order by default strategy", func() {
+		tags := []string{"1.2.9", "1.2.10", "1.2.3"}
+		g.Expect(latest("", tags)).To(Equal("1.2.10"))
+	})
+
+	It("should order by semver and ignore pre-release and non-semver tags", func() {
+		tags := []string{"2.3.1", "2.10.0-rc.1", "2.4.0+build.5", "latest", "2.3.10"}
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingSemver}
+		g.Expect(latest("", tags, internal.WithVersionOrdering(ordering))).To(Equal("2.4.0+build.5"))
+	})
+
+	It("should order by semver with pre-release", func() {
+		tags := []string{"2.3.1", "2.10.0-rc.2", "2.10.0-rc.10", "2.9.0"}
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingSemverWithPrerelease}
+		g.Expect(latest("", tags, internal.WithVersionOrdering(ordering))).To(Equal("2.10.0-rc.10"))
+
+		tags = append(tags, "2.10.0")
+		g.Expect(latest("", tags, internal.WithVersionOrdering(ordering))).To(Equal("2.10.0"))
+	})
+
+	It("should filter by constraint with semver ordering by default", func() {
+		tags := []string{"2.2.0", "2.3.0", "2.10.1", "3.0.0", "3.0.0-rc.1", "2.11.0-rc.1"}
+		g.Expect(latest("", tags, internal.WithVersionConstraint(">=2.3 <3"))).To(Equal("2.10.1"))
+
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingSemverWithPrerelease}
+		g.Expect(latest("", tags, internal.WithVersionConstraint(">=2.3 <3"), internal.WithVersionOrdering(ordering))).
+			To(Equal("2.10.1"))
+		g.Expect(latest("", tags, internal.WithVersionConstraint(">=2.3.0-0 <3.0.0-0"), internal.WithVersionOrdering(ordering))).
+			To(Equal("2.11.0-rc.1"))
+	})
+
+	It("should not match pre-release version which precedes the constraint", func() {
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingSemverWithPrerelease}
+		selector, err := internal.NewVersionSelector("", internal.WithVersionConstraint(">=2.3"),
+			internal.WithVersionOrdering(ordering))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(selector.Match("2.3.0-rc.1")).To(BeFalse())
+		g.Expect(selector.Match("2.3.0")).To(BeTrue())
+
+		selector, err = internal.NewVersionSelector("", internal.WithVersionConstraint(">=2.3.0-0"),
+			internal.WithVersionOrdering(ordering))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(selector.Match("2.3.0-rc.1")).To(BeTrue())
+		g.Expect(selector.Match("2.2.9-rc.1")).To(BeFalse())
+	})
+
+	It("should order alphabetically", func() {
+		tags := []string{"2021-01-10", "2021-01-09", "2020-12-31"}
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingLexical}
+		g.Expect(latest("", tags, internal.WithVersionOrdering(ordering))).To(Equal("2021-01-10"))
+	})
+
+	It("should apply constraint with non-semver ordering", func() {
+		tags := []string{"2.2.0", "2.3.0", "2.10.1", "3.0.0", "latest"}
+		ordering := &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingLexical}
+		g.Expect(latest("", tags, internal.WithVersionConstraint(">=2.3 <3"), internal.WithVersionOrdering(ordering))).
+			To(Equal("2.3.0"))
+
+		pattern := `^(\d+)\.(\d+)\.(\d+)$`
+		ordering = &s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingCaptureGroup}
+		g.Expect(latest(pattern, tags, internal.WithVersionConstraint("<3"), internal.WithVersionOrdering(ordering))).
+			To(Equal("2.10.1"))
+
+		selector, err := internal.NewVersionSelector("", internal.WithVersionConstraint(">=2"),
+			internal.WithVersionOrdering(&s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingCreatedTime}))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(selector.Match("3.0.0")).To(BeTrue())
+		g.Expect(selector.Match("1.0.0")).To(BeFalse())
+		g.Expect(selector.Match("latest")).To(BeFalse(), "non-semver tag should be rejected")
+	})
+
+	It("should order by created time", func() {
+		now := time.Now()
+		selector, err := internal.NewVersionSelector("", internal.WithVersionOrdering(
+			&s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingCreatedTime}))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		tag, ok := selector.Latest([]internal.ImageVersion{
+			{Tag: "abc123", CreatedTime: now.Add(-time.Hour)},
+			{Tag: "def456", CreatedTime: now},
+			{Tag: "aaa000", CreatedTime: now.Add(-2 * time.Hour)},
+		})
+		g.Expect(ok).To(BeTrue())
+		g.Expect(tag).To(Equal("def456"))
+	})
+
+	It("should order by capture groups", func() {
+		tags := []string{"1.0.0-r12", "1.0.0-r2", "1.1.0-r1"}
+		pattern := `^(?P<version>[\d.]+)-r(?P<revision>\d+)$`
+
+		ordering := &s2hv1.ImageOrdering{
+			Strategy:      s2hv1.ImageOrderingCaptureGroup,
+			CaptureGroups: []string{"revision"},
+		}
+		g.Expect(latest(pattern, tags, internal.WithVersionOrdering(ordering))).To(Equal("1.0.0-r12"))
+
+		ordering.CaptureGroups = []string{"version", "2"}
+		g.Expect(latest(pattern, tags, internal.WithVersionOrdering(ordering))).To(Equal("1.1.0-r1"))
+	})
+
+	It("should return false if no tag matched", func() {
+		selector, err := internal.NewVersionSelector(`^1\.`, internal.WithVersionConstraint(">=2"))
+		g.Expect(err).NotTo(HaveOccurred())
+
+		_, ok := selector.LatestTag([]string{"1.0.0", "2.0.0"})
+		g.Expect(ok).To(BeFalse())
+	})
+
+	It("should fail with invalid options", func() {
+		_, err := internal.NewVersionSelector("", internal.WithVersionConstraint("not a constraint"))
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = internal.NewVersionSelector("", internal.WithVersionOrdering(
+			&s2hv1.ImageOrdering{Strategy: "unknown"}))
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = internal.NewVersionSelector(`^(\d+)$`, internal.WithVersionOrdering(
+			&s2hv1.ImageOrdering{Strategy: s2hv1.ImageOrderingCaptureGroup, CaptureGroups: []string{"missing"}}))
+		g.Expect(err).To(HaveOccurred())
+	})
+})
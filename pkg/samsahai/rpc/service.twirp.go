@@ -5270,106 +5270,106 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x52, 0xdb, 0xc6,
-	0x17, 0x8f, 0x0c, 0x06, 0x7c, 0x08, 0x20, 0x36, 0x84, 0x28, 0x26, 0x7f, 0xe2, 0xd1, 0x90, 0xc4,
-	0xf9, 0x4f, 0xeb, 0x34, 0xa4, 0x37, 0xfd, 0x98, 0x69, 0xc1, 0x76, 0x8c, 0xa7, 0x60, 0x9c, 0xb5,
-	0x21, 0xfd, 0x98, 0x84, 0x11, 0xf6, 0xe2, 0x6c, 0x6b, 0x4b, 0xca, 0x6a, 0x45, 0xe2, 0x69, 0x6f,
-	0xdb, 0xdb, 0xde, 0xf6, 0xa2, 0xcf, 0xd1, 0x17, 0xe8, 0x23, 0xf4, 0x3d, 0x7a, 0xd1, 0x27, 0xe8,
-	0x68, 0xf5, 0x2d, 0xcb, 0x1f, 0x69, 0xe9, 0x95, 0x77, 0xcf, 0x9e, 0x3d, 0xdf, 0xe7, 0x77, 0xd6,
-	0x82, 0x6d, 0xf3, 0xbb, 0xde, 0x23, 0x4b, 0x1b, 0x58, 0xda, 0x2b, 0x8d, 0x3e, 0x62, 0x66, 0xe7,
-	0x91, 0x45, 0xd8, 0x25, 0xed, 0x90, 0x92, 0xc9, 0x0c, 0x6e, 0xa0, 0x0d, 0xff, 0xac, 0x44, 0x8d,
-	0x92, 0xbf, 0x56, 0x17, 0x21, 0x5b, 0x1d, 0x98, 0x7c, 0xa8, 0x36, 0x01, 0xb5, 0x89, 0x36, 0x78,
-	0x4e, 0xf9, 0xab, 0x7d, 0x5b, 0xef, 0xf6, 0x49, 0x43, 0x1b, 0x10, 0x94, 0x87, 0x25, 0x4e, 0xb4,
-	0x81, 0xb3, 0x56, 0xa4, 0x82, 0x54, 0xcc, 0xe1, 0x60, 0x8f, 0xb6, 0x01, 0xce, 0x03, 0x4e, 0x25,
-	0x23, 0x4e, 0x23, 0x14, 0xb5, 0x00, 0x10, 0x91, 0x84, 0x60, 0x5e, 0x0f, 0xa5, 0x88, 0xb5, 0xba,
-	0x0d, 0x4b, 0x6d, 0x5f, 0x5a, 0xda, 0x79, 0x11, 0x56, 0x9b, 0x8c, 0x1a, 0x8c, 0xf2, 0xe1, 0x33,
-	0x9b, 0xd8, 0xc4, 0x42, 0x9b, 0xb0, 0xf0, 0x5a, 0xac, 0x14, 0xa9, 0x30, 0x57, 0xcc, 0x61, 0x6f,
-	0xa7, 0xbe, 0x84, 0x5b, 0x4d, 0xbb, 0xdf, 0xc7, 0xe4, 0xb5, 0x4d, 0x2c, 0x5e, 0x21, 0x26, 0xd1,
-	0xbb, 0x44, 0xef, 0x50, 0x62, 0xa1, 0x32, 0x5c, 0xef, 0x46, 0xf6, 0xe2, 0xe2, 0xf2, 0xee, 0xdd,
-	0x52, 0x5a, 0x38, 0x4a, 0x65, 0x63, 0x60, 0x1a, 0x3a, 0xd1, 0x39, 0x8e, 0x5d, 0x52, 0xff, 0xc8,
-	0x81, 0x1c, 0x9c, 0x9d, 0x98, 0x3d, 0xa6, 0x75, 0x09, 0x3a, 0x84, 0x05, 0x8b, 0x6b, 0xdc, 0xb6,
-	0x84, 0xd1, 0xab, 0xbb, 0x1f, 0x4e, 0x91, 0xe9, 0xdd, 0x2b, 0x79, 0xbf, 0x2d, 0x71, 0x17, 0x7b,
-	0x32, 0x82, 0x00, 0x64, 0xc2, 0x00, 0xc4, 0xc2, 0x3f, 0x97, 0x08, 0xff, 0x67, 0x00, 0x1d, 0x5f,
-	0xb2, 0xa5, 0xcc, 0xcf, 0xe6, 0x55, 0xe4, 0x0a, 0x6a, 0x40, 0x8e, 0x5a, 0x96, 0x4d, 0xda, 0x43,
-	0x93, 0x28, 0x59, 0xe1, 0xc1, 0x07, 0x33, 0x7a, 0x50, 0xf7, 0xef, 0xe1, 0x50, 0x04, 0xfa, 0x3f,
-	0xc8, 0x22, 0x1b, 0x07, 0xd4, 0xe2, 0x06, 0x1b, 0x0a, 0xa3, 0x17, 0x84, 0xd1, 0x23, 0x74, 0x54,
-	0x03, 0x99, 0x0e, 0xb4, 0x1e, 0x39, 0xa2, 0x96, 0x45, 0xf5, 0xde, 0x21, 0xb5, 0xb8, 0xb2, 0x28,
-	0x5c, 0xd8, 0x4a, 0x37, 0xa1, 0xee, 0x70, 0xe3, 0x91, 0x4b, 0xe8, 0x0e, 0xe4, 0x9c, 0x48, 0x59,
-	0xa6, 0xd6, 0x21, 0xca, 0x92, 0xd0, 0x16, 0x12, 0x50, 0x11, 0xd6, 0x38, 0xb1, 0xf8, 0xbe, 0x4d,
-	0xfb, 0x5d, 0xc7, 0xc6, 0x7a, 0x45, 0xc9, 0x09, 0x9e, 0x24, 0xd9, 0x89, 0x3e, 0xb3, 0x75, 0x4b,
-	0x81, 0x82, 0x54, 0xcc, 0x62, 0xb1, 0x76, 0x0a, 0x9c, 0x5a, 0x98, 0x5c, 0x12, 0x46, 0x2f, 0x86,
-	0xca, 0x72, 0x41, 0x2a, 0x2e, 0xe1, 0x08, 0x05, 0x19, 0xb0, 0xc1, 0xdc, 0x35, 0xed, 0x68, 0x9c,
-	0x1a, 0xba, 0x9b, 0x51, 0xe5, 0xba, 0x88, 0xe5, 0x27, 0x33, 0xc6, 0x12, 0xa7, 0x88, 0xc0, 0xa9,
-	0x82, 0xd1, 0x33, 0x90, 0xbb, 0xc4, 0xec, 0x1b, 0xc3, 0x01, 0xd1, 0xb9, 0xc8, 0x81, 0xa5, 0xac,
-	0x88, 0xa8, 0xdd, 0x4b, 0x57, 0x56, 0x89, 0x73, 0xe3, 0x91, 0xeb, 0xe8, 0x05, 0x6c, 0x98, 0x61,
-	0xe3, 0x04, 0xc6, 0x29, 0xab, 0x05, 0xa9, 0xb8, 0xbc, 0xfb, 0x30, 0x5d, 0xac, 0x0f, 0x14, 0x91,
-	0x96, 0xc3, 0xa9, 0x62, 0xd0, 0x6e, 0x4c, 0x7c, 0x23, 0xc8, 0xd4, 0x9a, 0xc8, 0x42, 0xea, 0x99,
-	0xaa, 0xc1, 0x4a, 0xac, 0x43, 0xd0, 0x6d, 0xb8, 0x19, 0x23, 0x9c, 0x3d, 0xdd, 0xab, 0x1f, 0x9e,
-	0xe0, 0xaa, 0x7c, 0x6d, 0xf4, 0xa8, 0x75, 0x52, 0x2e, 0x57, 0x5b, 0x2d, 0x59, 0x42, 0x79, 0xd8,
-	0x8c, 0x1f, 0x95, 0xf7, 0x1a, 0xe5, 0xea, 0x61, 0xb5, 0x22, 0x67, 0xd4, 0x9f, 0x24, 0xc8, 0x05,
-	0x35, 0x8c, 0x6e, 0xc2, 0x7a, 0xb0, 0x39, 0x3b, 0x69, 0x7c, 0xd1, 0x38, 0x7e, 0xde, 0x90, 0xaf,
-	0xa1, 0x1d, 0x28, 0x84, 0xe4, 0x4a, 0xb5, 0x55, 0xc7, 0xd5, 0xca, 0xd9, 0x69, 0x15, 0xb7, 0xea,
-	0xc7, 0x0d, 0x61, 0x42, 0xb5, 0x22, 0x4b, 0x68, 0x0b, 0x6e, 0x85, 0x5c, 0xf5, 0xa3, 0xbd, 0x5a,
-	0xf5, 0xec, 0xa8, 0xde, 0x6a, 0xd5, 0x1b, 0x35, 0x39, 0x83, 0xee, 0xc2, 0x56, 0x78, 0x58, 0x6d,
-	0x9c, 0xd6, 0xf1, 0x71, 0xe3, 0xa8, 0xda, 0x68, 0x9f, 0xd5, 0x5b, 0xad, 0x93, 0xaa, 0x3c, 0xa7,
-	0xfe, 0x00, 0x1b, 0x69, 0xf9, 0x47, 0x05, 0xb8, 0x93, 0x46, 0x8f, 0x58, 0x37, 0x8e, 0xc3, 0x8f,
-	0x8d, 0x34, 0x96, 0xc3, 0x0f, 0x51, 0x46, 0xc5, 0x90, 0x0b, 0x53, 0x95, 0x02, 0xc0, 0xe8, 0x31,
-	0x64, 0x45, 0xc7, 0x09, 0x50, 0x9a, 0xd2, 0x9b, 0x2e, 0xa7, 0xfa, 0x11, 0x64, 0xc5, 0xde, 0xe9,
-	0x1e, 0x46, 0x4c, 0xc3, 0xa2, 0x4e, 0xd3, 0x7b, 0x52, 0x23, 0x14, 0x24, 0xc3, 0x1c, 0xd7, 0x7a,
-	0x1e, 0xdc, 0x39, 0x4b, 0xf5, 0x73, 0xc8, 0x89, 0xab, 0xa2, 0xb1, 0x9f, 0xc0, 0x82, 0x10, 0xe8,
-	0x03, 0xf6, 0x44, 0xdd, 0x1e, 0xab, 0xfa, 0x06, 0x14, 0xbf, 0x36, 0xcb, 0x36, 0x63, 0x44, 0x8f,
-	0x94, 0xe2, 0xa4, 0x51, 0x16, 0xc7, 0xd2, 0xcc, 0x3b, 0x63, 0xa9, 0xfa, 0xa3, 0x04, 0x6b, 0x89,
-	0x66, 0x73, 0xa0, 0x29, 0xc4, 0x57, 0x57, 0x63, 0x04, 0x2d, 0xdb, 0xb0, 0x7e, 0xa1, 0xd1, 0xbe,
-	0xcd, 0x48, 0x39, 0xa9, 0xf9, 0x7e, 0xba, 0xe6, 0xa7, 0x09, 0x76, 0x3c, 0x2a, 0x40, 0xfd, 0x4d,
-	0x02, 0x39, 0xc9, 0x87, 0x76, 0x60, 0x25, 0x30, 0x35, 0xe2, 0x7e, 0x9c, 0x88, 0x3e, 0x85, 0xdb,
-	0x17, 0x94, 0x59, 0x3c, 0xb8, 0xae, 0x73, 0x8d, 0xea, 0x84, 0x45, 0xa6, 0xfb, 0x78, 0x06, 0xa4,
-	0xc2, 0x75, 0x46, 0x2c, 0xae, 0x31, 0x5e, 0x36, 0x6c, 0x9d, 0x8b, 0x69, 0x95, 0xc5, 0x31, 0x9a,
-	0x93, 0x01, 0xdd, 0xe8, 0xba, 0xcf, 0x85, 0x79, 0x37, 0x03, 0xfe, 0x5e, 0x3d, 0x82, 0x75, 0x3f,
-	0x73, 0x01, 0x12, 0x4c, 0x4c, 0x59, 0x0c, 0xf8, 0x33, 0x09, 0xe0, 0x57, 0x7f, 0xc9, 0xc0, 0x8d,
-	0x14, 0x94, 0xfa, 0x37, 0xef, 0x19, 0xe7, 0x6e, 0x13, 0x37, 0xec, 0xc1, 0x39, 0x61, 0xfe, 0x30,
-	0xf6, 0xf7, 0x8e, 0x35, 0x1d, 0x63, 0x30, 0xa0, 0xbc, 0x75, 0xb0, 0xe7, 0xf9, 0x16, 0x12, 0xe2,
-	0xb6, 0x66, 0x93, 0x43, 0x6a, 0x07, 0x56, 0x06, 0xda, 0x5b, 0x4c, 0x38, 0x73, 0x5f, 0x39, 0x62,
-	0x68, 0x66, 0x71, 0x9c, 0x78, 0x65, 0x13, 0x53, 0xfd, 0x33, 0x03, 0xeb, 0xcd, 0x28, 0x56, 0xeb,
-	0x17, 0xb4, 0xe7, 0xe4, 0xaf, 0x63, 0xe8, 0x1d, 0xd1, 0x34, 0x1d, 0xe2, 0xbe, 0x68, 0xb2, 0x38,
-	0x46, 0x73, 0x02, 0xe0, 0xdb, 0x24, 0xc2, 0x93, 0xc5, 0xc1, 0x1e, 0xdd, 0x87, 0xd5, 0x81, 0xf6,
-	0xd6, 0x1b, 0xf1, 0x15, 0x6d, 0x68, 0x79, 0x15, 0x90, 0xa0, 0xa2, 0x03, 0x58, 0xe4, 0x8c, 0xf6,
-	0x7a, 0x84, 0x89, 0x30, 0x2d, 0xef, 0x96, 0xd2, 0xad, 0x8f, 0x58, 0xd8, 0x76, 0xf9, 0x5d, 0x43,
-	0xb1, 0x7f, 0xdd, 0x09, 0x5b, 0x8f, 0x72, 0x1c, 0x42, 0x8c, 0x1b, 0xd8, 0x38, 0xd1, 0xf1, 0xab,
-	0x47, 0x79, 0x93, 0x19, 0xdf, 0x92, 0x0e, 0xaf, 0x57, 0xbc, 0x07, 0x49, 0x8c, 0x86, 0x5e, 0x80,
-	0xcc, 0x89, 0xc6, 0x2a, 0xc6, 0x1b, 0xbd, 0x62, 0x33, 0x01, 0x95, 0xca, 0xa2, 0x30, 0xee, 0xf1,
-	0x74, 0xe3, 0x12, 0x17, 0xf1, 0x88, 0x28, 0xf5, 0x4b, 0x50, 0xc6, 0x79, 0x13, 0x0b, 0xa9, 0x94,
-	0x08, 0x69, 0x01, 0x96, 0x4d, 0xa3, 0xdf, 0xa7, 0x7a, 0xaf, 0x4d, 0x83, 0x82, 0x8c, 0x92, 0xd4,
-	0x57, 0x70, 0x23, 0xe8, 0xf2, 0x96, 0x61, 0xb3, 0x8e, 0x0b, 0x9d, 0xcf, 0x22, 0x6f, 0x55, 0x97,
-	0xec, 0x83, 0xe8, 0xbd, 0x29, 0x98, 0xe6, 0x72, 0xe3, 0x91, 0xeb, 0xea, 0xaf, 0x12, 0xac, 0x25,
-	0x88, 0x33, 0xc2, 0xca, 0x26, 0x2c, 0x58, 0x82, 0xdf, 0x73, 0xc0, 0xdb, 0x21, 0x05, 0x16, 0x4d,
-	0x8d, 0x73, 0xc2, 0x74, 0xaf, 0x99, 0xfc, 0x6d, 0x38, 0x74, 0xe6, 0x67, 0x1e, 0x3a, 0xef, 0x45,
-	0x3c, 0x3e, 0x25, 0xcc, 0xa2, 0x86, 0xee, 0x28, 0xb8, 0x74, 0x97, 0x9e, 0x61, 0xfe, 0x56, 0xfd,
-	0x5d, 0x02, 0x34, 0x9a, 0x91, 0xd4, 0x01, 0x38, 0x11, 0x65, 0x26, 0x3e, 0xcf, 0x37, 0x61, 0x81,
-	0x11, 0xcb, 0xee, 0x73, 0x0f, 0x0e, 0xbc, 0x5d, 0x6a, 0x1f, 0x67, 0xff, 0x49, 0x1f, 0xff, 0x25,
-	0xc1, 0xd6, 0x84, 0x42, 0x74, 0x8c, 0xeb, 0xfa, 0xd5, 0xec, 0xb8, 0x34, 0x87, 0x83, 0x3d, 0x3a,
-	0x85, 0xa5, 0x0e, 0xa3, 0x9c, 0x30, 0xaa, 0x09, 0xaf, 0x56, 0x77, 0x3f, 0x7e, 0xe7, 0x4a, 0x2f,
-	0x95, 0x3d, 0x09, 0x38, 0x90, 0xa5, 0x9e, 0xc1, 0x92, 0x4f, 0x45, 0x1b, 0x20, 0xfb, 0xeb, 0xc8,
-	0xb3, 0x65, 0x1d, 0x56, 0x02, 0xea, 0xfe, 0x71, 0xfb, 0x40, 0x96, 0x62, 0x8c, 0xfe, 0xeb, 0x25,
-	0x13, 0xa3, 0xfa, 0x2f, 0x96, 0xb9, 0xdd, 0x9f, 0x57, 0x60, 0x0e, 0x37, 0xcb, 0x48, 0x83, 0xcd,
-	0x1a, 0x71, 0x4c, 0x1a, 0xec, 0x75, 0x38, 0xbd, 0x24, 0xe1, 0xcc, 0xd8, 0x1e, 0xff, 0x64, 0x75,
-	0x98, 0xf2, 0x0f, 0x26, 0x3f, 0x69, 0x43, 0x41, 0x2f, 0xe1, 0x16, 0xb6, 0xf5, 0xa6, 0x11, 0x79,
-	0xce, 0xfa, 0x7f, 0xfc, 0xee, 0xcf, 0xf6, 0xb4, 0xcf, 0x8f, 0xc9, 0xa8, 0xf8, 0xc3, 0x1d, 0x91,
-	0x1f, 0x09, 0xb2, 0x8b, 0xf5, 0x57, 0x22, 0xff, 0x1c, 0x6e, 0x8f, 0xca, 0xf7, 0x6b, 0xbd, 0x38,
-	0x2b, 0xea, 0x4e, 0xd6, 0xc1, 0xa0, 0x38, 0xc6, 0x87, 0xb6, 0xf3, 0x9f, 0xc0, 0xd6, 0x75, 0xc2,
-	0x7c, 0x95, 0xb3, 0xff, 0x97, 0x98, 0xac, 0x93, 0x00, 0xaa, 0x11, 0xee, 0x75, 0x82, 0xd7, 0xec,
-	0x16, 0x2a, 0x4d, 0x96, 0x9e, 0x7c, 0x0d, 0xe6, 0xef, 0x4e, 0x68, 0x36, 0x01, 0xa2, 0x1a, 0xe4,
-	0x5b, 0x44, 0xef, 0x9e, 0x98, 0x5d, 0x8d, 0x8b, 0x7f, 0x10, 0x44, 0xf8, 0x75, 0x44, 0x38, 0xa3,
-	0x9d, 0xab, 0xc9, 0xd0, 0x37, 0xb0, 0x52, 0x23, 0x3c, 0xf2, 0x8d, 0xa4, 0x38, 0xd9, 0x89, 0x90,
-	0x33, 0x5f, 0x48, 0xe7, 0x8c, 0xc8, 0xfa, 0x0a, 0xd6, 0x6b, 0x84, 0x27, 0x3e, 0x9f, 0x4c, 0x6b,
-	0x8e, 0x9d, 0x31, 0x65, 0x11, 0x97, 0xf2, 0x3d, 0x14, 0x1c, 0xd1, 0x61, 0xc2, 0x5c, 0xb5, 0xb1,
-	0xaf, 0x2e, 0xb3, 0xbb, 0xf2, 0xfe, 0xd4, 0x52, 0x8c, 0x09, 0xa6, 0xb0, 0x11, 0x57, 0xee, 0x4d,
-	0xd2, 0xd9, 0x15, 0x3e, 0x98, 0xaa, 0xd0, 0x13, 0x79, 0x09, 0xdb, 0x49, 0x55, 0xf1, 0xb1, 0xf8,
-	0x2e, 0x35, 0xfd, 0x70, 0xa6, 0xd1, 0x2b, 0x4a, 0xaf, 0x0b, 0x37, 0x6a, 0x84, 0x8f, 0x0c, 0xb4,
-	0xd9, 0x86, 0x77, 0x7e, 0x5a, 0x69, 0xfa, 0xe2, 0x2e, 0xc1, 0xfb, 0x3c, 0xe0, 0x22, 0x68, 0xcb,
-	0xfd, 0x58, 0x68, 0xd5, 0x75, 0x6e, 0x44, 0xec, 0xae, 0xea, 0x97, 0x94, 0x19, 0xba, 0xf3, 0xaf,
-	0x06, 0xcd, 0x0a, 0x9d, 0x93, 0xab, 0xfe, 0x02, 0xee, 0x94, 0x19, 0xd1, 0x38, 0x19, 0xa3, 0xe5,
-	0xea, 0x70, 0xe2, 0x7f, 0x15, 0x62, 0x71, 0x66, 0x0c, 0xff, 0x4b, 0x77, 0xf6, 0xd1, 0xd7, 0x72,
-	0xf2, 0xc3, 0xeb, 0xf9, 0x82, 0xf8, 0xe2, 0xfa, 0xe4, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6,
-	0x3a, 0x64, 0x07, 0x93, 0x15, 0x00, 0x00,
+	// 1614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x72, 0xdb, 0xb6,
+	0x16, 0x0e, 0x65, 0x4b, 0xb6, 0x8e, 0x63, 0x9b, 0x46, 0x1c, 0x87, 0x91, 0x73, 0x1d, 0x0d, 0xc7,
+	0x49, 0x94, 0x3b, 0xf7, 0x2a, 0x37, 0xce, 0xdd, 0xdc, 0x9f, 0x99, 0xd6, 0x96, 0x14, 0x59, 0x53,
+	0x5b, 0x56, 0x20, 0xd9, 0xe9, 0xcf, 0x24, 0x1e, 0x5a, 0x82, 0x15, 0xb4, 0x12, 0xc9, 0x80, 0xa0,
+	0x13, 0x4d, 0xbb, 0x6d, 0xb7, 0xdd, 0xf6, 0x49, 0xfa, 0x02, 0x7d, 0x84, 0x6e, 0xfa, 0x14, 0x5d,
+	0xf4, 0x09, 0x3a, 0x04, 0xff, 0x29, 0xea, 0x27, 0xad, 0xbb, 0x22, 0x70, 0x70, 0x70, 0x7e, 0x71,
+	0xbe, 0x03, 0x10, 0x76, 0xcc, 0xaf, 0xfa, 0x4f, 0x2c, 0x6d, 0x68, 0x69, 0x6f, 0x34, 0xfa, 0x84,
+	0x99, 0xdd, 0x27, 0x16, 0x61, 0x57, 0xb4, 0x4b, 0xca, 0x26, 0x33, 0xb8, 0x81, 0x36, 0xfd, 0xb5,
+	0x32, 0x35, 0xca, 0xfe, 0x58, 0x5d, 0x82, 0x6c, 0x6d, 0x68, 0xf2, 0x91, 0xda, 0x02, 0xd4, 0x21,
+	0xda, 0xf0, 0x25, 0xe5, 0x6f, 0x0e, 0x6c, 0xbd, 0x37, 0x20, 0x4d, 0x6d, 0x48, 0x50, 0x01, 0x96,
+	0x39, 0xd1, 0x86, 0xce, 0x58, 0x91, 0x8a, 0x52, 0x29, 0x8f, 0x83, 0x39, 0xda, 0x01, 0xb8, 0x08,
+	0x38, 0x95, 0x8c, 0x58, 0x8d, 0x50, 0xd4, 0x22, 0x40, 0x44, 0x12, 0x82, 0x45, 0x3d, 0x94, 0x22,
+	0xc6, 0xea, 0x0e, 0x2c, 0x77, 0x7c, 0x69, 0x69, 0xeb, 0x25, 0x58, 0x6b, 0x31, 0x6a, 0x30, 0xca,
+	0x47, 0x2f, 0x6c, 0x62, 0x13, 0x0b, 0x6d, 0x41, 0xee, 0xad, 0x18, 0x29, 0x52, 0x71, 0xa1, 0x94,
+	0xc7, 0xde, 0x4c, 0x7d, 0x0d, 0x77, 0x5a, 0xf6, 0x60, 0x80, 0xc9, 0x5b, 0x9b, 0x58, 0xbc, 0x4a,
+	0x4c, 0xa2, 0xf7, 0x88, 0xde, 0xa5, 0xc4, 0x42, 0x15, 0xb8, 0xd9, 0x8b, 0xcc, 0xc5, 0xc6, 0x95,
+	0xbd, 0xfb, 0xe5, 0xb4, 0x70, 0x94, 0x2b, 0xc6, 0xd0, 0x34, 0x74, 0xa2, 0x73, 0x1c, 0xdb, 0xa4,
+	0xfe, 0x9c, 0x07, 0x39, 0x58, 0x3b, 0x35, 0xfb, 0x4c, 0xeb, 0x11, 0x74, 0x04, 0x39, 0x8b, 0x6b,
+	0xdc, 0xb6, 0x84, 0xd1, 0x6b, 0x7b, 0xff, 0x9e, 0x21, 0xd3, 0xdb, 0x57, 0xf6, 0xbe, 0x6d, 0xb1,
+	0x17, 0x7b, 0x32, 0x82, 0x00, 0x64, 0xc2, 0x00, 0xc4, 0xc2, 0xbf, 0x90, 0x08, 0xff, 0x47, 0x00,
+	0x5d, 0x5f, 0xb2, 0xa5, 0x2c, 0xce, 0xe7, 0x55, 0x64, 0x0b, 0x6a, 0x42, 0x9e, 0x5a, 0x96, 0x4d,
+	0x3a, 0x23, 0x93, 0x28, 0x59, 0xe1, 0xc1, 0xbf, 0xe6, 0xf4, 0xa0, 0xe1, 0xef, 0xc3, 0xa1, 0x08,
+	0xf4, 0x77, 0x90, 0x45, 0x36, 0x0e, 0xa9, 0xc5, 0x0d, 0x36, 0x12, 0x46, 0xe7, 0x84, 0xd1, 0x63,
+	0x74, 0x54, 0x07, 0x99, 0x0e, 0xb5, 0x3e, 0x39, 0xa6, 0x96, 0x45, 0xf5, 0xfe, 0x11, 0xb5, 0xb8,
+	0xb2, 0x24, 0x5c, 0xd8, 0x4e, 0x37, 0xa1, 0xe1, 0x70, 0xe3, 0xb1, 0x4d, 0xe8, 0x1e, 0xe4, 0x9d,
+	0x48, 0x59, 0xa6, 0xd6, 0x25, 0xca, 0xb2, 0xd0, 0x16, 0x12, 0x50, 0x09, 0xd6, 0x39, 0xb1, 0xf8,
+	0x81, 0x4d, 0x07, 0x3d, 0xc7, 0xc6, 0x46, 0x55, 0xc9, 0x0b, 0x9e, 0x24, 0xd9, 0x89, 0x3e, 0xb3,
+	0x75, 0x4b, 0x81, 0xa2, 0x54, 0xca, 0x62, 0x31, 0x76, 0x0e, 0x38, 0xb5, 0x30, 0xb9, 0x22, 0x8c,
+	0x5e, 0x8e, 0x94, 0x95, 0xa2, 0x54, 0x5a, 0xc6, 0x11, 0x0a, 0x32, 0x60, 0x93, 0xb9, 0x63, 0xda,
+	0xd5, 0x38, 0x35, 0x74, 0x37, 0xa3, 0xca, 0x4d, 0x11, 0xcb, 0xff, 0xcd, 0x19, 0x4b, 0x9c, 0x22,
+	0x02, 0xa7, 0x0a, 0x46, 0x2f, 0x40, 0xee, 0x11, 0x73, 0x60, 0x8c, 0x86, 0x44, 0xe7, 0x22, 0x07,
+	0x96, 0xb2, 0x2a, 0xa2, 0xf6, 0x20, 0x5d, 0x59, 0x35, 0xce, 0x8d, 0xc7, 0xb6, 0xa3, 0x57, 0xb0,
+	0x69, 0x86, 0x85, 0x13, 0x18, 0xa7, 0xac, 0x15, 0xa5, 0xd2, 0xca, 0xde, 0xe3, 0x74, 0xb1, 0x3e,
+	0x50, 0x44, 0x4a, 0x0e, 0xa7, 0x8a, 0x41, 0x7b, 0x31, 0xf1, 0xcd, 0x20, 0x53, 0xeb, 0x22, 0x0b,
+	0xa9, 0x6b, 0xaa, 0x06, 0xab, 0xb1, 0x0a, 0x41, 0x77, 0xe1, 0x76, 0x8c, 0x70, 0xfe, 0x7c, 0xbf,
+	0x71, 0x74, 0x8a, 0x6b, 0xf2, 0x8d, 0xf1, 0xa5, 0xf6, 0x69, 0xa5, 0x52, 0x6b, 0xb7, 0x65, 0x09,
+	0x15, 0x60, 0x2b, 0xbe, 0x54, 0xd9, 0x6f, 0x56, 0x6a, 0x47, 0xb5, 0xaa, 0x9c, 0x51, 0xbf, 0x93,
+	0x20, 0x1f, 0x9c, 0x61, 0x74, 0x1b, 0x36, 0x82, 0xc9, 0xf9, 0x69, 0xf3, 0x93, 0xe6, 0xc9, 0xcb,
+	0xa6, 0x7c, 0x03, 0xed, 0x42, 0x31, 0x24, 0x57, 0x6b, 0xed, 0x06, 0xae, 0x55, 0xcf, 0xcf, 0x6a,
+	0xb8, 0xdd, 0x38, 0x69, 0x0a, 0x13, 0x6a, 0x55, 0x59, 0x42, 0xdb, 0x70, 0x27, 0xe4, 0x6a, 0x1c,
+	0xef, 0xd7, 0x6b, 0xe7, 0xc7, 0x8d, 0x76, 0xbb, 0xd1, 0xac, 0xcb, 0x19, 0x74, 0x1f, 0xb6, 0xc3,
+	0xc5, 0x5a, 0xf3, 0xac, 0x81, 0x4f, 0x9a, 0xc7, 0xb5, 0x66, 0xe7, 0xbc, 0xd1, 0x6e, 0x9f, 0xd6,
+	0xe4, 0x05, 0xf5, 0x1b, 0xd8, 0x4c, 0xcb, 0x3f, 0x2a, 0xc2, 0xbd, 0x34, 0x7a, 0xc4, 0xba, 0x49,
+	0x1c, 0x7e, 0x6c, 0xa4, 0x89, 0x1c, 0x7e, 0x88, 0x32, 0x2a, 0x86, 0x7c, 0x98, 0xaa, 0x14, 0x00,
+	0x46, 0x4f, 0x21, 0x2b, 0x2a, 0x4e, 0x80, 0xd2, 0x8c, 0xda, 0x74, 0x39, 0xd5, 0xff, 0x40, 0x56,
+	0xcc, 0x9d, 0xea, 0x61, 0xc4, 0x34, 0x2c, 0xea, 0x14, 0xbd, 0x27, 0x35, 0x42, 0x41, 0x32, 0x2c,
+	0x70, 0xad, 0xef, 0xc1, 0x9d, 0x33, 0x54, 0x3f, 0x86, 0xbc, 0xd8, 0x2a, 0x0a, 0xfb, 0x19, 0xe4,
+	0x84, 0x40, 0x1f, 0xb0, 0xa7, 0xea, 0xf6, 0x58, 0xd5, 0x77, 0xa0, 0xf8, 0x67, 0xb3, 0x62, 0x33,
+	0x46, 0xf4, 0xc8, 0x51, 0x9c, 0xd6, 0xca, 0xe2, 0x58, 0x9a, 0xf9, 0x60, 0x2c, 0x55, 0xbf, 0x95,
+	0x60, 0x3d, 0x51, 0x6c, 0x0e, 0x34, 0x85, 0xf8, 0xea, 0x6a, 0x0c, 0x09, 0xa8, 0x03, 0x1b, 0x97,
+	0x1a, 0x1d, 0xd8, 0x8c, 0x54, 0x92, 0x9a, 0x1f, 0xa6, 0x6b, 0x7e, 0x9e, 0x60, 0xc7, 0xe3, 0x02,
+	0xd4, 0x1f, 0x25, 0x90, 0x93, 0x7c, 0x68, 0x17, 0x56, 0x03, 0x53, 0x23, 0xee, 0xc7, 0x89, 0xe8,
+	0xff, 0x70, 0xf7, 0x92, 0x32, 0x8b, 0x07, 0xdb, 0x75, 0xae, 0x51, 0x9d, 0xb0, 0x48, 0x77, 0x9f,
+	0xcc, 0x80, 0x54, 0xb8, 0xc9, 0x88, 0xc5, 0x35, 0xc6, 0x2b, 0x86, 0xad, 0x73, 0xd1, 0xad, 0xb2,
+	0x38, 0x46, 0x73, 0x32, 0xa0, 0x1b, 0x3d, 0xf7, 0xba, 0xb0, 0xe8, 0x66, 0xc0, 0x9f, 0xab, 0xc7,
+	0xb0, 0xe1, 0x67, 0x2e, 0x40, 0x82, 0xa9, 0x29, 0x8b, 0x01, 0x7f, 0x26, 0x01, 0xfc, 0xea, 0x0f,
+	0x19, 0xb8, 0x95, 0x82, 0x52, 0x7f, 0xe6, 0x3e, 0xe3, 0xec, 0x6d, 0xe1, 0xa6, 0x3d, 0xbc, 0x20,
+	0xcc, 0x6f, 0xc6, 0xfe, 0xdc, 0xb1, 0xa6, 0x6b, 0x0c, 0x87, 0x94, 0xb7, 0x0f, 0xf7, 0x3d, 0xdf,
+	0x42, 0x42, 0xdc, 0xd6, 0x6c, 0xb2, 0x49, 0xed, 0xc2, 0xea, 0x50, 0x7b, 0x8f, 0x09, 0x67, 0xee,
+	0x2d, 0x47, 0x34, 0xcd, 0x2c, 0x8e, 0x13, 0xaf, 0xad, 0x63, 0xaa, 0xbf, 0x66, 0x60, 0xa3, 0x15,
+	0xc5, 0x6a, 0xfd, 0x92, 0xf6, 0x9d, 0xfc, 0x75, 0x0d, 0xbd, 0x2b, 0x8a, 0xa6, 0x4b, 0xdc, 0x1b,
+	0x4d, 0x16, 0xc7, 0x68, 0x4e, 0x00, 0x7c, 0x9b, 0x44, 0x78, 0xb2, 0x38, 0x98, 0xa3, 0x87, 0xb0,
+	0x36, 0xd4, 0xde, 0x7b, 0x2d, 0xbe, 0xaa, 0x8d, 0x2c, 0xef, 0x04, 0x24, 0xa8, 0xe8, 0x10, 0x96,
+	0x38, 0xa3, 0xfd, 0x3e, 0x61, 0x22, 0x4c, 0x2b, 0x7b, 0xe5, 0x74, 0xeb, 0x23, 0x16, 0x76, 0x5c,
+	0x7e, 0xd7, 0x50, 0xec, 0x6f, 0x77, 0xc2, 0xd6, 0xa7, 0x1c, 0x87, 0x10, 0xe3, 0x06, 0x36, 0x4e,
+	0x74, 0xfc, 0xea, 0x53, 0xde, 0x62, 0xc6, 0x97, 0xa4, 0xcb, 0x1b, 0x55, 0xef, 0x42, 0x12, 0xa3,
+	0xa1, 0x57, 0x20, 0x73, 0xa2, 0xb1, 0xaa, 0xf1, 0x4e, 0xaf, 0xda, 0x4c, 0x40, 0xa5, 0xb2, 0x24,
+	0x8c, 0x7b, 0x3a, 0xdb, 0xb8, 0xc4, 0x46, 0x3c, 0x26, 0x4a, 0xfd, 0x14, 0x94, 0x49, 0xde, 0xc4,
+	0x42, 0x2a, 0x25, 0x42, 0x5a, 0x84, 0x15, 0xd3, 0x18, 0x0c, 0xa8, 0xde, 0xef, 0xd0, 0xe0, 0x40,
+	0x46, 0x49, 0xea, 0x1b, 0xb8, 0x15, 0x54, 0x79, 0xdb, 0xb0, 0x59, 0xd7, 0x85, 0xce, 0x17, 0x20,
+	0x27, 0xc8, 0x3e, 0x88, 0x3e, 0x98, 0x81, 0x69, 0x2e, 0x37, 0x1e, 0xdb, 0xae, 0xfe, 0x22, 0xc1,
+	0x7a, 0x82, 0x38, 0x27, 0xac, 0x6c, 0x41, 0xce, 0x12, 0xfc, 0x9e, 0x03, 0xde, 0x0c, 0x29, 0xb0,
+	0x64, 0x6a, 0x9c, 0x13, 0xa6, 0x7b, 0xc5, 0xe4, 0x4f, 0xc3, 0xa6, 0xb3, 0x38, 0x6f, 0xd3, 0x89,
+	0x95, 0x75, 0x76, 0x6a, 0x59, 0xe7, 0xc6, 0x9e, 0x29, 0xff, 0x88, 0x44, 0xeb, 0x8c, 0x30, 0x8b,
+	0x1a, 0xba, 0x63, 0xdc, 0x95, 0x3b, 0xf4, 0x9c, 0xf2, 0xa7, 0xea, 0x4f, 0x12, 0xa0, 0xf1, 0x6c,
+	0xa6, 0x36, 0xcf, 0xa9, 0x08, 0x35, 0xf5, 0x6a, 0xbf, 0x05, 0x39, 0x46, 0x2c, 0x7b, 0xc0, 0x3d,
+	0x28, 0xf1, 0x66, 0xa9, 0x18, 0x90, 0xfd, 0x23, 0x18, 0xf0, 0x9b, 0x04, 0xdb, 0x53, 0x0e, 0xb1,
+	0x63, 0x5c, 0xcf, 0x1b, 0x0b, 0x97, 0x16, 0x70, 0x30, 0x47, 0x67, 0xb0, 0xdc, 0x65, 0x94, 0x13,
+	0x46, 0x35, 0xe1, 0xd5, 0xda, 0xde, 0x7f, 0x3f, 0xb8, 0x4a, 0xca, 0x15, 0x4f, 0x02, 0x0e, 0x64,
+	0xa9, 0xe7, 0xb0, 0xec, 0x53, 0xd1, 0x26, 0xc8, 0xfe, 0x38, 0x72, 0xe5, 0xd9, 0x80, 0xd5, 0x80,
+	0x7a, 0x70, 0xd2, 0x39, 0x94, 0xa5, 0x18, 0xa3, 0x7f, 0xf3, 0xc9, 0xc4, 0xa8, 0xfe, 0x6d, 0x67,
+	0x61, 0xef, 0xfb, 0x55, 0x58, 0xc0, 0xad, 0x0a, 0xd2, 0x60, 0xab, 0x4e, 0x1c, 0x93, 0x86, 0xfb,
+	0x5d, 0x4e, 0xaf, 0x48, 0xd8, 0x6f, 0x76, 0x26, 0x5f, 0x77, 0x1d, 0xa6, 0xc2, 0xa3, 0xe9, 0xd7,
+	0xe1, 0x50, 0xd0, 0x6b, 0xb8, 0x83, 0x6d, 0xbd, 0x65, 0x44, 0xae, 0xc2, 0xfe, 0xa3, 0xf1, 0xe1,
+	0x7c, 0xcf, 0x82, 0xc2, 0x84, 0x8c, 0x8a, 0xc7, 0x7a, 0x44, 0x7e, 0x24, 0xc8, 0x6e, 0x9f, 0xb8,
+	0x16, 0xf9, 0x17, 0x70, 0x77, 0x5c, 0xbe, 0x7f, 0xd6, 0x4b, 0xf3, 0x22, 0xf6, 0x74, 0x1d, 0x0c,
+	0x4a, 0x13, 0x7c, 0xe8, 0x38, 0xef, 0x09, 0x5b, 0xd7, 0x09, 0xf3, 0x55, 0xce, 0xff, 0x0e, 0x99,
+	0xae, 0x93, 0x00, 0xaa, 0x13, 0xee, 0x55, 0x82, 0x57, 0xec, 0x16, 0x2a, 0x4f, 0x97, 0x9e, 0xbc,
+	0x49, 0x16, 0xee, 0x4f, 0x29, 0x36, 0x01, 0xc0, 0x1a, 0x14, 0xda, 0x44, 0xef, 0x9d, 0x9a, 0x3d,
+	0x8d, 0x8b, 0xd7, 0x07, 0x11, 0x7e, 0x1d, 0x13, 0xce, 0x68, 0xf7, 0x7a, 0x32, 0xf4, 0x05, 0xac,
+	0xd6, 0x09, 0x8f, 0xfc, 0x5f, 0x29, 0x4d, 0x77, 0x22, 0xe4, 0x2c, 0x14, 0xd3, 0x39, 0x23, 0xb2,
+	0x3e, 0x83, 0x8d, 0x3a, 0xe1, 0x89, 0x5f, 0x2f, 0xb3, 0x8a, 0x63, 0x77, 0xc2, 0xb1, 0x88, 0x4b,
+	0xf9, 0x1a, 0x8a, 0x8e, 0xe8, 0x30, 0x61, 0xae, 0xda, 0xd8, 0x1f, 0x9b, 0xf9, 0x5d, 0xf9, 0xe7,
+	0xcc, 0xa3, 0x18, 0x13, 0x4c, 0x61, 0x33, 0xae, 0xdc, 0xeb, 0xc2, 0xf3, 0x2b, 0x7c, 0x34, 0x53,
+	0xa1, 0x27, 0xf2, 0x0a, 0x76, 0x92, 0xaa, 0xe2, 0x2d, 0xf5, 0x43, 0xce, 0xf4, 0xe3, 0xb9, 0xda,
+	0xb6, 0x38, 0x7a, 0x3d, 0xb8, 0x55, 0x27, 0x7c, 0xac, 0xa1, 0xcd, 0xd7, 0xf8, 0x0b, 0xb3, 0x8e,
+	0xa6, 0x2f, 0xee, 0x0a, 0xbc, 0x5f, 0x0b, 0x2e, 0x82, 0xb6, 0xdd, 0x1f, 0x8d, 0x56, 0x43, 0xe7,
+	0x46, 0xc4, 0xee, 0x9a, 0x7e, 0x45, 0x99, 0xa1, 0x3b, 0x2f, 0x22, 0x34, 0x2f, 0x74, 0x4e, 0x3f,
+	0xf5, 0x97, 0x70, 0xaf, 0xc2, 0x88, 0xc6, 0xc9, 0x04, 0x2d, 0xd7, 0x87, 0x13, 0x7f, 0xab, 0x12,
+	0x8b, 0x33, 0x63, 0xf4, 0x57, 0xba, 0x73, 0x80, 0x3e, 0x97, 0x93, 0x3f, 0x6d, 0x2f, 0x72, 0xe2,
+	0x6f, 0xed, 0xb3, 0xdf, 0x07, 0x00, 0xb5, 0x52, 0xed, 0x83, 0xcf, 0x15, 0x00, 0x00,
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"sync"
 	"time"
 
@@ -1783,7 +1784,7 @@ var _ = Describe("[e2e] Main controller", func() {
 				return false, nil
 			}
 
-			if teamUsingTemplate.Status.Used.Credential == team.Status.Used.Credential ||
+			if reflect.DeepEqual(teamUsingTemplate.Status.Used.Credential, team.Status.Used.Credential) ||
 				teamUsingTemplate.Status.Used.StagingCtrl == team.Status.Used.StagingCtrl ||
 				len(teamUsingTemplate.Status.Used.Owners) == len(team.Status.Used.Owners) {
 				return true, nil